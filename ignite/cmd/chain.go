@@ -87,6 +87,12 @@ to send token from any other account that exists on chain.
 
 The "simulate" command helps you start a simulation testing process for your
 chain.
+
+The "index" command saves the transactions of your chain and their events in a
+database, so they can be queried without going through the node.
+
+The "snapshot" command saves named states of your chain so you can restore them
+later.
 `,
 		Aliases:           []string{"c"},
 		Args:              cobra.ExactArgs(1),
@@ -104,6 +110,9 @@ chain.
 		NewChainFaucet(),
 		NewChainSimulate(),
 		NewChainDebug(),
+		NewChainIndex(),
+		NewChainSnapshot(),
+		NewChainAccounts(),
 	)
 
 	return c
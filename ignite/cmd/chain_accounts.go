@@ -0,0 +1,31 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewChainAccounts creates a new accounts command to manage the development accounts of a blockchain.
+func NewChainAccounts() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "accounts [command]",
+		Short: "Manage the development accounts of your chain",
+		Long: `Commands for managing the accounts created when the chain is initialized.
+
+The accounts are defined in the "accounts" section of config.yml. By default
+the mnemonics of the accounts are random, so the accounts change each time the
+chain is initialized. Use "ignite chain init --deterministic" or set a "seed"
+in config.yml to always create the same accounts.
+
+Export the accounts to use them in test suites:
+
+	ignite chain accounts export --format env > .env
+`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(
+		NewChainAccountsExport(),
+	)
+
+	return c
+}
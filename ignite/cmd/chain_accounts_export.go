@@ -0,0 +1,103 @@
+package ignitecmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	flagFormat = "format"
+
+	accountsFormatEnv  = "env"
+	accountsFormatJSON = "json"
+)
+
+var envNameInvalidChars = regexp.MustCompile(`[^A-Z0-9]+`)
+
+// NewChainAccountsExport creates a new command to export the development accounts of a blockchain.
+func NewChainAccountsExport() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "export",
+		Short: "Export the address, mnemonic and private key of the accounts",
+		Long: `Export the address, mnemonic and private key of the accounts created when the
+chain was initialized.
+
+The "env" format prints one variable per line, prefixed with the account name:
+
+	ALICE_ADDRESS=cosmos1...
+	ALICE_MNEMONIC="..."
+	ALICE_PRIVATE_KEY=...
+
+The "json" format prints a list with the accounts. The mnemonic and the private
+key are only available for the accounts with a key created by Ignite.
+
+The exported keys give full control of the accounts, use them only for
+development.
+`,
+		Args: cobra.NoArgs,
+		RunE: chainAccountsExportHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().String(flagFormat, accountsFormatEnv, "output format (env|json)")
+
+	return c
+}
+
+func chainAccountsExportHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.End()
+
+	format, _ := cmd.Flags().GetString(flagFormat)
+	if format != accountsFormatEnv && format != accountsFormatJSON {
+		return fmt.Errorf("invalid format %q, expected one of: %s, %s", format, accountsFormatEnv, accountsFormatJSON)
+	}
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	accounts, err := c.DevAccounts()
+	if err != nil {
+		return err
+	}
+
+	if format == accountsFormatJSON {
+		data, err := json.MarshalIndent(accounts, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		return session.Println(string(data))
+	}
+
+	return session.Println(formatAccountsEnv(accounts))
+}
+
+// formatAccountsEnv formats the accounts as environment variables.
+func formatAccountsEnv(accounts []chain.DevAccount) string {
+	var b strings.Builder
+	for _, a := range accounts {
+		prefix := strings.Trim(envNameInvalidChars.ReplaceAllString(strings.ToUpper(a.Name), "_"), "_")
+
+		fmt.Fprintf(&b, "%s_ADDRESS=%s\n", prefix, a.Address)
+		if a.Mnemonic != "" {
+			fmt.Fprintf(&b, "%s_MNEMONIC=%s\n", prefix, strconv.Quote(a.Mnemonic))
+		}
+		if a.PrivateKey != "" {
+			fmt.Fprintf(&b, "%s_PRIVATE_KEY=%s\n", prefix, a.PrivateKey)
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
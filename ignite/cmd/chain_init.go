@@ -2,6 +2,7 @@ package ignitecmd
 
 import (
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/ignite/cli/ignite/pkg/chaincmd"
 	"github.com/ignite/cli/ignite/pkg/cliui"
@@ -10,12 +11,14 @@ import (
 	"github.com/ignite/cli/ignite/services/chain"
 )
 
+const flagDeterministic = "deterministic"
+
 func NewChainInit() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "init",
 		Short: "Initialize your chain",
 		Long: `The init command compiles and installs the binary (like "ignite chain build")
-and uses that binary to initialize the blockchain's data directory for one
+and uses that binary to initialize the blockchain's data directory for each
 validator. To learn how the build process works, refer to "ignite chain build
 --help".
 
@@ -28,6 +31,11 @@ By default, the data directory will be initialized in $HOME/.mychain, where
 	    bonded: '100000000stake'
 	    home: "~/.customdir"
 
+When more than one validator is defined in config.yml, the data directory of
+each additional validator is initialized in the "validators" directory of the
+chain's data directory, unless a custom "home" is set for the validator. The
+nodes are connected to each other as persistent peers.
+
 The data directory contains three files in the "config" directory: app.toml,
 config.toml, client.toml. These files let you customize the behavior of your
 blockchain node and the client executable. When a chain is re-initialized the
@@ -74,6 +82,17 @@ genesis in config.yml:
 The example above changes the staking token to "foo". If you change the staking
 denom, make sure the validator account has the right tokens.
 
+By default a random mnemonic is created for each account that doesn't define
+a "mnemonic" or an "address", so the account addresses change every time the
+chain is initialized. To keep the same addresses, for example to use them in
+frontend fixtures, derive the mnemonics from a seed:
+
+	ignite chain init --deterministic
+
+The default seed can be changed with the "seed" property of config.yml, in
+which case the mnemonics are always derived from the seed. The accounts created
+by the last initialization can be exported with "ignite chain accounts export".
+
 The init command is meant to be used ONLY FOR DEVELOPMENT PURPOSES. Under the
 hood it runs commands like "appd init", "appd add-genesis-account", "appd
 gentx", and "appd collect-gentx". For production, you may want to run these
@@ -89,11 +108,23 @@ commands manually to ensure a production-level node initialization.
 	c.Flags().AddFlagSet(flagSetCheckDependencies())
 	c.Flags().AddFlagSet(flagSetSkipProto())
 	c.Flags().AddFlagSet(flagSetDebug())
+	c.Flags().AddFlagSet(flagSetDeterministic())
 	c.Flags().StringSlice(flagBuildTags, []string{cosmosver.DefaultVersion().String()}, "parameters to build the chain binary")
 
 	return c
 }
 
+func flagSetDeterministic() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Bool(flagDeterministic, false, "derive the mnemonics of the accounts from a seed to keep the same addresses")
+	return fs
+}
+
+func flagGetDeterministic(cmd *cobra.Command) (deterministic bool) {
+	deterministic, _ = cmd.Flags().GetBool(flagDeterministic)
+	return
+}
+
 func chainInitHandler(cmd *cobra.Command, _ []string) error {
 	session := cliui.New(
 		cliui.WithVerbosity(getVerbosity(cmd)),
@@ -112,6 +143,10 @@ func chainInitHandler(cmd *cobra.Command, _ []string) error {
 		chainOption = append(chainOption, chain.CheckDependencies())
 	}
 
+	if flagGetDeterministic(cmd) {
+		chainOption = append(chainOption, chain.DeterministicAccounts())
+	}
+
 	c, err := newChainWithHomeFlags(cmd, chainOption...)
 	if err != nil {
 		return err
@@ -3,6 +3,7 @@ package ignitecmd
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
@@ -18,8 +19,12 @@ import (
 
 const (
 	flagConfig          = "config"
+	flagControlAddr     = "control-addr"
 	flagForceReset      = "force-reset"
+	flagForkFrom        = "fork-from"
+	flagFromSnapshot    = "from-snapshot"
 	flagGenerateClients = "generate-clients"
+	flagIndexDB         = "index-db"
 	flagQuitOnFail      = "quit-on-fail"
 	flagResetOnce       = "reset-once"
 )
@@ -38,9 +43,48 @@ Automatic code reloading means Ignite starts watching the project directory.
 Whenever a file change is detected, Ignite automatically rebuilds, reinitializes
 and restarts the node.
 
+The action applied when a file changes can be configured with the "serve.watch"
+rules of the config file. Each rule matches files with glob patterns relative to
+the project directory and defines whether to rebuild the app, only regenerate
+the Go code from the proto files, only regenerate the clients, restart the node
+without rebuilding or ignore the change:
+
+	serve:
+	  watch:
+	    - paths: ["x/**/client/**"]
+	      action: restart
+	    - paths: ["docs/**"]
+	      action: ignore
+
+The first matching rule is applied. Files in the "app", "cmd", "x", "proto" and
+"third_party" directories that don't match any rule rebuild the app.
+
 Whenever possible Ignite will try to keep the current state of the chain by
 exporting and importing the genesis file.
 
+When the state schema of a module changes, the exported genesis can be migrated
+before it's imported by adding migrations to the "migrations" directory of the
+project, with a subdirectory for each module:
+
+	migrations/blog/001_rename_title.json
+
+JSON migrations contain a list of operations that change the genesis state of
+the module using jq-style paths:
+
+	[
+	  {"op": "rename", "path": ".posts[].title", "to": "name"},
+	  {"op": "convert", "path": ".params.max_posts", "type": "number"},
+	  {"op": "set", "path": ".params.enabled", "value": true},
+	  {"op": "default", "path": ".params.fee", "value": "10token"},
+	  {"op": "delete", "path": ".posts[].legacy"}
+	]
+
+Migrations can also be Go programs with the "ignore" build constraint that read
+the state of the module from the standard input and write the new state to the
+standard output. Each migration is applied once, in the order of the file names,
+and the changes are saved as a diff next to the exported genesis. Migrations
+that exist when the chain is initialized are not applied to its state.
+
 To force Ignite to start from a clean slate even if a genesis file exists, use
 the following flag:
 
@@ -58,6 +102,68 @@ from one blockchain to another. To start a node using a specific config file:
 
 	ignite chain serve --config mars.yml
 
+To index the transactions of the blockchain in a database while it's served,
+use the following flag with the URL of the database:
+
+	ignite chain serve --index-db postgres://localhost:5432/indexer?sslmode=disable
+
+An embedded SQLite database file can be used instead of a PostgreSQL server:
+
+	ignite chain serve --index-db sqlite://indexer.db
+
+The indexer resumes from the latest block saved in the database every time the
+node is restarted. The database is not cleared when the state is reset.
+
+To start the blockchain from a state saved with "ignite chain snapshot save"
+use the following flag with the name of the snapshot:
+
+	ignite chain serve --from-snapshot demo
+
+The snapshot is restored once when the node is started, the state is then kept
+when the source code is modified like with any other state.
+
+To start the blockchain with the state of another network, for example to
+reproduce a bug of a live network, use the following flag with the path or URL
+of an exported genesis file:
+
+	ignite chain serve --fork-from exported-genesis.json
+
+The state is exported with the "export" command of the app on a node of the
+network, for example "appd export > exported-genesis.json". The URL must point
+to a JSON file or to a tarball that contains it. The RPC address of a node can't
+be used because it only serves the genesis the network was started with, not
+its current state.
+
+The validators of the forked state are replaced by the validators defined in
+the config file, the tokens delegated to them are removed, and the accounts of
+the config file are added to the state. The validators must be bonded with the
+bond denom of the forked state. The genesis migrations of the app are applied
+to the forked state, and the genesis values of the config file are applied on
+top of it.
+
+To control and observe the served blockchain from other programs, like editor
+extensions or end-to-end tests, use the following flag to serve an HTTP API:
+
+	ignite chain serve --control-addr localhost:4600
+
+The API has the following endpoints:
+
+	GET  /status    state, chain ID, height, watching status and last build error
+	GET  /accounts  accounts of the chain keyring
+	GET  /events    stream of the serve events as newline delimited JSON
+	POST /rebuild   rebuild the app and restart the blockchain
+	POST /reset     reset the app state and restart the blockchain
+	POST /restart   restart the blockchain
+	POST /pause     pause watching the source code changes
+	POST /resume    resume watching and apply the changes made while paused
+
+The API only accepts requests sent to a loopback address, without a foreign
+"Origin" header. The POST requests must be authorized with the token of the
+serve session, saved in the "control.token" file of the chain directory in
+$HOME/.ignite/local-chains while the chain is served:
+
+	curl -X POST -H "Authorization: Bearer $(cat control.token)" localhost:4600/restart
+
 The serve command is meant to be used ONLY FOR DEVELOPMENT PURPOSES. Under the
 hood, it runs "appd start", where "appd" is the name of your chain's binary. For
 production, you may want to run "appd start" manually.
@@ -71,11 +177,16 @@ production, you may want to run "appd start" manually.
 	c.Flags().AddFlagSet(flagSetHome())
 	c.Flags().AddFlagSet(flagSetCheckDependencies())
 	c.Flags().AddFlagSet(flagSetSkipProto())
+	c.Flags().AddFlagSet(flagSetDeterministic())
 	c.Flags().BoolP("verbose", "v", false, "verbose output")
 	c.Flags().BoolP(flagForceReset, "f", false, "force reset of the app state on start and every source change")
 	c.Flags().BoolP(flagResetOnce, "r", false, "reset the app state once on init")
 	c.Flags().Bool(flagGenerateClients, false, "generate code for the configured clients on reset or source code change")
 	c.Flags().Bool(flagQuitOnFail, false, "quit program if the app fails to start")
+	c.Flags().String(flagControlAddr, "", "address of an HTTP API to control and observe the served blockchain")
+	c.Flags().String(flagIndexDB, "", "URL of a database to index the transactions of the blockchain")
+	c.Flags().String(flagFromSnapshot, "", "restore the app state from a saved snapshot on init")
+	c.Flags().String(flagForkFrom, "", "initialize the app state from the path or URL of a genesis file exported with the app (RPC addresses are not supported)")
 	c.Flags().StringSlice(flagBuildTags, []string{cosmosver.DefaultVersion().String()}, "parameters to build the chain binary")
 
 	return c
@@ -134,6 +245,10 @@ func chainServe(cmd *cobra.Command, session *cliui.Session) error {
 		chainOption = append(chainOption, chain.CheckDependencies())
 	}
 
+	if flagGetDeterministic(cmd) {
+		chainOption = append(chainOption, chain.DeterministicAccounts())
+	}
+
 	// check if custom config is defined
 	config, err := cmd.Flags().GetString(flagConfig)
 	if err != nil {
@@ -206,9 +321,59 @@ func chainServe(cmd *cobra.Command, session *cliui.Session) error {
 		serveOptions = append(serveOptions, chain.ServeSkipProto())
 	}
 
+	snapshot, err := cmd.Flags().GetString(flagFromSnapshot)
+	if err != nil {
+		return err
+	}
+
+	if snapshot != "" {
+		serveOptions = append(serveOptions, chain.ServeFromSnapshot(snapshot))
+	}
+
+	forkFrom, err := cmd.Flags().GetString(flagForkFrom)
+	if err != nil {
+		return err
+	}
+
+	if forkFrom != "" {
+		serveOptions = append(serveOptions, chain.ServeForkFrom(forkFrom))
+	}
+
+	controlAddr, err := cmd.Flags().GetString(flagControlAddr)
+	if err != nil {
+		return err
+	}
+
+	if controlAddr != "" {
+		serveOptions = append(serveOptions, chain.ServeControl(controlAddr))
+	}
+
+	indexDB, err := cmd.Flags().GetString(flagIndexDB)
+	if err != nil {
+		return err
+	}
+
+	if indexDB != "" {
+		db, err := newTXsAdapter(indexDB)
+		if err != nil {
+			return err
+		}
+
+		if err := db.Init(cmd.Context()); err != nil {
+			return fmt.Errorf("failed to initialize the index database: %w", err)
+		}
+
+		serveOptions = append(serveOptions, chain.ServeIndex(db))
+	}
+
 	if quitOnFail {
 		serveOptions = append(serveOptions, chain.QuitOnFail())
 	}
 
+	// deliver the lifecycle events to the plugins that subscribe to them
+	if h := newPluginsLifecycleHandler(cmd, session.EventBus()); h != nil {
+		serveOptions = append(serveOptions, chain.ServeLifecycle(h))
+	}
+
 	return c.Serve(cmd.Context(), cacheStorage, serveOptions...)
 }
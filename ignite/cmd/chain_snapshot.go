@@ -0,0 +1,45 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewChainSnapshot creates a new snapshot command to manage saved states of a blockchain.
+func NewChainSnapshot() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "snapshot [command]",
+		Short: "Save and restore named states of your chain",
+		Long: `Commands for saving and restoring named states of your blockchain.
+
+A snapshot contains the exported state of the blockchain together with the
+config files and keyrings of each validator's data directory, so a snapshot can
+be restored even after the chain was reset. This is useful to go back to a
+specific state during development, for example the state right after a set of
+demo transactions, instead of recreating it by hand.
+
+Save a snapshot of the current state of the chain:
+
+	ignite chain snapshot save demo
+
+The chain must be stopped before a snapshot is saved or restored. Snapshots are
+saved in the Ignite config directory, under the chain ID.
+
+Restore a snapshot:
+
+	ignite chain snapshot restore demo
+
+Or restore it when the chain is served:
+
+	ignite chain serve --from-snapshot demo
+`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(
+		NewChainSnapshotSave(),
+		NewChainSnapshotList(),
+		NewChainSnapshotRestore(),
+	)
+
+	return c
+}
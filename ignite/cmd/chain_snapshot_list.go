@@ -0,0 +1,60 @@
+package ignitecmd
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+)
+
+var snapshotListHeader = []string{"name", "chain id", "height", "validators", "created at"}
+
+// NewChainSnapshotList creates a new command to list the saved states of a blockchain.
+func NewChainSnapshotList() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "list",
+		Short: "List the saved states of your chain",
+		Args:  cobra.NoArgs,
+		RunE:  chainSnapshotListHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+
+	return c
+}
+
+func chainSnapshotListHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.End()
+
+	c, err := newChainWithHomeFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := c.Snapshots()
+	if err != nil {
+		return err
+	}
+
+	if len(snapshots) == 0 {
+		return session.Println("No snapshots found")
+	}
+
+	var rows [][]string
+	for _, s := range snapshots {
+		rows = append(rows, []string{
+			s.Name,
+			s.ChainID,
+			strconv.FormatInt(s.Height, 10),
+			strings.Join(s.Validators, ", "),
+			s.CreatedAt.Local().Format(time.RFC822),
+		})
+	}
+
+	return session.PrintTable(snapshotListHeader, rows...)
+}
@@ -0,0 +1,49 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+// NewChainSnapshotRestore creates a new command to restore a saved state of a blockchain.
+func NewChainSnapshotRestore() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "restore [name]",
+		Short: "Restore a saved state of your chain",
+		Long: `Restore a saved state of your chain.
+
+The config files and keyrings of each validator's data directory are replaced
+by the ones in the snapshot and the blockchain database is reset, so the chain
+starts from the saved state the next time it's started.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: chainSnapshotRestoreHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+
+	return c
+}
+
+func chainSnapshotRestoreHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New(cliui.StartSpinnerWithText("Restoring snapshot..."))
+	defer session.End()
+
+	c, err := newChainWithHomeFlags(cmd, chain.WithOutputer(session), chain.CollectEvents(session.EventBus()))
+	if err != nil {
+		return err
+	}
+
+	s, err := c.RestoreSnapshot(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.Printf("%s Snapshot %q restored at height %d\n", icons.OK, s.Name, s.Height)
+}
@@ -0,0 +1,43 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+// NewChainSnapshotSave creates a new command to save the state of a blockchain.
+func NewChainSnapshotSave() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "save [name]",
+		Short: "Save the current state of your chain",
+		Args:  cobra.ExactArgs(1),
+		RunE:  chainSnapshotSaveHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+
+	return c
+}
+
+func chainSnapshotSaveHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New(cliui.StartSpinnerWithText("Saving snapshot..."))
+	defer session.End()
+
+	c, err := newChainWithHomeFlags(cmd, chain.WithOutputer(session), chain.CollectEvents(session.EventBus()))
+	if err != nil {
+		return err
+	}
+
+	s, err := c.SaveSnapshot(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	return session.Printf("%s Snapshot %q saved at height %d\n", icons.OK, s.Name, s.Height)
+}
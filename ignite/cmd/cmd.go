@@ -75,6 +75,7 @@ To get started, create a blockchain:
 		NewNode(),
 		NewAccount(),
 		NewRelayer(),
+		NewIBC(),
 		NewTools(),
 		NewDocs(),
 		NewVersion(),
@@ -178,12 +179,16 @@ func newChainWithHomeFlags(cmd *cobra.Command, chainOption ...chain.Option) (*ch
 var (
 	modifyPrefix = colors.Modified("modify ")
 	createPrefix = colors.Success("create ")
+	deletePrefix = colors.Error("delete ")
 	removePrefix = func(s string) string {
-		return strings.TrimPrefix(strings.TrimPrefix(s, modifyPrefix), createPrefix)
+		for _, prefix := range []string{modifyPrefix, createPrefix, deletePrefix} {
+			s = strings.TrimPrefix(s, prefix)
+		}
+		return s
 	}
 )
 
-func sourceModificationToString(sm xgenny.SourceModification) (string, error) {
+func sourceModificationToString(sm xgenny.SourceModification, deletedFiles ...string) (string, error) {
 	// get file names and add prefix
 	var files []string
 	for _, modified := range sm.ModifiedFiles() {
@@ -202,6 +207,14 @@ func sourceModificationToString(sm xgenny.SourceModification) (string, error) {
 		}
 		files = append(files, createPrefix+relativePath)
 	}
+	for _, deleted := range deletedFiles {
+		// get the relative app path from the current directory
+		relativePath, err := relativePath(deleted)
+		if err != nil {
+			return "", err
+		}
+		files = append(files, deletePrefix+relativePath)
+	}
 
 	// sort filenames without prefix
 	sort.Slice(files, func(i, j int) bool {
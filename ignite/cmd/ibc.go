@@ -0,0 +1,20 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewIBC returns a command that groups sub commands related to IBC development.
+func NewIBC() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "ibc [command]",
+		Short: "Develop IBC apps with several local blockchains",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(
+		NewIBCDev(),
+	)
+
+	return c
+}
@@ -0,0 +1,210 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/relayer"
+	"github.com/ignite/cli/ignite/services/chain"
+	"github.com/ignite/cli/ignite/services/ibcdev"
+)
+
+const (
+	flagPort           = "port"
+	flagChannelVersion = "channel-version"
+)
+
+// NewIBCDev returns a new command to serve blockchains connected with an IBC relayer.
+func NewIBCDev() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "dev [path|config] [path|config]...",
+		Short: "Serve several blockchains and relay IBC packets between them",
+		Long: `The dev command serves two or more blockchains like "ignite chain serve" and
+connects them with an IBC relayer.
+
+Each argument is the path of a blockchain app:
+
+	ignite ibc dev ./mars ./venus
+
+Or a config file of the blockchain app in the current directory, to serve the
+same app with different configs:
+
+	ignite ibc dev earth.yml mars.yml
+
+Once the blockchains produce blocks, the relayer creates the IBC clients, a
+connection and a transfer channel between each pair of blockchains and relays
+the packets and acknowledgements until the command is stopped. Use the "--port"
+and "--channel-version" flags to create channels for the port of a custom IBC
+module:
+
+	ignite ibc dev earth.yml mars.yml --port blog --channel-version blog-1
+
+When the source code of a blockchain changes, the blockchain is rebuilt and the
+relayer restarts. The blockchains are linked again when the state of one of them
+is reset.
+
+Each blockchain must be served with its own config, so an app can be served
+several times with different config files but not twice with the same config.
+
+The relayer uses the first account of each blockchain with a key created by
+Ignite to send the IBC transactions of all the channels of the blockchain, so
+this account must have enough tokens to pay for the fees.
+
+The blockchains run at the same time on the same machine, so they must use
+different chain IDs and the validators must listen on different ports. Change
+the ports in the "validators" section of config.yml, for example:
+
+	validators:
+	  - name: alice
+	    bonded: 100000000stake
+	    app:
+	      api:
+	        address: :1318
+	      grpc:
+	        address: :9092
+	      grpc-web:
+	        address: :9093
+	    config:
+	      p2p:
+	        laddr: :26658
+	      rpc:
+	        laddr: :26659
+	        pprof_laddr: :6061
+
+The faucet port must also be different when the faucet is enabled.
+`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: ibcDevHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	c.Flags().AddFlagSet(flagSetCheckDependencies())
+	c.Flags().AddFlagSet(flagSetSkipProto())
+	c.Flags().AddFlagSet(flagSetDeterministic())
+	c.Flags().BoolP(flagForceReset, "f", false, "force reset of the app state on start and every source change")
+	c.Flags().BoolP(flagResetOnce, "r", false, "reset the app state once on init")
+	c.Flags().String(flagPort, relayer.TransferPort, "IBC port ID of the channels")
+	c.Flags().String(flagChannelVersion, relayer.TransferVersion, "module version of the channels")
+	c.Flags().Bool(flagOrdered, false, "create ordered channels")
+
+	return c
+}
+
+func ibcDevHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New(cliui.StartSpinner())
+	defer session.End()
+
+	var chainOption []chain.Option
+
+	if flagGetCheckDependencies(cmd) {
+		chainOption = append(chainOption, chain.CheckDependencies())
+	}
+
+	if flagGetDeterministic(cmd) {
+		chainOption = append(chainOption, chain.DeterministicAccounts())
+	}
+
+	chains := make([]*chain.Chain, 0, len(args))
+	for _, arg := range args {
+		appPath, options, err := ibcDevChainArg(cmd, arg)
+		if err != nil {
+			return err
+		}
+
+		// the events of each chain are prefixed with the chain name
+		bus := events.NewBus()
+		defer bus.Stop()
+
+		options = append(
+			options,
+			chain.WithOutputer(session),
+			chain.CollectEvents(bus),
+			chain.CheckCosmosSDKVersion(),
+		)
+
+		c, err := chain.New(appPath, append(options, chainOption...)...)
+		if err != nil {
+			return err
+		}
+
+		go forwardChainEvents(c.Name(), bus, session.EventBus())
+
+		chains = append(chains, c)
+	}
+
+	port, _ := cmd.Flags().GetString(flagPort)
+	version, _ := cmd.Flags().GetString(flagChannelVersion)
+	options := []ibcdev.Option{
+		ibcdev.CollectEvents(session.EventBus()),
+		ibcdev.Channel(port, version),
+	}
+
+	if ordered, _ := cmd.Flags().GetBool(flagOrdered); ordered {
+		options = append(options, ibcdev.Ordered())
+	}
+
+	dev, err := ibcdev.New(chains, options...)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	var serveOptions []chain.ServeOption
+
+	if forceReset, _ := cmd.Flags().GetBool(flagForceReset); forceReset {
+		serveOptions = append(serveOptions, chain.ServeForceReset())
+	}
+
+	if resetOnce, _ := cmd.Flags().GetBool(flagResetOnce); resetOnce {
+		serveOptions = append(serveOptions, chain.ServeResetOnce())
+	}
+
+	if flagGetSkipProto(cmd) {
+		serveOptions = append(serveOptions, chain.ServeSkipProto())
+	}
+
+	return dev.Run(cmd.Context(), cacheStorage, serveOptions...)
+}
+
+// ibcDevChainArg returns the app path and the chain options of an argument,
+// which is either the path of an app or a config file of the app in the path flag.
+func ibcDevChainArg(cmd *cobra.Command, arg string) (string, []chain.Option, error) {
+	info, err := os.Stat(arg)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if info.IsDir() {
+		appPath, err := filepath.Abs(arg)
+		return appPath, nil, err
+	}
+
+	configPath, err := filepath.Abs(arg)
+	if err != nil {
+		return "", nil, err
+	}
+
+	appPath, err := filepath.Abs(flagGetPath(cmd))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return appPath, []chain.Option{chain.ConfigFile(configPath)}, nil
+}
+
+func forwardChainEvents(name string, from events.Bus, to events.Bus) {
+	for e := range from.Events() {
+		e.Message = fmt.Sprintf("[%s] %s", name, e.Message)
+		to.SendEvent(e)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/ignite/cli/ignite/pkg/cliui/icons"
 	"github.com/ignite/cli/ignite/pkg/cosmosanalysis"
 	"github.com/ignite/cli/ignite/pkg/xgit"
+	"github.com/ignite/cli/ignite/services/chain"
 	"github.com/ignite/cli/ignite/services/plugin"
 )
 
@@ -34,17 +36,25 @@ func LoadPlugins(ctx context.Context, cmd *cobra.Command) error {
 	var (
 		rootCmd        = cmd.Root()
 		pluginsConfigs []pluginsconfig.Plugin
+		localLock      *pluginsconfig.Lock
+		globalLock     *pluginsconfig.Lock
 	)
 	localCfg, err := parseLocalPlugins(rootCmd)
 	if err != nil && !errors.As(err, &cosmosanalysis.ErrPathNotChain{}) {
 		return err
 	} else if err == nil {
 		pluginsConfigs = append(pluginsConfigs, localCfg.Plugins...)
+		if localLock, err = parsePluginsLock(localCfg); err != nil {
+			return err
+		}
 	}
 
 	globalCfg, err := parseGlobalPlugins()
 	if err == nil {
 		pluginsConfigs = append(pluginsConfigs, globalCfg.Plugins...)
+		if globalLock, err = parsePluginsLock(globalCfg); err != nil {
+			return err
+		}
 	}
 	ensureDefaultPlugins(cmd, globalCfg)
 
@@ -56,7 +66,12 @@ func LoadPlugins(ctx context.Context, cmd *cobra.Command) error {
 	defer session.End()
 
 	uniquePlugins := pluginsconfig.RemoveDuplicates(pluginsConfigs)
-	plugins, err = plugin.Load(ctx, uniquePlugins, plugin.CollectEvents(session.EventBus()))
+	plugins, err = plugin.Load(
+		ctx,
+		uniquePlugins,
+		plugin.CollectEvents(session.EventBus()),
+		plugin.WithLock(localLock, globalLock),
+	)
 	if err != nil {
 		return err
 	}
@@ -103,11 +118,40 @@ func parseGlobalPlugins() (cfg *pluginsconfig.Config, err error) {
 	return
 }
 
+// parsePluginsLock parses the lock file next to the plugins config file.
+// It returns a nil lock when the location of the config file is unknown.
+func parsePluginsLock(cfg *pluginsconfig.Config) (*pluginsconfig.Lock, error) {
+	if cfg.Path() == "" {
+		return nil, nil
+	}
+	return pluginsconfig.ParseLockDir(filepath.Dir(cfg.Path()))
+}
+
+// isPluginCmd returns true if the executed command is one of the plugin
+// commands, which must run even if some plugins don't match their lock file or
+// are incompatible, so they can be listed, updated or removed.
+func isPluginCmd(rootCmd *cobra.Command) bool {
+	cmd, _, err := rootCmd.Find(os.Args[1:])
+	if err != nil {
+		return false
+	}
+	cmdPath := cmd.CommandPath()
+	return cmdPath == "ignite plugin" || strings.HasPrefix(cmdPath, "ignite plugin ")
+}
+
 func linkPlugins(rootCmd *cobra.Command, plugins []*plugin.Plugin) error {
 	// Link plugins to related commands
 	var linkErrors []*plugin.Plugin
 	for _, p := range plugins {
 		if p.Error != nil {
+			var (
+				mismatchErr     *plugin.LockMismatchError
+				incompatibleErr *plugin.IncompatibleError
+			)
+			if (errors.As(p.Error, &mismatchErr) || errors.As(p.Error, &incompatibleErr)) && isPluginCmd(rootCmd) {
+				// the plugin is not linked but it can be managed
+				continue
+			}
 			linkErrors = append(linkErrors, p)
 			continue
 		}
@@ -121,6 +165,11 @@ func linkPlugins(rootCmd *cobra.Command, plugins []*plugin.Plugin) error {
 			linkErrors = append(linkErrors, p)
 			continue
 		}
+		checkPluginServeEvents(p, manifest.ServeEvents)
+		if p.Error != nil {
+			linkErrors = append(linkErrors, p)
+			continue
+		}
 		linkPluginCmds(rootCmd, p, manifest.Commands)
 		if p.Error != nil {
 			linkErrors = append(linkErrors, p)
@@ -196,7 +245,7 @@ func linkPluginHook(rootCmd *cobra.Command, p *plugin.Plugin, hook plugin.Hook)
 				return err
 			}
 		}
-		err := p.Interface.ExecuteHookPre(newExecutedHook(hook, cmd, args))
+		err := p.Interface.ExecuteHookPre(newExecutedHook(hook, cmd, args), newPluginClientAPI(cmd))
 		if err != nil {
 			return fmt.Errorf("plugin %q ExecuteHookPre() error: %w", p.Path, err)
 		}
@@ -210,7 +259,7 @@ func linkPluginHook(rootCmd *cobra.Command, p *plugin.Plugin, hook plugin.Hook)
 			err := runCmd(cmd, args)
 			// if the command has failed the `PostRun` will not execute. here we execute the cleanup step before returnning.
 			if err != nil {
-				err := p.Interface.ExecuteHookCleanUp(newExecutedHook(hook, cmd, args))
+				err := p.Interface.ExecuteHookCleanUp(newExecutedHook(hook, cmd, args), newPluginClientAPI(cmd))
 				if err != nil {
 					fmt.Printf("plugin %q ExecuteHookCleanUp() error: %v", p.Path, err)
 				}
@@ -224,10 +273,13 @@ func linkPluginHook(rootCmd *cobra.Command, p *plugin.Plugin, hook plugin.Hook)
 
 	postCmd := cmd.PostRunE
 	cmd.PostRunE = func(cmd *cobra.Command, args []string) error {
-		execHook := newExecutedHook(hook, cmd, args)
+		var (
+			execHook = newExecutedHook(hook, cmd, args)
+			api      = newPluginClientAPI(cmd)
+		)
 
 		defer func() {
-			err := p.Interface.ExecuteHookCleanUp(execHook)
+			err := p.Interface.ExecuteHookCleanUp(execHook, api)
 			if err != nil {
 				fmt.Printf("plugin %q ExecuteHookCleanUp() error: %v", p.Path, err)
 			}
@@ -241,7 +293,7 @@ func linkPluginHook(rootCmd *cobra.Command, p *plugin.Plugin, hook plugin.Hook)
 			}
 		}
 
-		err := p.Interface.ExecuteHookPost(execHook)
+		err := p.Interface.ExecuteHookPost(execHook, api)
 		if err != nil {
 			return fmt.Errorf("plugin %q ExecuteHookPost() error : %w", p.Path, err)
 		}
@@ -312,7 +364,7 @@ func linkPluginCmd(rootCmd *cobra.Command, p *plugin.Plugin, pluginCmd plugin.Co
 				}
 				execCmd.SetFlags(cmd)
 				// Call the plugin Execute
-				err := p.Interface.Execute(execCmd)
+				err := p.Interface.Execute(execCmd, newPluginClientAPI(cmd))
 				// NOTE(tb): This pause gives enough time for go-plugin to sync the
 				// output from stdout/stderr of the plugin. Without that pause, this
 				// output can be discarded and not printed in the user console.
@@ -331,6 +383,31 @@ func linkPluginCmd(rootCmd *cobra.Command, p *plugin.Plugin, pluginCmd plugin.Co
 	}
 }
 
+// newPluginClientAPI creates the API of ignite given to the plugins executed by cmd.
+// The chain is only available to the plugins when the command is executed for an app.
+func newPluginClientAPI(cmd *cobra.Command) plugin.ClientAPI {
+	var options []plugin.APIOption
+
+	// plugin commands don't always define the path flag, the working directory is used then
+	appPath := flagGetPath(cmd)
+	if appPath == "" {
+		appPath = "."
+	}
+
+	if cosmosanalysis.IsChainPath(appPath) == nil {
+		var chainOption []chain.Option
+		if config, _ := cmd.Flags().GetString(flagConfig); config != "" {
+			chainOption = append(chainOption, chain.ConfigFile(config))
+		}
+
+		if c, err := newChainWithHomeFlags(cmd, chainOption...); err == nil {
+			options = append(options, plugin.WithChain(c))
+		}
+	}
+
+	return plugin.NewClientAPI(cmd.Context(), options...)
+}
+
 func findCommandByPath(cmd *cobra.Command, cmdPath string) *cobra.Command {
 	if cmd.CommandPath() == cmdPath {
 		return cmd
@@ -448,8 +525,14 @@ Example:
 				Global: global,
 			}
 
+			lock, err := parsePluginsLock(conf)
+			if err != nil {
+				return err
+			}
+
 			pluginsOptions := []plugin.Option{
 				plugin.CollectEvents(session.EventBus()),
+				plugin.WithLock(lock, lock),
 			}
 
 			var pluginArgs []string
@@ -534,6 +617,16 @@ func NewPluginRemove() *cobra.Command {
 				return err
 			}
 
+			lock, err := parsePluginsLock(conf)
+			if err != nil {
+				return err
+			}
+			if lock != nil && lock.Remove(args[0]) {
+				if err := lock.Save(); err != nil {
+					return err
+				}
+			}
+
 			s.Printf("%s %s removed\n", icons.OK, args[0])
 			s.Printf("\t%s updated\n", conf.Path())
 
@@ -600,11 +693,20 @@ func NewPluginDescribe() *cobra.Command {
 
 			for _, p := range plugins {
 				if p.Path == args[0] {
+					if p.Interface == nil {
+						return fmt.Errorf("error while loading plugin: %w", p.Error)
+					}
 					manifest, err := p.Interface.Manifest()
 					if err != nil {
 						return fmt.Errorf("error while loading plugin manifest: %w", err)
 					}
 					s.Printf("Plugin '%s':\n", args[0])
+					igniteVersion := manifest.IgniteVersion
+					if igniteVersion == "" {
+						igniteVersion = "any"
+					}
+					s.Printf("%s Ignite version: %s\n", icons.Bullet, igniteVersion)
+					s.Printf("%s Interface version: %d\n", icons.Bullet, manifest.InterfaceVersion)
 					s.Printf("%s %d Command(s):\n", icons.Command, len(manifest.Commands))
 					for i, c := range manifest.Commands {
 						cmdPath := fmt.Sprintf("%s %s", c.PlaceCommandUnderFull(), c.Use)
@@ -614,6 +716,10 @@ func NewPluginDescribe() *cobra.Command {
 					for i, h := range manifest.Hooks {
 						s.Printf("\t%d) '%s' on command '%s'\n", i+1, h.Name, h.PlaceHookOnFull())
 					}
+					s.Printf("%s %d Serve event(s):\n", icons.Hook, len(manifest.ServeEvents))
+					for i, t := range manifest.ServeEvents {
+						s.Printf("\t%d) '%s'\n", i+1, t)
+					}
 					break
 				}
 			}
@@ -627,6 +733,10 @@ func printPlugins(session *cliui.Session) error {
 	var (
 		entries     [][]string
 		buildStatus = func(p *plugin.Plugin) string {
+			var incompatibleErr *plugin.IncompatibleError
+			if errors.As(p.Error, &incompatibleErr) {
+				return fmt.Sprintf("%s Incompatible: %s, %s", icons.NotOK, incompatibleErr.Reason, incompatibleErr.Fix)
+			}
 			if p.Error != nil {
 				return fmt.Sprintf("%s Error: %v", icons.NotOK, p.Error)
 			}
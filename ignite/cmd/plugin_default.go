@@ -79,6 +79,11 @@ func newPluginInstallCmd(dp defaultPlugin) *cobra.Command {
 				return err
 			}
 
+			lock, err := parsePluginsLock(cfg)
+			if err != nil {
+				return err
+			}
+
 			session := cliui.New()
 			defer session.End()
 
@@ -87,6 +92,7 @@ func newPluginInstallCmd(dp defaultPlugin) *cobra.Command {
 				cmd.Context(),
 				[]pluginsconfig.Plugin{pluginCfg},
 				plugin.CollectEvents(session.EventBus()),
+				plugin.WithLock(lock, lock),
 			)
 			if err != nil {
 				return err
@@ -0,0 +1,152 @@
+package ignitecmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/chain"
+	"github.com/ignite/cli/ignite/services/plugin"
+)
+
+const (
+	// pluginServeEventsQueueSize is the number of serve events queued for each
+	// plugin, the events are dropped when the plugin doesn't handle them fast enough.
+	pluginServeEventsQueueSize = 100
+
+	// pluginServeEventTimeout is the time a plugin has to handle a serve event.
+	pluginServeEventTimeout = 30 * time.Second
+)
+
+// checkPluginServeEvents ensures that the plugin only subscribes to known
+// lifecycle events of a served chain.
+func checkPluginServeEvents(p *plugin.Plugin, eventTypes []plugin.ServeEventType) {
+	for _, t := range eventTypes {
+		if !slices.Contains(plugin.ServeEventTypes, t) {
+			p.Error = fmt.Errorf("unknown serve event %q", t)
+			return
+		}
+	}
+}
+
+// newPluginsLifecycleHandler returns a handler that delivers the lifecycle
+// events of a served chain to the plugins that subscribe to them.
+// The events are delivered in order to each plugin by a goroutine, so plugins
+// don't block the chain, and the errors of the plugins are sent to ev.
+// It returns nil when no plugin subscribes to serve events.
+func newPluginsLifecycleHandler(cmd *cobra.Command, ev events.Bus) chain.LifecycleHandler {
+	eventTypes := make(map[*plugin.Plugin][]plugin.ServeEventType)
+	for _, p := range plugins {
+		if p.Error != nil {
+			continue
+		}
+		manifest, err := p.Interface.Manifest()
+		if err != nil || len(manifest.ServeEvents) == 0 {
+			continue
+		}
+		eventTypes[p] = manifest.ServeEvents
+	}
+	if len(eventTypes) == 0 {
+		return nil
+	}
+
+	var (
+		api         = newPluginClientAPI(cmd)
+		subscribers = make(map[plugin.ServeEventType][]chan plugin.ServeEvent)
+	)
+	for p, types := range eventTypes {
+		queue := make(chan plugin.ServeEvent, pluginServeEventsQueueSize)
+		for _, t := range types {
+			subscribers[t] = append(subscribers[t], queue)
+		}
+
+		go deliverPluginServeEvents(cmd.Context(), p, api, queue, ev)
+	}
+
+	return func(e chain.LifecycleEvent) error {
+		event := newPluginServeEvent(e)
+
+		var errs []error
+		for _, queue := range subscribers[event.Type] {
+			select {
+			case queue <- event:
+			default:
+				errs = append(errs, fmt.Errorf("%s event dropped because a plugin doesn't handle the events fast enough", event.Type))
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// deliverPluginServeEvents delivers the queued serve events to a plugin until ctx is done.
+func deliverPluginServeEvents(
+	ctx context.Context,
+	p *plugin.Plugin,
+	api plugin.ClientAPI,
+	queue <-chan plugin.ServeEvent,
+	ev events.Bus,
+) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-queue:
+			if err := executePluginServeEvent(ctx, p, event, api); err != nil {
+				ev.SendError(fmt.Errorf("plugin %q: %s event handler failed: %w", p.Path, event.Type, err))
+			}
+		}
+	}
+}
+
+// executePluginServeEvent executes the serve event handler of a plugin and
+// stops waiting for it after pluginServeEventTimeout.
+func executePluginServeEvent(ctx context.Context, p *plugin.Plugin, event plugin.ServeEvent, api plugin.ClientAPI) error {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- p.Interface.ExecuteServeEvent(event, api)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(pluginServeEventTimeout):
+		return fmt.Errorf("timeout after %s", pluginServeEventTimeout)
+	}
+}
+
+// newPluginServeEvent converts a lifecycle event of a served chain to the
+// serve event delivered to the plugins.
+func newPluginServeEvent(e chain.LifecycleEvent) plugin.ServeEvent {
+	event := plugin.ServeEvent{
+		Type:    plugin.ServeEventType(e.Type),
+		ChainID: e.ChainID,
+	}
+	if p := e.BuildFailed; p != nil {
+		event.BuildFailed = &plugin.BuildFailedPayload{Error: p.Error}
+	}
+	if p := e.BuildSucceeded; p != nil {
+		event.BuildSucceeded = &plugin.BuildSucceededPayload{Binary: p.Binary}
+	}
+	if p := e.ChainInitialized; p != nil {
+		event.ChainInitialized = &plugin.ChainInitializedPayload{Home: p.Home}
+	}
+	if p := e.NodeStarted; p != nil {
+		event.NodeStarted = &plugin.NodeStartedPayload{
+			RPCAddress: p.RPCAddress,
+			APIAddress: p.APIAddress,
+			Home:       p.Home,
+			Height:     p.Height,
+		}
+	}
+	if p := e.NewBlock; p != nil {
+		event.NewBlock = &plugin.NewBlockPayload{Height: p.Height}
+	}
+	return event
+}
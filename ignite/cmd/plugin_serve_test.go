@@ -0,0 +1,174 @@
+package ignitecmd
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	pluginsconfig "github.com/ignite/cli/ignite/config/plugins"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/chain"
+	"github.com/ignite/cli/ignite/services/plugin"
+	"github.com/ignite/cli/ignite/services/plugin/mocks"
+)
+
+func TestPluginsLifecycleHandler(t *testing.T) {
+	// Arrange
+	newPlugin := func(path string, events ...plugin.ServeEventType) (*plugin.Plugin, *mocks.PluginInterface) {
+		pi := mocks.NewPluginInterface(t)
+		pi.EXPECT().Manifest().Return(plugin.Manifest{ServeEvents: events}, nil)
+		return &plugin.Plugin{
+			Plugin:    pluginsconfig.Plugin{Path: path},
+			Interface: pi,
+		}, pi
+	}
+
+	deployer, deployerInterface := newPlugin("deployer", plugin.ServeNodeStarted, plugin.ServeNewBlock)
+	seeder, seederInterface := newPlugin("seeder", plugin.ServeNodeStarted)
+	other, _ := newPlugin("other")
+
+	defer func(p []*plugin.Plugin) { plugins = p }(plugins)
+	plugins = []*plugin.Plugin{deployer, seeder, other, {Error: errors.New("oups")}}
+
+	var (
+		event = chain.LifecycleEvent{
+			Type:        chain.LifecycleNodeStarted,
+			ChainID:     "mars",
+			NodeStarted: &chain.NodeStartedPayload{RPCAddress: "http://localhost:26657"},
+		}
+		want = plugin.ServeEvent{
+			Type:        plugin.ServeNodeStarted,
+			ChainID:     "mars",
+			NodeStarted: &plugin.NodeStartedPayload{RPCAddress: "http://localhost:26657"},
+		}
+		delivered = make(chan struct{})
+	)
+	deployerInterface.EXPECT().ExecuteServeEvent(want, mock.Anything).
+		RunAndReturn(func(plugin.ServeEvent, plugin.ClientAPI) error {
+			close(delivered)
+			return nil
+		}).Once()
+	seederInterface.EXPECT().ExecuteServeEvent(want, mock.Anything).Return(errors.New("seed failed")).Once()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(ctx)
+
+	bus := events.NewBus()
+	defer bus.Stop()
+
+	handler := newPluginsLifecycleHandler(cmd, bus)
+	require.NotNil(t, handler)
+
+	// Act
+	err := handler(event)
+
+	// Assert
+	require.NoError(t, err)
+	require.NoError(t, handler(chain.LifecycleEvent{Type: chain.LifecycleBuildStarted}))
+	require.Equal(t, colors.Error(`plugin "seeder": node-started event handler failed: seed failed`), (<-bus.Events()).Message)
+	<-delivered
+}
+
+func TestPluginsLifecycleHandlerWithoutSubscribers(t *testing.T) {
+	pi := mocks.NewPluginInterface(t)
+	pi.EXPECT().Manifest().Return(plugin.Manifest{}, nil)
+
+	defer func(p []*plugin.Plugin) { plugins = p }(plugins)
+	plugins = []*plugin.Plugin{{Interface: pi}}
+
+	require.Nil(t, newPluginsLifecycleHandler(&cobra.Command{}, events.Bus{}))
+}
+
+func TestLinkPluginServeEvents(t *testing.T) {
+	// Arrange
+	pi := mocks.NewPluginInterface(t)
+	pi.EXPECT().Manifest().Return(plugin.Manifest{
+		ServeEvents: []plugin.ServeEventType{plugin.ServeNodeStarted, "node-stopped"},
+	}, nil)
+	p := &plugin.Plugin{
+		Plugin:    pluginsconfig.Plugin{Path: "foo"},
+		Interface: pi,
+	}
+
+	// Act
+	linkPlugins(buildRootCmd(), []*plugin.Plugin{p})
+
+	// Assert
+	require.EqualError(t, p.Error, `unknown serve event "node-stopped"`)
+}
+
+func TestPluginServeEventsMatchLifecycleEvents(t *testing.T) {
+	// every lifecycle event must be delivered to the plugins with the same type
+	require.Len(t, plugin.ServeEventTypes, len(chain.LifecycleEventTypes))
+	for _, eventType := range chain.LifecycleEventTypes {
+		require.Contains(t, plugin.ServeEventTypes, plugin.ServeEventType(eventType))
+	}
+
+	// the payloads of the events must define the same fields
+	lifecycleEvent := reflect.TypeOf(chain.LifecycleEvent{})
+	serveEvent := reflect.TypeOf(plugin.ServeEvent{})
+	require.Equal(t, lifecycleEvent.NumField(), serveEvent.NumField())
+	for i := 0; i < lifecycleEvent.NumField(); i++ {
+		field := lifecycleEvent.Field(i)
+		if field.Type.Kind() != reflect.Pointer {
+			continue
+		}
+
+		serveField, ok := serveEvent.FieldByName(field.Name)
+		require.True(t, ok, "serve event payload %s is missing", field.Name)
+
+		payload, servePayload := field.Type.Elem(), serveField.Type.Elem()
+		require.Equal(t, payload.NumField(), servePayload.NumField(), "payload %s", field.Name)
+		for j := 0; j < payload.NumField(); j++ {
+			f, ok := servePayload.FieldByName(payload.Field(j).Name)
+			require.True(t, ok, "payload %s field %s is missing", field.Name, payload.Field(j).Name)
+			require.Equal(t, payload.Field(j).Type, f.Type)
+		}
+	}
+}
+
+func TestNewPluginServeEvent(t *testing.T) {
+	// Arrange
+	e := chain.LifecycleEvent{
+		Type:             chain.LifecycleNodeStarted,
+		ChainID:          "mars-1",
+		BuildFailed:      &chain.BuildFailedPayload{Error: "failed"},
+		BuildSucceeded:   &chain.BuildSucceededPayload{Binary: "marsd"},
+		ChainInitialized: &chain.ChainInitializedPayload{Home: "/home/mars"},
+		NodeStarted: &chain.NodeStartedPayload{
+			RPCAddress: "http://0.0.0.0:26657",
+			APIAddress: "http://0.0.0.0:1317",
+			Home:       "/home/mars",
+			Height:     1,
+		},
+		NewBlock: &chain.NewBlockPayload{Height: 2},
+	}
+
+	// Act
+	event := newPluginServeEvent(e)
+
+	// Assert
+	require.Equal(t, plugin.ServeEvent{
+		Type:             plugin.ServeNodeStarted,
+		ChainID:          "mars-1",
+		BuildFailed:      &plugin.BuildFailedPayload{Error: "failed"},
+		BuildSucceeded:   &plugin.BuildSucceededPayload{Binary: "marsd"},
+		ChainInitialized: &plugin.ChainInitializedPayload{Home: "/home/mars"},
+		NodeStarted: &plugin.NodeStartedPayload{
+			RPCAddress: "http://0.0.0.0:26657",
+			APIAddress: "http://0.0.0.0:1317",
+			Home:       "/home/mars",
+			Height:     1,
+		},
+		NewBlock: &plugin.NewBlockPayload{Height: 2},
+	}, event)
+}
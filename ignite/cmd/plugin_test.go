@@ -80,7 +80,8 @@ func TestLinkPluginCmds(t *testing.T) {
 			mock.MatchedBy(func(execCmd plugin.ExecutedCommand) bool {
 				return cmd.Use == execCmd.Use
 			}),
-		).Run(func(execCmd plugin.ExecutedCommand) {
+			mock.Anything,
+		).Run(func(execCmd plugin.ExecutedCommand, _ plugin.ClientAPI) {
 			// Assert execCmd is populated correctly
 			assert.True(t, strings.HasSuffix(execCmd.Path, cmd.Use), "wrong path %s", execCmd.Path)
 			assert.Equal(t, args, execCmd.Args)
@@ -380,8 +381,8 @@ func TestLinkPluginHooks(t *testing.T) {
 						hook.PlaceHookOn == execHook.PlaceHookOn
 				})
 			}
-			asserter := func(hook plugin.Hook) func(hook plugin.ExecutedHook) {
-				return func(execHook plugin.ExecutedHook) {
+			asserter := func(hook plugin.Hook) func(hook plugin.ExecutedHook, api plugin.ClientAPI) {
+				return func(execHook plugin.ExecutedHook, _ plugin.ClientAPI) {
 					assert.True(t, strings.HasSuffix(execHook.ExecutedCommand.Path, hook.PlaceHookOn), "wrong path %q want %q", execHook.ExecutedCommand.Path, hook.PlaceHookOn)
 					assert.Equal(t, args, execHook.ExecutedCommand.Args)
 					assertFlags(t, expectedFlags, execHook.ExecutedCommand)
@@ -390,7 +391,7 @@ func TestLinkPluginHooks(t *testing.T) {
 			}
 			var lastPre *mock.Call
 			for _, hook := range hooks {
-				pre := p.EXPECT().ExecuteHookPre(matcher(hook)).
+				pre := p.EXPECT().ExecuteHookPre(matcher(hook), mock.Anything).
 					Run(asserter(hook)).Return(nil).Call
 				if lastPre != nil {
 					pre.NotBefore(lastPre)
@@ -398,9 +399,9 @@ func TestLinkPluginHooks(t *testing.T) {
 				lastPre = pre
 			}
 			for _, hook := range hooks {
-				post := p.EXPECT().ExecuteHookPost(matcher(hook)).
+				post := p.EXPECT().ExecuteHookPost(matcher(hook), mock.Anything).
 					Run(asserter(hook)).Return(nil).Call
-				cleanup := p.EXPECT().ExecuteHookCleanUp(matcher(hook)).
+				cleanup := p.EXPECT().ExecuteHookCleanUp(matcher(hook), mock.Anything).
 					Run(asserter(hook)).Return(nil).Call
 				post.NotBefore(lastPre)
 				cleanup.NotBefore(post)
@@ -89,6 +89,10 @@ If you're building an application with custom IBC logic, you might need to
 scaffold IBC packets. An IBC packet represents the data sent from one blockchain
 to another. You can only scaffold IBC packets in IBC-enabled modules scaffolded
 with an "--ibc" flag. Note that the default module is not IBC-enabled.
+
+Lists, maps, singles, types, messages, queries and packets can be removed once
+scaffolded with the remove command, for example, "ignite scaffold remove list
+post".
 `,
 		Aliases: []string{"s"},
 		Args:    cobra.ExactArgs(1),
@@ -100,10 +104,12 @@ with an "--ibc" flag. Note that the default module is not IBC-enabled.
 		NewScaffoldList(),
 		NewScaffoldMap(),
 		NewScaffoldSingle(),
+		NewScaffoldField(),
 		NewScaffoldType(),
 		NewScaffoldMessage(),
 		NewScaffoldQuery(),
 		NewScaffoldPacket(),
+		NewScaffoldRemove(),
 		NewScaffoldBandchain(),
 		NewScaffoldVue(),
 		NewScaffoldReact(),
@@ -151,6 +157,7 @@ func scaffoldType(
 	cmd *cobra.Command,
 	args []string,
 	kind scaffolder.AddTypeKind,
+	options ...scaffolder.AddTypeOption,
 ) error {
 	var (
 		typeName          = args[0]
@@ -162,8 +169,6 @@ func scaffoldType(
 		appPath           = flagGetPath(cmd)
 	)
 
-	var options []scaffolder.AddTypeOption
-
 	if len(fields) > 0 {
 		options = append(options, scaffolder.TypeWithFields(fields...))
 	}
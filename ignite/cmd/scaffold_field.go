@@ -0,0 +1,168 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/services/scaffolder"
+)
+
+// NewScaffoldField returns a command that groups the commands to change the fields of a type.
+func NewScaffoldField() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "field [command]",
+		Short: "Add or remove fields of a list, map or single type",
+		Long: `Add or remove fields of a type scaffolded with the list, map or single commands.
+
+The fields are added to or removed from the proto messages of the type, and the
+code generated for the type, like the CLI commands, the messages, the message
+server handlers, the simulation and the tests, is generated again with the new
+fields:
+
+	ignite scaffold map post title body --module blog
+	ignite scaffold field add post tags:array.string --module blog
+	ignite scaffold field remove post body --module blog
+
+The numbers and names of the removed fields are reserved in the proto messages,
+so new fields never reuse them and a removed field can't be added again.
+
+Files modified after the type was scaffolded are not updated, they are listed
+after the command runs so they can be updated manually.
+`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(
+		NewScaffoldFieldAdd(),
+		NewScaffoldFieldRemove(),
+	)
+
+	return c
+}
+
+// NewScaffoldFieldAdd returns a command to add fields to a type.
+func NewScaffoldFieldAdd() *cobra.Command {
+	c := &cobra.Command{
+		Use:     "add [type] [field]...",
+		Short:   "Add fields to a list, map or single type",
+		Args:    cobra.MinimumNArgs(2),
+		PreRunE: migrationPreRunHandler,
+		RunE:    scaffoldFieldAddHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().String(flagModule, "", "module of the type. Default: app's main module")
+
+	return c
+}
+
+// NewScaffoldFieldRemove returns a command to remove fields from a type.
+func NewScaffoldFieldRemove() *cobra.Command {
+	c := &cobra.Command{
+		Use:     "remove [type] [field]...",
+		Short:   "Remove fields from a list, map or single type",
+		Args:    cobra.MinimumNArgs(2),
+		PreRunE: migrationPreRunHandler,
+		RunE:    scaffoldFieldRemoveHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().String(flagModule, "", "module of the type. Default: app's main module")
+
+	return c
+}
+
+func scaffoldFieldAddHandler(cmd *cobra.Command, args []string) error {
+	var (
+		typeName   = args[0]
+		fields     = args[1:]
+		moduleName = flagGetModule(cmd)
+		appPath    = flagGetPath(cmd)
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
+	defer session.End()
+
+	sc, err := scaffolder.New(appPath)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sm, notUpdated, err := sc.AddFields(cmd.Context(), cacheStorage, placeholder.New(), moduleName, typeName, fields)
+	if err != nil {
+		return err
+	}
+
+	return printFieldsModifications(session, sm, notUpdated, "🎉 Added fields to `%[1]v`.", typeName)
+}
+
+func scaffoldFieldRemoveHandler(cmd *cobra.Command, args []string) error {
+	var (
+		typeName   = args[0]
+		fields     = args[1:]
+		moduleName = flagGetModule(cmd)
+		appPath    = flagGetPath(cmd)
+	)
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
+	defer session.End()
+
+	sc, err := scaffolder.New(appPath)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sm, notUpdated, err := sc.RemoveFields(cmd.Context(), cacheStorage, placeholder.New(), moduleName, typeName, fields)
+	if err != nil {
+		return err
+	}
+
+	return printFieldsModifications(session, sm, notUpdated, "🎉 Removed fields from `%[1]v`.", typeName)
+}
+
+func printFieldsModifications(
+	session *cliui.Session,
+	sm xgenny.SourceModification,
+	notUpdated []string,
+	successMsg,
+	typeName string,
+) error {
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	if len(notUpdated) > 0 {
+		session.Println(colors.Info("\nThe following files were modified after the type was scaffolded and must be updated manually:"))
+		for _, path := range notUpdated {
+			relPath, err := relativePath(path)
+			if err != nil {
+				return err
+			}
+			session.Println(relPath)
+		}
+	}
+	session.Printf("\n"+successMsg+"\n\n", typeName)
+
+	return nil
+}
@@ -51,7 +51,7 @@ business logic you will implement next.
 By default, all fields are assumed to be strings. If you want a field of a
 different type, you can specify it after a colon ":". The following types are
 supported: string, bool, int, uint, coin, array.string, array.int, array.uint,
-array.coin. An example of using field types:
+array.coin, bytes, address, decimal, timestamp and enum. An example of using field types:
 
 	ignite scaffold list pool amount:coin tags:array.string height:int
 
@@ -68,10 +68,20 @@ Supported types:
 | array.uint   | uints   | no    | []uint64  | List of unsigned integers types |
 | coin         | -       | no    | sdk.Coin  | Cosmos SDK coin type            |
 | array.coin   | coins   | no    | sdk.Coins | List of Cosmos SDK coin types   |
+| bytes        | -       | no    | []byte    | Raw bytes type                  |
+| address      | -       | yes   | string    | Bech32 account address type     |
+| decimal      | -       | no    | sdk.Dec   | Cosmos SDK decimal type         |
+| timestamp    | -       | no    | time.Time | Timestamp type                  |
+| enum         | -       | no    | enum      | Protobuf enumeration type       |
 
 "Index" indicates whether the type can be used as an index in
 "ignite scaffold map".
 
+Enum fields define their values after the type, separated by "|". Each enum
+is defined in its own proto file inside the module:
+
+	ignite scaffold list task title status:enum:pending|active|done
+
 Ignite also supports custom types:
 
 	ignite scaffold list product-details name desc
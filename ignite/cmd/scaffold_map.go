@@ -7,7 +7,8 @@ import (
 )
 
 const (
-	FlagIndexes = "index"
+	FlagIndexes          = "index"
+	flagSecondaryIndexes = "secondary-index"
 )
 
 // NewScaffoldMap returns a new command to scaffold a map.
@@ -51,6 +52,19 @@ With this command, you would get a "Product" value indexed by both a category
 and a GUID (globally unique ID). This will let you programmatically fetch
 product values that have the same category but are using different GUIDs.
 
+Index fields are encoded in the store key according to their type, so values
+can be indexed by multiple fields of any index type:
+
+	ignite scaffold map order amount:uint --index owner:address,id:uint
+
+To list values by one of their fields, use the "--secondary-index" flag. A
+secondary index is maintained in the store for each of these fields, along with
+a paginated query and a CLI command to list the values that have a given value
+for the field:
+
+	ignite scaffold map order owner:address denom amount:uint --index id:uint --secondary-index owner,denom
+	marketd q market list-order-by-owner cosmos1...
+
 Since the behavior of "list" and "map" scaffolding is very similar, you can use
 the "--no-message", "--module", "--signer" flags as well as the colon syntax for
 custom types.
@@ -66,6 +80,7 @@ custom types.
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().AddFlagSet(flagSetScaffoldType())
 	c.Flags().StringSlice(FlagIndexes, []string{"index"}, "fields that index the value")
+	c.Flags().StringSlice(flagSecondaryIndexes, nil, "fields of the value to list the values by")
 
 	return c
 }
@@ -76,5 +91,15 @@ func scaffoldMapHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	return scaffoldType(cmd, args, scaffolder.MapType(indexes...))
+	secondaryIndexes, err := cmd.Flags().GetStringSlice(flagSecondaryIndexes)
+	if err != nil {
+		return err
+	}
+
+	var options []scaffolder.AddTypeOption
+	if len(secondaryIndexes) > 0 {
+		options = append(options, scaffolder.TypeWithSecondaryIndexes(secondaryIndexes...))
+	}
+
+	return scaffoldType(cmd, args, scaffolder.MapType(indexes...), options...)
 }
@@ -0,0 +1,105 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/services/scaffolder"
+)
+
+const statusRemoving = "Removing..."
+
+// NewScaffoldRemove returns a command to remove a scaffolded component.
+func NewScaffoldRemove() *cobra.Command {
+	kinds := make([]string, 0, len(scaffolder.ComponentKinds()))
+	for _, kind := range scaffolder.ComponentKinds() {
+		kinds = append(kinds, string(kind))
+	}
+
+	c := &cobra.Command{
+		Use:   "remove [kind] [name]",
+		Short: "Remove a previously scaffolded component",
+		Long: fmt.Sprintf(`Remove a component previously scaffolded in a module.
+
+The supported kinds of components are: %s.
+
+The files created when the component was scaffolded are deleted and the code
+added to the existing files, like the registration of the messages in the codec,
+the CLI commands, the genesis state or the simulation, is removed:
+
+	ignite scaffold map pots amount:uint
+	ignite scaffold remove map pots
+
+Ignite keeps track of the changes made by the scaffolding commands in its cache,
+only the components scaffolded since then can be removed. Removing the cache
+with the "--clear-cache" flag also removes the history of scaffolded components.
+
+When a file created with a component is modified by scaffolding another
+component, the other component must be removed first. The component can't be
+removed either when the code added to the existing files was changed.
+`, strings.Join(kinds, ", ")),
+		Args:    cobra.ExactArgs(2),
+		PreRunE: gitChangesConfirmPreRunHandler,
+		RunE:    scaffoldRemoveHandler,
+	}
+
+	flagSetPath(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().String(flagModule, "", "module of the component. Default: app's main module")
+
+	return c
+}
+
+func scaffoldRemoveHandler(cmd *cobra.Command, args []string) error {
+	var (
+		kind       = scaffolder.ComponentKind(args[0])
+		name       = args[1]
+		moduleName = flagGetModule(cmd)
+		appPath    = flagGetPath(cmd)
+	)
+
+	if !isComponentKind(kind) {
+		return fmt.Errorf("unknown component kind %q", kind)
+	}
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusRemoving))
+	defer session.End()
+
+	sc, err := scaffolder.New(appPath)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sm, removed, err := sc.RemoveComponent(cmd.Context(), cacheStorage, kind, moduleName, name)
+	if err != nil {
+		return err
+	}
+
+	modificationsStr, err := sourceModificationToString(sm, removed...)
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🗑  Removed the %s `%s`.\n\n", kind, name)
+
+	return nil
+}
+
+func isComponentKind(kind scaffolder.ComponentKind) bool {
+	for _, k := range scaffolder.ComponentKinds() {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,9 @@
 package base
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/imdario/mergo"
 
 	"github.com/ignite/cli/ignite/config/chain/version"
@@ -27,6 +30,50 @@ var (
 	DefaultPProfAddress = "0.0.0.0:6060"
 )
 
+const (
+	// WatchActionRebuild rebuilds the app and restarts the chain keeping its state.
+	WatchActionRebuild = "rebuild"
+
+	// WatchActionProto generates the Go code from the proto files without rebuilding the app.
+	WatchActionProto = "proto"
+
+	// WatchActionClients generates the configured clients without rebuilding the app.
+	WatchActionClients = "clients"
+
+	// WatchActionRestart restarts the chain without rebuilding the app.
+	WatchActionRestart = "restart"
+
+	// WatchActionIgnore ignores the changes.
+	WatchActionIgnore = "ignore"
+)
+
+// WatchActions contains the actions that can be applied when a watched file changes.
+var WatchActions = []string{
+	WatchActionRebuild,
+	WatchActionProto,
+	WatchActionClients,
+	WatchActionRestart,
+	WatchActionIgnore,
+}
+
+const (
+	// VestingContinuous vests the coins linearly between the start and end times.
+	VestingContinuous = "continuous"
+
+	// VestingDelayed vests all the coins at the end time.
+	VestingDelayed = "delayed"
+
+	// VestingPeriodic vests the coins of each period at the end of the period.
+	VestingPeriodic = "periodic"
+)
+
+// VestingTypes contains the supported vesting schedule types.
+var VestingTypes = []string{
+	VestingContinuous,
+	VestingDelayed,
+	VestingPeriodic,
+}
+
 // Account holds the options related to setting up Cosmos wallets.
 type Account struct {
 	Name     string   `yaml:"name"`
@@ -37,6 +84,70 @@ type Account struct {
 
 	// The RPCAddress off the chain that account is issued at.
 	RPCAddress string `yaml:"rpc_address,omitempty"`
+
+	// Vesting configures the vesting schedule of the account coins.
+	Vesting *Vesting `yaml:"vesting,omitempty"`
+
+	// Multisig creates the account as a multisig of other accounts.
+	Multisig *Multisig `yaml:"multisig,omitempty"`
+
+	// Module is the name of a module to fund its module account instead of creating a new account.
+	Module string `yaml:"module,omitempty"`
+}
+
+// Vesting configures the vesting schedule of an account.
+//
+// Times are RFC 3339 timestamps or durations relative to the time when the chain
+// is initialized, for example "720h".
+type Vesting struct {
+	// Type is the type of vesting schedule: continuous, delayed or periodic.
+	Type string `yaml:"type"`
+
+	// Coins are the vesting coins, by default all the account coins vest.
+	// It's not used by periodic schedules where the coins of each period vest.
+	Coins []string `yaml:"coins,omitempty"`
+
+	// StartTime is the start time of continuous and periodic schedules.
+	StartTime string `yaml:"start_time,omitempty"`
+
+	// EndTime is the end time of continuous and delayed schedules.
+	EndTime string `yaml:"end_time,omitempty"`
+
+	// Periods are the consecutive periods of periodic schedules.
+	Periods []VestingPeriod `yaml:"periods,omitempty"`
+}
+
+// VestingPeriod is a period of a periodic vesting schedule.
+type VestingPeriod struct {
+	// Length is the duration of the period, for example "24h".
+	Length string `yaml:"length"`
+
+	// Coins are the coins that vest at the end of the period.
+	Coins []string `yaml:"coins"`
+}
+
+// ParseVestingTime parses a vesting time that is either an RFC 3339 timestamp
+// or a duration relative to now.
+func ParseVestingTime(s string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		return now.Add(d), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, expected an RFC 3339 time or a duration", s)
+	}
+
+	return t, nil
+}
+
+// Multisig configures a multisig account.
+type Multisig struct {
+	// Threshold is the number of signatures required to sign the transactions.
+	Threshold int `yaml:"threshold"`
+
+	// Accounts are the names of the accounts that can sign the transactions.
+	Accounts []string `yaml:"accounts"`
 }
 
 // Build holds build configs.
@@ -127,6 +238,37 @@ type Faucet struct {
 
 	// Port number for faucet server to listen at.
 	Port int `yaml:"port,omitempty"`
+
+	// ChallengeDifficulty is the number of leading zero bits of the proof-of-work
+	// challenge that clients must solve before each transfer. Zero disables it.
+	ChallengeDifficulty int `yaml:"challenge_difficulty,omitempty"`
+
+	// ClientIPHeader is the HTTP header to read client IPs from when the faucet
+	// is served behind a reverse proxy, like "X-Forwarded-For".
+	ClientIPHeader string `yaml:"client_ip_header,omitempty"`
+
+	// ExemptLoopback disables the per client IP limit for clients running in the same host.
+	ExemptLoopback bool `yaml:"exempt_loopback,omitempty"`
+}
+
+// Serve holds the options of the serve command.
+type Serve struct {
+	// Watch contains the rules that define the action applied when a file of
+	// the app changes. The first rule that matches a changed file is applied.
+	Watch []WatchRule `yaml:"watch,omitempty"`
+}
+
+// WatchRule defines the action applied when the files matching the rule change.
+type WatchRule struct {
+	// Paths contains the glob patterns of the files that match the rule.
+	// Patterns are relative to the app directory and "**" matches any number of directories.
+	Paths []string `yaml:"paths"`
+
+	// Exclude contains the glob patterns of the files that don't match the rule.
+	Exclude []string `yaml:"exclude,omitempty"`
+
+	// Action is the action to apply when a matching file changes.
+	Action string `yaml:"action"`
 }
 
 // Init overwrites sdk configurations with given values.
@@ -159,9 +301,15 @@ type Config struct {
 	Version  version.Version `yaml:"version"`
 	Build    Build           `yaml:"build,omitempty"`
 	Accounts []Account       `yaml:"accounts"`
-	Faucet   Faucet          `yaml:"faucet,omitempty"`
-	Client   Client          `yaml:"client,omitempty"`
-	Genesis  xyaml.Map       `yaml:"genesis,omitempty"`
+
+	// Seed derives the mnemonics of the accounts that don't define a mnemonic or an
+	// address, so the accounts keep the same addresses when the chain is reset.
+	Seed string `yaml:"seed,omitempty"`
+
+	Faucet  Faucet    `yaml:"faucet,omitempty"`
+	Client  Client    `yaml:"client,omitempty"`
+	Genesis xyaml.Map `yaml:"genesis,omitempty"`
+	Serve   Serve     `yaml:"serve,omitempty"`
 }
 
 // GetVersion returns the config version.
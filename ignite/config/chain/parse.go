@@ -5,10 +5,16 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path"
+	"strings"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"golang.org/x/exp/slices"
 	"gopkg.in/yaml.v2"
 
+	"github.com/ignite/cli/ignite/config/chain/base"
 	"github.com/ignite/cli/ignite/config/chain/version"
 )
 
@@ -125,6 +131,11 @@ func validateConfig(c *Config) error {
 		return &ValidationError{"at least one account is required"}
 	}
 
+	if err := validateAccounts(c.Accounts); err != nil {
+		return err
+	}
+
+	validatorNames := make(map[string]bool)
 	for _, validator := range c.Validators {
 		if validator.Name == "" {
 			return &ValidationError{"validator 'name' is required"}
@@ -133,6 +144,176 @@ func validateConfig(c *Config) error {
 		if validator.Bonded == "" {
 			return &ValidationError{"validator 'bonded' is required"}
 		}
+
+		if validatorNames[validator.Name] {
+			return &ValidationError{fmt.Sprintf("validator '%s' is defined more than once", validator.Name)}
+		}
+
+		validatorNames[validator.Name] = true
+	}
+
+	for i, rule := range c.Serve.Watch {
+		if len(rule.Paths) == 0 {
+			return &ValidationError{fmt.Sprintf("serve watch rule #%d 'paths' is required", i+1)}
+		}
+
+		for _, p := range append(append([]string{}, rule.Paths...), rule.Exclude...) {
+			if _, err := path.Match(p, ""); err != nil {
+				return &ValidationError{fmt.Sprintf("serve watch rule #%d path '%s' is not a valid glob pattern", i+1, p)}
+			}
+		}
+
+		if !slices.Contains(base.WatchActions, rule.Action) {
+			return &ValidationError{fmt.Sprintf(
+				"serve watch rule #%d action '%s' is not valid, expected one of: %s",
+				i+1,
+				rule.Action,
+				strings.Join(base.WatchActions, ", "),
+			)}
+		}
+	}
+
+	return nil
+}
+
+// unfundableModules are the modules with accounts that can't be funded in the genesis
+// because their balance must match the state of the module.
+var unfundableModules = []string{
+	"gov",
+	"distribution",
+	"bonded_tokens_pool",
+	"not_bonded_tokens_pool",
+}
+
+func validateAccounts(accounts []base.Account) error {
+	keyring := make(map[string]bool)
+	for _, account := range accounts {
+		if account.Address == "" && account.Multisig == nil && account.Module == "" {
+			keyring[account.Name] = true
+		}
+	}
+
+	for _, account := range accounts {
+		if account.Module != "" && (account.Address != "" || account.Mnemonic != "" || account.Multisig != nil || account.Vesting != nil) {
+			return &ValidationError{fmt.Sprintf(
+				"account '%s' is a module account and can't have an address, a mnemonic, a multisig or a vesting schedule",
+				account.Name,
+			)}
+		}
+
+		if slices.Contains(unfundableModules, account.Module) {
+			return &ValidationError{fmt.Sprintf(
+				"account '%s' can't fund the %s module account because its balance must match the module state",
+				account.Name,
+				account.Module,
+			)}
+		}
+
+		if m := account.Multisig; m != nil {
+			if account.Address != "" || account.Mnemonic != "" {
+				return &ValidationError{fmt.Sprintf("multisig account '%s' can't have an address or a mnemonic", account.Name)}
+			}
+
+			if m.Threshold < 1 || m.Threshold > len(m.Accounts) {
+				return &ValidationError{fmt.Sprintf(
+					"multisig account '%s' threshold must be between 1 and the number of accounts",
+					account.Name,
+				)}
+			}
+
+			for _, name := range m.Accounts {
+				if !keyring[name] {
+					return &ValidationError{fmt.Sprintf(
+						"multisig account '%s' uses '%s' which is not an account with a key created by Ignite",
+						account.Name,
+						name,
+					)}
+				}
+			}
+		}
+
+		if account.Vesting != nil {
+			if err := validateVesting(account); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateVesting(account base.Account) error {
+	name, v := account.Name, *account.Vesting
+
+	if !slices.Contains(base.VestingTypes, v.Type) {
+		return &ValidationError{fmt.Sprintf(
+			"account '%s' vesting type '%s' is not valid, expected one of: %s",
+			name,
+			v.Type,
+			strings.Join(base.VestingTypes, ", "),
+		)}
+	}
+
+	switch v.Type {
+	case base.VestingContinuous:
+		if v.StartTime == "" || v.EndTime == "" {
+			return &ValidationError{fmt.Sprintf("account '%s' continuous vesting requires 'start_time' and 'end_time'", name)}
+		}
+	case base.VestingDelayed:
+		if v.EndTime == "" {
+			return &ValidationError{fmt.Sprintf("account '%s' delayed vesting requires 'end_time'", name)}
+		}
+	case base.VestingPeriodic:
+		if v.StartTime == "" || len(v.Periods) == 0 {
+			return &ValidationError{fmt.Sprintf("account '%s' periodic vesting requires 'start_time' and 'periods'", name)}
+		}
+
+		var vesting sdk.Coins
+		for i, p := range v.Periods {
+			if d, err := time.ParseDuration(p.Length); err != nil || d <= 0 {
+				return &ValidationError{fmt.Sprintf(
+					"account '%s' vesting period #%d length '%s' is not a valid duration",
+					name,
+					i+1,
+					p.Length,
+				)}
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(strings.Join(p.Coins, ","))
+			if err != nil {
+				return &ValidationError{fmt.Sprintf("account '%s' vesting period #%d coins are not valid: %s", name, i+1, err)}
+			}
+
+			vesting = vesting.Add(coins...)
+		}
+
+		// the original vesting of the account is the sum of the coins of the periods
+		coins, err := sdk.ParseCoinsNormalized(strings.Join(account.Coins, ","))
+		if err != nil {
+			return &ValidationError{fmt.Sprintf("account '%s' coins are not valid: %s", name, err)}
+		}
+
+		if !coins.IsAllGTE(vesting) {
+			return &ValidationError{fmt.Sprintf(
+				"account '%s' periodic vesting coins %s exceed the account coins %s",
+				name,
+				vesting,
+				coins,
+			)}
+		}
+	}
+
+	for _, t := range []struct{ field, value string }{
+		{"start_time", v.StartTime},
+		{"end_time", v.EndTime},
+	} {
+		if t.value == "" {
+			continue
+		}
+
+		if _, err := base.ParseVestingTime(t.value, time.Now()); err != nil {
+			return &ValidationError{fmt.Sprintf("account '%s' vesting '%s': %s", name, t.field, err)}
+		}
 	}
 
 	return nil
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	chainconfig "github.com/ignite/cli/ignite/config/chain"
+	"github.com/ignite/cli/ignite/config/chain/base"
 	"github.com/ignite/cli/ignite/config/chain/version"
 	"github.com/ignite/cli/ignite/config/testdata"
 )
@@ -103,3 +104,225 @@ func TestParseNetworkWithInvalidData(t *testing.T) {
 		),
 	)
 }
+
+func TestParseWithDuplicatedValidators(t *testing.T) {
+	// Arrange
+	r := strings.NewReader(`version: 1
+accounts:
+  - name: alice
+    coins: ["100000000stake"]
+validators:
+  - name: alice
+    bonded: 100000000stake
+  - name: alice
+    bonded: 100000000stake
+`)
+
+	var want *chainconfig.ValidationError
+
+	// Act
+	_, err := chainconfig.Parse(r)
+
+	// Assert
+	require.ErrorAs(t, err, &want)
+	require.Equal(t, "validator 'alice' is defined more than once", want.Message)
+}
+
+func TestParseWithServeWatchRules(t *testing.T) {
+	// Arrange
+	r := strings.NewReader(`version: 1
+accounts:
+  - name: alice
+    coins: ["100000000stake"]
+validators:
+  - name: alice
+    bonded: 100000000stake
+serve:
+  watch:
+    - paths: ["docs/**", "**/*.md"]
+      action: ignore
+    - paths: ["pkg/**"]
+      exclude: ["pkg/**/*_test.go"]
+      action: rebuild
+`)
+
+	// Act
+	cfg, err := chainconfig.Parse(r)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []base.WatchRule{
+		{
+			Paths:  []string{"docs/**", "**/*.md"},
+			Action: base.WatchActionIgnore,
+		},
+		{
+			Paths:   []string{"pkg/**"},
+			Exclude: []string{"pkg/**/*_test.go"},
+			Action:  base.WatchActionRebuild,
+		},
+	}, cfg.Serve.Watch)
+}
+
+func TestParseWithInvalidServeWatchRules(t *testing.T) {
+	cases := []struct {
+		name, rule, want string
+	}{
+		{
+			name: "missing paths",
+			rule: `{action: rebuild}`,
+			want: "serve watch rule #1 'paths' is required",
+		},
+		{
+			name: "invalid pattern",
+			rule: `{paths: ["x/["], action: rebuild}`,
+			want: "serve watch rule #1 path 'x/[' is not a valid glob pattern",
+		},
+		{
+			name: "invalid action",
+			rule: `{paths: ["x/**"], action: deploy}`,
+			want: "serve watch rule #1 action 'deploy' is not valid, expected one of: rebuild, proto, clients, restart, ignore",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			r := strings.NewReader(`version: 1
+accounts:
+  - name: alice
+    coins: ["100000000stake"]
+serve:
+  watch:
+    - ` + tt.rule + `
+`)
+
+			var want *chainconfig.ValidationError
+
+			// Act
+			_, err := chainconfig.Parse(r)
+
+			// Assert
+			require.ErrorAs(t, err, &want)
+			require.Equal(t, tt.want, want.Message)
+		})
+	}
+}
+
+func TestParseWithAccountTypes(t *testing.T) {
+	// Arrange
+	r := strings.NewReader(`version: 1
+accounts:
+  - name: alice
+    coins: ["100000000stake"]
+  - name: bob
+    coins: ["100000000stake"]
+  - name: treasury
+    coins: ["1000stake"]
+    multisig:
+      threshold: 2
+      accounts: [alice, bob]
+  - name: team
+    coins: ["1000stake"]
+    vesting:
+      type: periodic
+      start_time: 1h
+      periods:
+        - length: 24h
+          coins: ["500stake"]
+        - length: 24h
+          coins: ["500stake"]
+  - name: rewards
+    coins: ["1000stake"]
+    module: rewards
+`)
+
+	// Act
+	cfg, err := chainconfig.Parse(r)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, &base.Multisig{Threshold: 2, Accounts: []string{"alice", "bob"}}, cfg.Accounts[2].Multisig)
+	require.Equal(t, &base.Vesting{
+		Type:      base.VestingPeriodic,
+		StartTime: "1h",
+		Periods: []base.VestingPeriod{
+			{Length: "24h", Coins: []string{"500stake"}},
+			{Length: "24h", Coins: []string{"500stake"}},
+		},
+	}, cfg.Accounts[3].Vesting)
+	require.Equal(t, "rewards", cfg.Accounts[4].Module)
+}
+
+func TestParseWithInvalidAccountTypes(t *testing.T) {
+	cases := []struct {
+		name, account, want string
+	}{
+		{
+			name:    "invalid vesting type",
+			account: `{name: team, vesting: {type: cliff}}`,
+			want:    "account 'team' vesting type 'cliff' is not valid, expected one of: continuous, delayed, periodic",
+		},
+		{
+			name:    "continuous vesting without start time",
+			account: `{name: team, vesting: {type: continuous, end_time: 24h}}`,
+			want:    "account 'team' continuous vesting requires 'start_time' and 'end_time'",
+		},
+		{
+			name:    "invalid vesting time",
+			account: `{name: team, vesting: {type: delayed, end_time: tomorrow}}`,
+			want:    `account 'team' vesting 'end_time': invalid time "tomorrow", expected an RFC 3339 time or a duration`,
+		},
+		{
+			name:    "invalid vesting period",
+			account: `{name: team, vesting: {type: periodic, start_time: 1h, periods: [{length: -1h}]}}`,
+			want:    "account 'team' vesting period #1 length '-1h' is not a valid duration",
+		},
+		{
+			name:    "periodic vesting exceeding the account coins",
+			account: `{name: team, coins: [100stake], vesting: {type: periodic, start_time: 1h, periods: [{length: 1h, coins: [60stake]}, {length: 1h, coins: [60stake]}]}}`,
+			want:    "account 'team' periodic vesting coins 120stake exceed the account coins 100stake",
+		},
+		{
+			name:    "invalid multisig threshold",
+			account: `{name: treasury, multisig: {threshold: 3, accounts: [alice]}}`,
+			want:    "multisig account 'treasury' threshold must be between 1 and the number of accounts",
+		},
+		{
+			name:    "unknown multisig account",
+			account: `{name: treasury, multisig: {threshold: 1, accounts: [carol]}}`,
+			want:    "multisig account 'treasury' uses 'carol' which is not an account with a key created by Ignite",
+		},
+		{
+			name:    "module account with address",
+			account: `{name: rewards, module: rewards, address: cosmos1abc}`,
+			want:    "account 'rewards' is a module account and can't have an address, a mnemonic, a multisig or a vesting schedule",
+		},
+		{
+			name:    "module account with a balance bound to the module state",
+			account: `{name: pool, module: bonded_tokens_pool, coins: [100stake]}`,
+			want:    "account 'pool' can't fund the bonded_tokens_pool module account because its balance must match the module state",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			r := strings.NewReader(`version: 1
+accounts:
+  - name: alice
+    coins: ["100000000stake"]
+  - ` + tt.account + `
+`)
+
+			var want *chainconfig.ValidationError
+
+			// Act
+			_, err := chainconfig.Parse(r)
+
+			// Assert
+			require.ErrorAs(t, err, &want)
+			require.Equal(t, tt.want, want.Message)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package plugins
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+)
+
+// LockFilename is the name of the lock file written next to the plugins
+// config file.
+const LockFilename = "plugins.lock"
+
+// Lock pins the remote plugins of a config to the commit they were fetched
+// at and to the checksum of the binaries built from it.
+// It's meant to be committed along with the config, so every one building the
+// plugins gets the exact same binaries.
+type Lock struct {
+	// path to the lock file
+	path string
+
+	Plugins []LockedPlugin `yaml:"plugins"`
+}
+
+// LockedPlugin holds what was resolved for a remote plugin.
+type LockedPlugin struct {
+	// Path is the path of the plugin, as declared in the config.
+	Path string `yaml:"path"`
+	// Commit is the hash of the commit the plugin path was resolved to.
+	Commit string `yaml:"commit"`
+	// GoSum is the SHA256 checksum of the go.sum file of the plugin, it pins
+	// the dependencies of the plugin. It's empty when the plugin doesn't have
+	// a go.sum file.
+	GoSum string `yaml:"go_sum,omitempty"`
+	// GoVersion is the version of Go the plugin binaries are built with.
+	GoVersion string `yaml:"go_version"`
+	// Checksums holds the SHA256 checksums of the plugin binaries, indexed by
+	// GOOS:GOARCH target because binaries differ for each target.
+	Checksums map[string]string `yaml:"checksums"`
+}
+
+// ParseLockDir parses the plugins lock file found in dir.
+// If the lock file doesn't exist, an empty lock is returned, w/o errors.
+func ParseLockDir(dir string) (*Lock, error) {
+	errf := func(err error) (*Lock, error) {
+		return nil, fmt.Errorf("plugin lock parse: %w", err)
+	}
+	l := Lock{
+		path: filepath.Join(dir, LockFilename),
+	}
+
+	f, err := os.Open(l.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &l, nil
+		}
+		return errf(err)
+	}
+	defer f.Close()
+
+	if err := yaml.NewDecoder(f).Decode(&l); err != nil && !errors.Is(err, io.EOF) {
+		return errf(err)
+	}
+	return &l, nil
+}
+
+// Path return the path of the lock file.
+func (l Lock) Path() string {
+	return l.path
+}
+
+// Get returns the locked plugin that has the given path.
+func (l Lock) Get(path string) (LockedPlugin, bool) {
+	for _, lp := range l.Plugins {
+		if lp.Path == path {
+			return lp, true
+		}
+	}
+	return LockedPlugin{}, false
+}
+
+// Set adds or replaces the locked plugin that has the same path than lp.
+func (l *Lock) Set(lp LockedPlugin) {
+	l.Remove(lp.Path)
+	l.Plugins = append(l.Plugins, lp)
+	// keep the file content stable to avoid noise in diffs
+	sort.Slice(l.Plugins, func(i, j int) bool {
+		return l.Plugins[i].Path < l.Plugins[j].Path
+	})
+}
+
+// Remove removes the locked plugin that has the given path.
+// It returns true if the plugin was locked.
+func (l *Lock) Remove(path string) bool {
+	for i, lp := range l.Plugins {
+		if lp.Path == path {
+			l.Plugins = append(l.Plugins[:i], l.Plugins[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Save persists the lock to its path on disk.
+func (l *Lock) Save() error {
+	errf := func(err error) error {
+		return fmt.Errorf("plugin lock save: %w", err)
+	}
+	if l.path == "" {
+		return errf(errors.New("empty path"))
+	}
+	file, err := os.Create(l.path)
+	if err != nil {
+		return errf(err)
+	}
+	defer file.Close()
+	if err := yaml.NewEncoder(file).Encode(l); err != nil {
+		return errf(err)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package plugins_test
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pluginsconfig "github.com/ignite/cli/ignite/config/plugins"
+)
+
+func TestParseLockDir(t *testing.T) {
+	tests := []struct {
+		name            string
+		noLockFile      bool
+		content         string
+		expectedError   string
+		expectedPlugins []pluginsconfig.LockedPlugin
+	}{
+		{
+			name:       "ok: dir doesn't contain any lock",
+			noLockFile: true,
+		},
+		{
+			name:    "ok: lock is empty",
+			content: "",
+		},
+		{
+			name: "ok: lock contains plugins",
+			content: `plugins:
+- path: github.com/ignite/example@main
+  commit: 0d57c3d3c1c5a7a1d3a8f0bca8f4e8c7d6b7e5f4
+  go_sum: 5d41402abc4b2a76b9719d911017c592a5d1f2c3b4e5f60718293a4b5c6d7e8f
+  go_version: go1.20.4
+  checksums:
+    linux:amd64: 3a7bd3e2360a3d29eea436fcfb7e44c735d117c42d1c1835420b6b9942dd4f1b
+`,
+			expectedPlugins: []pluginsconfig.LockedPlugin{
+				{
+					Path:      "github.com/ignite/example@main",
+					Commit:    "0d57c3d3c1c5a7a1d3a8f0bca8f4e8c7d6b7e5f4",
+					GoSum:     "5d41402abc4b2a76b9719d911017c592a5d1f2c3b4e5f60718293a4b5c6d7e8f",
+					GoVersion: "go1.20.4",
+					Checksums: map[string]string{
+						"linux:amd64": "3a7bd3e2360a3d29eea436fcfb7e44c735d117c42d1c1835420b6b9942dd4f1b",
+					},
+				},
+			},
+		},
+		{
+			name:          "fail: lock is invalid",
+			content:       "not yaml !",
+			expectedError: "plugin lock parse: yaml: unmarshal errors:\n  line 1: cannot unmarshal !!str `not yaml !` into plugins.Lock",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			dir := t.TempDir()
+			if !tt.noLockFile {
+				err := os.WriteFile(path.Join(dir, pluginsconfig.LockFilename), []byte(tt.content), 0o644)
+				require.NoError(t, err)
+			}
+
+			// Act
+			lock, err := pluginsconfig.ParseLockDir(dir)
+
+			// Assert
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedPlugins, lock.Plugins)
+			require.Equal(t, path.Join(dir, pluginsconfig.LockFilename), lock.Path())
+		})
+	}
+}
+
+func TestLockSave(t *testing.T) {
+	// Arrange
+	lock, err := pluginsconfig.ParseLockDir(t.TempDir())
+	require.NoError(t, err)
+
+	lock.Set(pluginsconfig.LockedPlugin{
+		Path:      "github.com/ignite/b",
+		Commit:    "b1",
+		GoVersion: "go1.20",
+		Checksums: map[string]string{"linux:amd64": "sum"},
+	})
+	lock.Set(pluginsconfig.LockedPlugin{Path: "github.com/ignite/a", Commit: "a1", GoVersion: "go1.20"})
+	lock.Set(pluginsconfig.LockedPlugin{Path: "github.com/ignite/c", Commit: "c1", GoVersion: "go1.20"})
+	lock.Set(pluginsconfig.LockedPlugin{Path: "github.com/ignite/a", Commit: "a2", GoVersion: "go1.20"})
+	require.True(t, lock.Remove("github.com/ignite/c"))
+	require.False(t, lock.Remove("github.com/ignite/c"))
+
+	// Act
+	err = lock.Save()
+
+	// Assert
+	require.NoError(t, err)
+	bz, err := os.ReadFile(lock.Path())
+	require.NoError(t, err)
+	require.Equal(t, `plugins:
+- path: github.com/ignite/a
+  commit: a2
+  go_version: go1.20
+  checksums: {}
+- path: github.com/ignite/b
+  commit: b1
+  go_version: go1.20
+  checksums:
+    linux:amd64: sum
+`, string(bz))
+
+	lp, ok := lock.Get("github.com/ignite/a")
+	require.True(t, ok)
+	require.Equal(t, "a2", lp.Commit)
+	_, ok = lock.Get("github.com/ignite/c")
+	require.False(t, ok)
+
+	require.EqualError(t, (&pluginsconfig.Lock{}).Save(), "plugin lock save: empty path")
+}
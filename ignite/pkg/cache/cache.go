@@ -144,6 +144,47 @@ func (c Cache[T]) Delete(key string) error {
 	})
 }
 
+// DeleteFunc removes the values within the namespace for which del returns true.
+func (c Cache[T]) DeleteFunc(del func(key string, value T) bool) error {
+	db, err := openDB(c.storage.storagePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(c.namespace))
+		if b == nil {
+			return nil
+		}
+
+		var keys [][]byte
+		err := b.ForEach(func(k, v []byte) error {
+			var value T
+			if err := gob.NewDecoder(bytes.NewReader(v)).Decode(&value); err != nil {
+				return err
+			}
+
+			if del(string(k), value) {
+				keys = append(keys, k)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, k := range keys {
+			if err := b.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
 func openDB(path string) (*bolt.DB, error) {
 	return bolt.Open(path, 0o640, &bolt.Options{Timeout: 1 * time.Minute})
 }
@@ -144,6 +144,28 @@ func TestDeleteKey(t *testing.T) {
 	require.Equal(t, cache.ErrorNotFound, err)
 }
 
+func TestDeleteFunc(t *testing.T) {
+	tmpDir := t.TempDir()
+	cacheStorage, err := cache.NewStorage(filepath.Join(tmpDir, "testdbfile.db"))
+	require.NoError(t, err)
+
+	structCache := cache.New[TestStruct](cacheStorage, "mySimpleNamespace")
+	require.NoError(t, structCache.Put("myKey1", TestStruct{Num: 1}))
+	require.NoError(t, structCache.Put("myKey2", TestStruct{Num: 2}))
+
+	err = structCache.DeleteFunc(func(_ string, v TestStruct) bool {
+		return v.Num == 1
+	})
+	require.NoError(t, err)
+
+	_, err = structCache.Get("myKey1")
+	require.Equal(t, cache.ErrorNotFound, err)
+
+	val, err := structCache.Get("myKey2")
+	require.NoError(t, err)
+	require.Equal(t, 2, val.Num)
+}
+
 func TestClearStorage(t *testing.T) {
 	tmpDir := t.TempDir()
 	cacheStorage, err := cache.NewStorage(filepath.Join(tmpDir, "testdbfile.db"))
@@ -2,6 +2,8 @@ package chaincmd
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 
@@ -48,6 +50,9 @@ const (
 	optionCoinType                         = "--coin-type"
 	optionVestingAmount                    = "--vesting-amount"
 	optionVestingEndTime                   = "--vesting-end-time"
+	optionVestingStartTime                 = "--vesting-start-time"
+	optionMultisig                         = "--multisig"
+	optionMultisigThreshold                = "--multisig-threshold"
 	optionBroadcastMode                    = "--broadcast-mode"
 
 	constTendermint = "tendermint"
@@ -213,6 +218,22 @@ func (c ChainCmd) RecoverKeyCommand(accountName, coinType string) step.Option {
 	return c.cliCommand(command)
 }
 
+// AddMultisigKeyCommand returns the command to add a multisig key of other keys in the chain keyring.
+func (c ChainCmd) AddMultisigKeyCommand(accountName string, threshold int, keys []string) step.Option {
+	command := []string{
+		commandKeys,
+		"add",
+		accountName,
+		optionMultisig,
+		strings.Join(keys, ","),
+		optionMultisigThreshold,
+		strconv.Itoa(threshold),
+	}
+	command = c.attachKeyringBackend(command)
+
+	return c.cliCommand(command)
+}
+
 // ImportKeyCommand returns the command to import a key into the chain keyring from a key file.
 func (c ChainCmd) ImportKeyCommand(accountName, keyFile string) step.Option {
 	command := []string{
@@ -263,8 +284,25 @@ func (c ChainCmd) AddGenesisAccountCommand(address, coins string) step.Option {
 	return c.daemonCommand(command)
 }
 
+// VestingOption for the AddVestingAccountCommand.
+type VestingOption func([]string) []string
+
+// VestingWithStartTime provides the vesting start time option to add a continuous vesting account.
+func VestingWithStartTime(vestingStartTime int64) VestingOption {
+	return func(command []string) []string {
+		return append(command, optionVestingStartTime, fmt.Sprintf("%d", vestingStartTime))
+	}
+}
+
 // AddVestingAccountCommand returns the command to add a delayed vesting account in the genesis file of the chain.
-func (c ChainCmd) AddVestingAccountCommand(address, originalCoins, vestingCoins string, vestingEndTime int64) step.Option {
+// The account is a continuous vesting account when a start time option is provided.
+func (c ChainCmd) AddVestingAccountCommand(
+	address,
+	originalCoins,
+	vestingCoins string,
+	vestingEndTime int64,
+	options ...VestingOption,
+) step.Option {
 	command := []string{
 		commandAddGenesisAccount,
 		address,
@@ -275,6 +313,11 @@ func (c ChainCmd) AddVestingAccountCommand(address, originalCoins, vestingCoins
 		fmt.Sprintf("%d", vestingEndTime),
 	}
 
+	// Apply the options provided by the user
+	for _, applyOption := range options {
+		command = applyOption(command)
+	}
+
 	return c.daemonCommand(command)
 }
 
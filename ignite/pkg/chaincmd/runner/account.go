@@ -8,6 +8,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
 	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
 )
 
@@ -99,6 +100,20 @@ func (r Runner) AddAccount(ctx context.Context, name, mnemonic, coinType string)
 	return account, nil
 }
 
+// AddMultisigAccount creates a multisig account of other accounts of the keyring.
+// The threshold is the number of accounts that must sign the transactions.
+func (r Runner) AddMultisigAccount(ctx context.Context, name string, threshold int, accounts []string) (Account, error) {
+	if err := r.CheckAccountExist(ctx, name); err != nil {
+		return Account{}, err
+	}
+
+	if err := r.run(ctx, runOptions{}, r.chainCmd.AddMultisigKeyCommand(name, threshold, accounts)); err != nil {
+		return Account{}, err
+	}
+
+	return r.ShowAccount(ctx, name)
+}
+
 // ImportAccount import an account from a key file.
 func (r Runner) ImportAccount(ctx context.Context, name, keyFile, passphrase string) (Account, error) {
 	if err := r.CheckAccountExist(ctx, name); err != nil {
@@ -214,6 +229,11 @@ func (r Runner) AddVestingAccount(
 	originalCoins,
 	vestingCoins string,
 	vestingEndTime int64,
+	options ...chaincmd.VestingOption,
 ) error {
-	return r.run(ctx, runOptions{}, r.chainCmd.AddVestingAccountCommand(address, originalCoins, vestingCoins, vestingEndTime))
+	return r.run(
+		ctx,
+		runOptions{},
+		r.chainCmd.AddVestingAccountCommand(address, originalCoins, vestingCoins, vestingEndTime, options...),
+	)
 }
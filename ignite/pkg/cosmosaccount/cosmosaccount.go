@@ -2,6 +2,7 @@ package cosmosaccount
 
 import (
 	"bufio"
+	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -196,6 +197,30 @@ func (r Registry) Create(name string) (acc Account, mnemonic string, err error)
 	if err != nil {
 		return Account{}, "", err
 	}
+
+	return r.createFromMnemonic(name, mnemonic)
+}
+
+// CreateFromSeed creates a new account with name and a mnemonic derived from a seed.
+// The same seed and name always create the same account, which is useful to
+// keep the addresses of development accounts when a chain is reset.
+func (r Registry) CreateFromSeed(name, seed string) (acc Account, mnemonic string, err error) {
+	if _, err = r.GetByName(name); err == nil {
+		return Account{}, "", ErrAccountExists
+	}
+	var accErr *AccountDoesNotExistError
+	if !errors.As(err, &accErr) {
+		return Account{}, "", err
+	}
+	mnemonic, err = MnemonicFromSeed(seed, name)
+	if err != nil {
+		return Account{}, "", err
+	}
+
+	return r.createFromMnemonic(name, mnemonic)
+}
+
+func (r Registry) createFromMnemonic(name, mnemonic string) (Account, string, error) {
 	algo, err := r.algo()
 	if err != nil {
 		return Account{}, "", err
@@ -205,7 +230,7 @@ func (r Registry) Create(name string) (acc Account, mnemonic string, err error)
 		return Account{}, "", err
 	}
 
-	acc = Account{
+	acc := Account{
 		Name:   name,
 		Record: record,
 	}
@@ -213,6 +238,24 @@ func (r Registry) Create(name string) (acc Account, mnemonic string, err error)
 	return acc, mnemonic, nil
 }
 
+// MnemonicFromSeed derives the mnemonic of an account from a seed and the account name.
+// The mnemonic is NOT secure and must only be used for development accounts.
+func MnemonicFromSeed(seed, name string) (string, error) {
+	entropy := sha256.Sum256([]byte(seed + "/" + name))
+	return bip39.NewMnemonic(entropy[:])
+}
+
+// PrivKeyHexFromMnemonic returns the hex encoded private key derived from a mnemonic
+// with the HD path of a coin type.
+func PrivKeyHexFromMnemonic(mnemonic string, coinType uint32) (string, error) {
+	privKey, err := hd.Secp256k1.Derive()(mnemonic, "", hd.CreateHDPath(coinType, 0, 0).String())
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(privKey), nil
+}
+
 // Import imports an existing account with name and passphrase and secret where secret can be a
 // mnemonic or a private key.
 func (r Registry) Import(name, secret, passphrase string) (Account, error) {
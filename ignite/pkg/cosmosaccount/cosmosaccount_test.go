@@ -70,3 +70,37 @@ func TestRegistry(t *testing.T) {
 	_, err = registry.GetByAddress(addr)
 	require.ErrorAs(t, err, &expectedErr)
 }
+
+func TestRegistryCreateFromSeed(t *testing.T) {
+	registry, err := cosmosaccount.NewInMemory()
+	require.NoError(t, err)
+
+	account, mnemonic, err := registry.CreateFromSeed("alice", "ignite")
+	require.NoError(t, err)
+	require.Equal(t, "alice", account.Name)
+
+	// the same seed and name always derive the same mnemonic
+	want, err := cosmosaccount.MnemonicFromSeed("ignite", "alice")
+	require.NoError(t, err)
+	require.Equal(t, want, mnemonic)
+
+	other, err := cosmosaccount.MnemonicFromSeed("ignite", "bob")
+	require.NoError(t, err)
+	require.NotEqual(t, mnemonic, other)
+
+	_, _, err = registry.CreateFromSeed("alice", "ignite")
+	require.ErrorIs(t, err, cosmosaccount.ErrAccountExists)
+}
+
+func TestPrivKeyHexFromMnemonic(t *testing.T) {
+	mnemonic, err := cosmosaccount.MnemonicFromSeed("ignite", "alice")
+	require.NoError(t, err)
+
+	privKey, err := cosmosaccount.PrivKeyHexFromMnemonic(mnemonic, 118)
+	require.NoError(t, err)
+	require.Len(t, privKey, 64)
+
+	otherPrivKey, err := cosmosaccount.PrivKeyHexFromMnemonic(mnemonic, 60)
+	require.NoError(t, err)
+	require.NotEqual(t, privKey, otherPrivKey)
+}
@@ -18,6 +18,7 @@ import (
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -67,6 +68,8 @@ const (
 
 	searchHeight = "tx.height"
 
+	newBlockSubscriber = "cosmosclient"
+
 	orderAsc = "asc"
 )
 
@@ -132,6 +135,8 @@ type Client struct {
 	gasAdjustment float64
 	fees          string
 	generateOnly  bool
+
+	interfaceRegistry codectypes.InterfaceRegistry
 }
 
 // Option configures your client.
@@ -279,6 +284,24 @@ func WithSigner(signer Signer) Option {
 	}
 }
 
+// WithInterfaceRegistry sets the interface registry used to encode and decode the messages.
+// The registry of the chain should be used to decode the messages of transactions collected
+// from the chain blocks. Messages from the Cosmos SDK auth, bank and staking modules are
+// always registered.
+func WithInterfaceRegistry(registry codectypes.InterfaceRegistry) Option {
+	return func(c *Client) {
+		c.interfaceRegistry = registry
+	}
+}
+
+// WithAccountRegistry sets the registry used to access the accounts that sign the transactions.
+// The keyring options are ignored when the registry is set.
+func WithAccountRegistry(registry cosmosaccount.Registry) Option {
+	return func(c *Client) {
+		c.AccountRegistry = registry
+	}
+}
+
 // New creates a new client with given options.
 func New(ctx context.Context, options ...Option) (Client, error) {
 	c := Client{
@@ -328,13 +351,15 @@ func New(ctx context.Context, options ...Option) (Client, error) {
 		c.keyringDir = c.homePath
 	}
 
-	c.AccountRegistry, err = cosmosaccount.New(
-		cosmosaccount.WithKeyringServiceName(c.keyringServiceName),
-		cosmosaccount.WithKeyringBackend(c.keyringBackend),
-		cosmosaccount.WithHome(c.keyringDir),
-	)
-	if err != nil {
-		return Client{}, err
+	if c.AccountRegistry.Keyring == nil {
+		c.AccountRegistry, err = cosmosaccount.New(
+			cosmosaccount.WithKeyringServiceName(c.keyringServiceName),
+			cosmosaccount.WithKeyringBackend(c.keyringBackend),
+			cosmosaccount.WithHome(c.keyringDir),
+		)
+		if err != nil {
+			return Client{}, err
+		}
 	}
 
 	c.context = c.newContext()
@@ -634,6 +659,15 @@ func (c Client) GetBlockTXs(ctx context.Context, height int64) (txs []TX, err er
 	page := 1
 	perPage := defaultTXsPerPage
 	blockTime := r.Block.Time
+	block := Block{
+		Height:          r.Block.Height,
+		Hash:            r.BlockID.Hash.String(),
+		ChainID:         r.Block.ChainID,
+		Time:            r.Block.Time,
+		ProposerAddress: r.Block.ProposerAddress.String(),
+		AppHash:         r.Block.AppHash.String(),
+	}
+
 	for {
 		res, err := c.RPC.TxSearch(ctx, query, false, &page, &perPage, orderAsc)
 		if err != nil {
@@ -643,7 +677,9 @@ func (c Client) GetBlockTXs(ctx context.Context, height int64) (txs []TX, err er
 		for _, tx := range res.Txs {
 			txs = append(txs, TX{
 				BlockTime: blockTime,
+				Block:     block,
 				Raw:       tx,
+				Decoded:   c.decodeTX(tx.Tx),
 			})
 		}
 
@@ -658,6 +694,76 @@ func (c Client) GetBlockTXs(ctx context.Context, height int64) (txs []TX, err er
 	return txs, nil
 }
 
+// SubscribeNewBlockHeights subscribes to the new block events of the node.
+// The height of each new block is sent to the returned channel, which is closed
+// when the context is done or when the node terminates the subscription.
+func (c Client) SubscribeNewBlockHeights(ctx context.Context) (<-chan int64, error) {
+	// Events are received through the websocket connection of the RPC client
+	if !c.RPC.IsRunning() {
+		if err := c.RPC.Start(); err != nil {
+			return nil, fmt.Errorf("failed to connect to the node websocket: %w", err)
+		}
+	}
+
+	query := tmtypes.EventQueryNewBlock.String()
+	subscriber := fmt.Sprintf("%s-%d", newBlockSubscriber, time.Now().UnixNano())
+	events, err := c.RPC.Subscribe(ctx, subscriber, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to new blocks: %w", err)
+	}
+
+	heights := make(chan int64)
+
+	go func() {
+		defer close(heights)
+		defer c.RPC.Unsubscribe(context.Background(), subscriber, query)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case e, ok := <-events:
+				if !ok {
+					return
+				}
+
+				data, ok := e.Data.(tmtypes.EventDataNewBlock)
+				if !ok || data.Block == nil {
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case heights <- data.Block.Height:
+				}
+			}
+		}
+	}()
+
+	return heights, nil
+}
+
+// decodeTX decodes a transaction using the client interface registry.
+// Nil is returned when the client has no interface registry or when the
+// transaction can't be decoded, in which case only its raw data is available.
+func (c Client) decodeTX(txBytes []byte) *DecodedTX {
+	registry := c.context.InterfaceRegistry
+	if registry == nil {
+		return nil
+	}
+
+	// The signers of the messages are validated using the SDK global address prefix
+	defer c.lockBech32Prefix()()
+
+	tx, err := DecodeTX(registry, c.addressPrefix, txBytes)
+	if err != nil {
+		return nil
+	}
+
+	return tx
+}
+
 // CollectTXs collects transactions from multiple consecutive blocks.
 // Transactions from a single block are send to the channel only if all transactions
 // from that block are collected successfully.
@@ -786,11 +892,15 @@ func (c *Client) prepareFactory(clientCtx client.Context) (tx.Factory, error) {
 }
 
 func (c Client) newContext() client.Context {
-	var (
-		amino             = codec.NewLegacyAmino()
+	interfaceRegistry := c.interfaceRegistry
+	if interfaceRegistry == nil {
 		interfaceRegistry = codectypes.NewInterfaceRegistry()
-		marshaler         = codec.NewProtoCodec(interfaceRegistry)
-		txConfig          = authtx.NewTxConfig(marshaler, authtx.DefaultSignModes)
+	}
+
+	var (
+		amino     = codec.NewLegacyAmino()
+		marshaler = codec.NewProtoCodec(interfaceRegistry)
+		txConfig  = authtx.NewTxConfig(marshaler, authtx.DefaultSignModes)
 	)
 
 	authtypes.RegisterInterfaces(interfaceRegistry)
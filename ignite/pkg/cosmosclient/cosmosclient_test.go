@@ -7,9 +7,11 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cometbft/cometbft/p2p"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	tmtypes "github.com/cometbft/cometbft/types"
@@ -638,7 +640,7 @@ func TestGetBlockTXs(t *testing.T) {
 	// Mock the Block RPC endpoint
 	block := createTestBlock(1)
 
-	m.On("Block", ctx, &block.Height).Return(&ctypes.ResultBlock{Block: &block}, nil)
+	m.On("Block", ctx, &block.Height).Return(createTestResultBlock(&block), nil)
 
 	// Mock the TxSearch RPC endpoint
 	searchQry := fmt.Sprintf("tx.height=%d", block.Height)
@@ -662,6 +664,7 @@ func TestGetBlockTXs(t *testing.T) {
 	require.Equal(t, txs, []cosmosclient.TX{
 		{
 			BlockTime: block.Time,
+			Block:     createTestBlockHeader(&block),
 			Raw:       &rtx,
 		},
 	})
@@ -697,7 +700,7 @@ func TestGetBlockTXsPagination(t *testing.T) {
 	// Mock the Block RPC endpoint
 	block := createTestBlock(1)
 
-	m.OnBlock().Return(&ctypes.ResultBlock{Block: &block}, nil)
+	m.OnBlock().Return(createTestResultBlock(&block), nil)
 
 	// Mock the TxSearch RPC endpoint and fake the number of
 	// transactions, so it is called twice to fetch two pages
@@ -729,10 +732,12 @@ func TestGetBlockTXsPagination(t *testing.T) {
 	require.Equal(t, txs, []cosmosclient.TX{
 		{
 			BlockTime: block.Time,
+			Block:     createTestBlockHeader(&block),
 			Raw:       firstPage.Txs[0],
 		},
 		{
 			BlockTime: block.Time,
+			Block:     createTestBlockHeader(&block),
 			Raw:       secondPage.Txs[0],
 		},
 	})
@@ -749,7 +754,7 @@ func TestGetBlockTXsWithSearchError(t *testing.T) {
 	// Mock the Block RPC endpoint
 	block := createTestBlock(1)
 
-	m.OnBlock().Return(&ctypes.ResultBlock{Block: &block}, nil)
+	m.OnBlock().Return(createTestResultBlock(&block), nil)
 
 	// Mock the TxSearch RPC endpoint to return an error
 	m.OnTxSearch().Return(nil, wantErr)
@@ -784,8 +789,8 @@ func TestCollectTXs(t *testing.T) {
 	b1 := createTestBlock(1)
 	b2 := createTestBlock(2)
 
-	m.On("Block", ctx, &b1.Height).Return(&ctypes.ResultBlock{Block: &b1}, nil)
-	m.On("Block", ctx, &b2.Height).Return(&ctypes.ResultBlock{Block: &b2}, nil)
+	m.On("Block", ctx, &b1.Height).Return(createTestResultBlock(&b1), nil)
+	m.On("Block", ctx, &b2.Height).Return(createTestResultBlock(&b2), nil)
 
 	// Mock the TxSearch RPC endpoint to return each of the two block.
 	// Transactions are empty because only the pointer address is required to assert.
@@ -809,14 +814,17 @@ func TestCollectTXs(t *testing.T) {
 	wantTXs := []cosmosclient.TX{
 		{
 			BlockTime: b1.Time,
+			Block:     createTestBlockHeader(&b1),
 			Raw:       r1.Txs[0],
 		},
 		{
 			BlockTime: b2.Time,
+			Block:     createTestBlockHeader(&b2),
 			Raw:       r2.Txs[0],
 		},
 		{
 			BlockTime: b2.Time,
+			Block:     createTestBlockHeader(&b2),
 			Raw:       r2.Txs[1],
 		},
 	}
@@ -944,7 +952,7 @@ func TestCollectTXsWithContextDone(t *testing.T) {
 	// Mock the Block RPC endpoint
 	block := createTestBlock(1)
 
-	m.OnBlock().Return(&ctypes.ResultBlock{Block: &block}, nil)
+	m.OnBlock().Return(createTestResultBlock(&block), nil)
 
 	// Mock the TxSearch RPC endpoint
 	rs := ctypes.ResultTxSearch{
@@ -1027,6 +1035,66 @@ func (s suite) expectPrepareFactory(sdkaddr sdktypes.Address) {
 		Return(1, 2, nil)
 }
 
+func TestSubscribeNewBlockHeights(t *testing.T) {
+	m := testutil.NewTendermintClientMock(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Mock the websocket connection and the new block subscription
+	events := make(chan ctypes.ResultEvent, 3)
+	events <- ctypes.ResultEvent{Data: tmtypes.EventDataNewBlock{Block: &tmtypes.Block{Header: tmtypes.Header{Height: 1}}}}
+	events <- ctypes.ResultEvent{Data: tmtypes.EventDataTx{}}
+	events <- ctypes.ResultEvent{Data: tmtypes.EventDataNewBlock{Block: &tmtypes.Block{Header: tmtypes.Header{Height: 2}}}}
+	close(events)
+
+	query := tmtypes.EventQueryNewBlock.String()
+
+	m.On("IsRunning").Return(false)
+	m.On("Start").Return(nil)
+	m.On("Subscribe", mock.Anything, mock.AnythingOfType("string"), query).Return((<-chan ctypes.ResultEvent)(events), nil)
+	m.On("Unsubscribe", mock.Anything, mock.AnythingOfType("string"), query).Return(nil)
+
+	client := cosmosclient.Client{RPC: m}
+
+	// Act
+	heights, err := client.SubscribeNewBlockHeights(ctx)
+	require.NoError(t, err)
+
+	var got []int64
+	for h := range heights {
+		got = append(got, h)
+	}
+
+	// Assert: Only the new block events are received and
+	// the channel is closed when the subscription ends.
+	require.Equal(t, []int64{1, 2}, got)
+	m.AssertNumberOfCalls(t, "Start", 1)
+	m.AssertNumberOfCalls(t, "Unsubscribe", 1)
+}
+
+func createTestBlockHeader(b *tmtypes.Block) cosmosclient.Block {
+	return cosmosclient.Block{
+		Height:          b.Height,
+		Hash:            createTestBlockID(b).Hash.String(),
+		ChainID:         b.ChainID,
+		Time:            b.Time,
+		ProposerAddress: b.ProposerAddress.String(),
+		AppHash:         b.AppHash.String(),
+	}
+}
+
+// createTestBlockID returns a block ID with a hash that is unique for each block height.
+func createTestBlockID(b *tmtypes.Block) tmtypes.BlockID {
+	return tmtypes.BlockID{Hash: tmhash.Sum([]byte(strconv.FormatInt(b.Height, 10)))}
+}
+
+func createTestResultBlock(b *tmtypes.Block) *ctypes.ResultBlock {
+	return &ctypes.ResultBlock{
+		BlockID: createTestBlockID(b),
+		Block:   b,
+	}
+}
+
 func createTestBlock(height int64) tmtypes.Block {
 	return tmtypes.Block{
 		Header: tmtypes.Header{
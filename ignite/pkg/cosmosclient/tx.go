@@ -6,6 +6,12 @@ import (
 	"time"
 
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/gogoproto/proto"
 )
 
 // TX defines a block transaction.
@@ -13,8 +19,144 @@ type TX struct {
 	// BlockTime returns the time of the block that contains the transaction.
 	BlockTime time.Time
 
+	// Block contains the header data of the block that contains the transaction.
+	Block Block
+
 	// Raw contains the transaction as returned by the Tendermint API.
 	Raw *ctypes.ResultTx
+
+	// Decoded contains the decoded transaction data.
+	// It is nil when the transaction is not decoded.
+	Decoded *DecodedTX
+}
+
+// Block defines the header data of a block.
+type Block struct {
+	Height          int64
+	Hash            string
+	ChainID         string
+	Time            time.Time
+	ProposerAddress string
+	AppHash         string
+}
+
+// DecodedTX defines the decoded data of a transaction.
+type DecodedTX struct {
+	Messages []TXMessage
+	Memo     string
+	Fee      sdktypes.Coins
+	GasLimit uint64
+}
+
+// TXMessage defines a transaction message.
+type TXMessage struct {
+	// TypeURL is the protobuf type URL of the message.
+	TypeURL string `json:"type_url"`
+
+	// Value contains the message encoded as JSON.
+	// Messages with types that are not registered in the interface registry are
+	// encoded as a JSON object with the type URL and the protobuf encoded bytes.
+	Value json.RawMessage `json:"value"`
+
+	// Signers contains the bech32 addresses of the message signers.
+	// It is empty when the message type is not registered in the interface registry.
+	Signers []string `json:"signers"`
+}
+
+// DecodeTX decodes the protobuf encoded bytes of a transaction.
+// The interface registry is used to decode the transaction messages and the address
+// prefix to encode the signer addresses. Messages that are not registered are not
+// decoded but their type URL and raw bytes are kept.
+func DecodeTX(registry codectypes.InterfaceRegistry, addressPrefix string, txBytes []byte) (*DecodedTX, error) {
+	// The transaction is decoded without unpacking the messages so the
+	// data of transactions with unknown message types can be decoded.
+	var raw txtypes.TxRaw
+	if err := proto.Unmarshal(txBytes, &raw); err != nil {
+		return nil, fmt.Errorf("failed to decode TX: %w", err)
+	}
+
+	var body txtypes.TxBody
+	if err := proto.Unmarshal(raw.BodyBytes, &body); err != nil {
+		return nil, fmt.Errorf("failed to decode TX body: %w", err)
+	}
+
+	var authInfo txtypes.AuthInfo
+	if err := proto.Unmarshal(raw.AuthInfoBytes, &authInfo); err != nil {
+		return nil, fmt.Errorf("failed to decode TX auth info: %w", err)
+	}
+
+	tx := DecodedTX{Memo: body.Memo}
+	if authInfo.Fee != nil {
+		tx.Fee = authInfo.Fee.Amount
+		tx.GasLimit = authInfo.Fee.GasLimit
+	}
+
+	cdc := codec.NewProtoCodec(registry)
+	for _, msgAny := range body.Messages {
+		msg, err := decodeTXMessage(cdc, registry, addressPrefix, msgAny)
+		if err != nil {
+			return nil, err
+		}
+
+		tx.Messages = append(tx.Messages, msg)
+	}
+
+	return &tx, nil
+}
+
+func decodeTXMessage(
+	cdc codec.Codec,
+	registry codectypes.InterfaceRegistry,
+	addressPrefix string,
+	msgAny *codectypes.Any,
+) (TXMessage, error) {
+	m := TXMessage{TypeURL: msgAny.TypeUrl}
+
+	var msg sdktypes.Msg
+	if err := registry.UnpackAny(msgAny, &msg); err != nil {
+		// Keep the raw bytes of messages with unknown types
+		v, err := json.Marshal(struct {
+			Type  string `json:"@type"`
+			Value []byte `json:"value"`
+		}{msgAny.TypeUrl, msgAny.Value})
+		if err != nil {
+			return TXMessage{}, err
+		}
+
+		m.Value = v
+		return m, nil
+	}
+
+	v, err := cdc.MarshalInterfaceJSON(msg)
+	if err != nil {
+		return TXMessage{}, fmt.Errorf("failed to encode TX message %s: %w", msgAny.TypeUrl, err)
+	}
+
+	m.Value = v
+
+	for _, addr := range getMsgSigners(msg) {
+		signer, err := bech32.ConvertAndEncode(addressPrefix, addr)
+		if err != nil {
+			return TXMessage{}, err
+		}
+
+		m.Signers = append(m.Signers, signer)
+	}
+
+	return m, nil
+}
+
+// getMsgSigners returns the signers of a message.
+// Messages with invalid signer addresses panic when the signers are
+// requested, in which case the message is considered to have no signers.
+func getMsgSigners(msg sdktypes.Msg) (signers []sdktypes.AccAddress) {
+	defer func() {
+		if r := recover(); r != nil {
+			signers = nil
+		}
+	}()
+
+	return msg.GetSigners()
 }
 
 // GetEvents returns the transaction events.
@@ -0,0 +1,100 @@
+package cosmosclient_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+)
+
+func TestDecodeTX(t *testing.T) {
+	// Arrange
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+
+	from := sdktypes.AccAddress("from________________")
+	to := sdktypes.AccAddress("to__________________")
+	fromAddr, err := sdktypes.Bech32ifyAddressBytes("cosmos", from)
+	require.NoError(t, err)
+
+	toAddr, err := sdktypes.Bech32ifyAddressBytes("cosmos", to)
+	require.NoError(t, err)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: fromAddr,
+		ToAddress:   toAddr,
+		Amount:      sdktypes.NewCoins(sdktypes.NewInt64Coin("token", 1)),
+	}
+	fee := sdktypes.NewCoins(sdktypes.NewInt64Coin("stake", 10))
+
+	b := txConfig.NewTxBuilder()
+	require.NoError(t, b.SetMsgs(msg))
+	b.SetMemo("memo")
+	b.SetFeeAmount(fee)
+	b.SetGasLimit(200000)
+
+	txBytes, err := txConfig.TxEncoder()(b.GetTx())
+	require.NoError(t, err)
+
+	// Act
+	tx, err := cosmosclient.DecodeTX(registry, "cosmos", txBytes)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, "memo", tx.Memo)
+	require.Equal(t, fee, tx.Fee)
+	require.EqualValues(t, 200000, tx.GasLimit)
+	require.Len(t, tx.Messages, 1)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", tx.Messages[0].TypeURL)
+	require.Equal(t, []string{fromAddr}, tx.Messages[0].Signers)
+	require.JSONEq(
+		t,
+		`{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"`+fromAddr+`","to_address":"`+toAddr+`","amount":[{"denom":"token","amount":"1"}]}`,
+		string(tx.Messages[0].Value),
+	)
+}
+
+func TestDecodeTXWithUnknownMessage(t *testing.T) {
+	// Arrange: The message type is not registered in the interface registry
+	registry := codectypes.NewInterfaceRegistry()
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+	msg := &banktypes.MsgSend{FromAddress: "from", ToAddress: "to"}
+
+	b := txConfig.NewTxBuilder()
+	require.NoError(t, b.SetMsgs(msg))
+
+	txBytes, err := txConfig.TxEncoder()(b.GetTx())
+	require.NoError(t, err)
+
+	msgBytes, err := proto.Marshal(msg)
+	require.NoError(t, err)
+
+	// Act
+	tx, err := cosmosclient.DecodeTX(registry, "cosmos", txBytes)
+
+	// Assert: The message value contains the base64 encoded protobuf bytes
+	require.NoError(t, err)
+	require.Len(t, tx.Messages, 1)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", tx.Messages[0].TypeURL)
+	require.Empty(t, tx.Messages[0].Signers)
+	require.JSONEq(
+		t,
+		`{"@type":"/cosmos.bank.v1beta1.MsgSend","value":"`+base64.StdEncoding.EncodeToString(msgBytes)+`"}`,
+		string(tx.Messages[0].Value),
+	)
+}
+
+func TestDecodeTXWithInvalidBytes(t *testing.T) {
+	_, err := cosmosclient.DecodeTX(codectypes.NewInterfaceRegistry(), "cosmos", []byte("invalid"))
+
+	require.Error(t, err)
+}
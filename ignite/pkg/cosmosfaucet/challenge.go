@@ -0,0 +1,80 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"math/bits"
+	"strconv"
+)
+
+// ErrInvalidChallenge is returned when the challenge of a transfer request is not solved.
+var ErrInvalidChallenge = errors.New("invalid faucet challenge")
+
+// ChallengeVerifier defines the interface to verify the challenge solved
+// by a client before transferring tokens, like a captcha or a proof-of-work.
+type ChallengeVerifier interface {
+	// Verify checks the challenge solution of a transfer request sent by a client IP.
+	// It returns an error when the challenge is not solved.
+	Verify(ctx context.Context, req TransferRequest, clientIP string) error
+}
+
+// ProofOfWork is a challenge verifier that requires clients to find a nonce
+// that combined with the account address results in a SHA-256 hash that
+// starts with a number of zero bits.
+// The number of zero bits defines the difficulty of the challenge, each
+// extra bit doubles the average number of hashes to compute.
+type ProofOfWork struct {
+	Difficulty int
+}
+
+// NewProofOfWork returns a new proof-of-work challenge verifier.
+func NewProofOfWork(difficulty int) ProofOfWork {
+	return ProofOfWork{difficulty}
+}
+
+// Verify checks that the challenge of the transfer request is a valid proof-of-work nonce.
+func (p ProofOfWork) Verify(_ context.Context, req TransferRequest, _ string) error {
+	if req.Challenge == "" {
+		return ErrInvalidChallenge
+	}
+
+	if leadingZeroBits(proofOfWorkHash(req.AccountAddress, req.Challenge)) < p.Difficulty {
+		return ErrInvalidChallenge
+	}
+
+	return nil
+}
+
+// Solve finds a nonce to request tokens for an account address.
+func (p ProofOfWork) Solve(ctx context.Context, accountAddress string) (string, error) {
+	for n := uint64(0); ; n++ {
+		// check the context once in a while so the search can be canceled
+		if n%10000 == 0 && ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+
+		nonce := strconv.FormatUint(n, 10)
+		if leadingZeroBits(proofOfWorkHash(accountAddress, nonce)) >= p.Difficulty {
+			return nonce, nil
+		}
+	}
+}
+
+func proofOfWorkHash(accountAddress, nonce string) []byte {
+	h := sha256.Sum256([]byte(accountAddress + ":" + nonce))
+	return h[:]
+}
+
+func leadingZeroBits(b []byte) int {
+	var n int
+	for _, v := range b {
+		if v != 0 {
+			return n + bits.LeadingZeros8(v)
+		}
+
+		n += 8
+	}
+
+	return n
+}
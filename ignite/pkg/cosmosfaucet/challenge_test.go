@@ -0,0 +1,57 @@
+package cosmosfaucet_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosfaucet"
+)
+
+func TestProofOfWork(t *testing.T) {
+	// Arrange
+	var (
+		ctx     = context.Background()
+		pow     = cosmosfaucet.NewProofOfWork(12)
+		address = "cosmos1uzv4v9g9xln2qx2vtqhz99yxum33calja5vruz"
+	)
+
+	nonce, err := pow.Solve(ctx, address)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name    string
+		req     cosmosfaucet.TransferRequest
+		wantErr bool
+	}{
+		{
+			name: "valid nonce",
+			req:  cosmosfaucet.TransferRequest{AccountAddress: address, Challenge: nonce},
+		},
+		{
+			name:    "empty nonce",
+			req:     cosmosfaucet.TransferRequest{AccountAddress: address},
+			wantErr: true,
+		},
+		{
+			name:    "nonce for another address",
+			req:     cosmosfaucet.TransferRequest{AccountAddress: "cosmos1other", Challenge: nonce},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := pow.Verify(ctx, tt.req, "")
+
+			// Assert
+			if tt.wantErr {
+				require.ErrorIs(t, err, cosmosfaucet.ErrInvalidChallenge)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
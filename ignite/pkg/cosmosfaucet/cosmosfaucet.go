@@ -9,6 +9,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/ignite/cli/ignite/pkg/cache"
 	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
 )
 
@@ -58,6 +59,22 @@ type Faucet struct {
 
 	limitRefreshWindow time.Duration
 
+	// grants keeps the coins transferred to each account and client IP.
+	// when it's nil the transferred amounts are queried from the blockchain
+	// and they are only limited per account.
+	grants *grants
+
+	// challengeVerifier verifies the challenge solved by clients before
+	// transferring tokens requested via HTTP. it's optional.
+	challengeVerifier ChallengeVerifier
+
+	// clientIPHeader is the name of the HTTP header to read client IPs from.
+	// when it's empty the remote address of the HTTP requests is used.
+	clientIPHeader string
+
+	// exemptLoopback disables the per IP limit for clients running in the same host.
+	exemptLoopback bool
+
 	// openAPIData holds template data customizations for serving OpenAPI page & spec.
 	openAPIData openAPIData
 }
@@ -95,6 +112,45 @@ func RefreshWindow(refreshWindow time.Duration) Option {
 	}
 }
 
+// Storage persists the coins transferred by the faucet in a storage.
+// transfers are limited per account and per client IP when the storage is used,
+// otherwise the transferred amounts are queried from the blockchain for each
+// transfer, and they are only limited per account.
+func Storage(storage cache.Storage) Option {
+	return func(f *Faucet) {
+		f.grants = &grants{
+			byAddress: cache.New[[]grant](storage, grantsAddressNamespace),
+			byIP:      cache.New[[]grant](storage, grantsIPNamespace),
+		}
+	}
+}
+
+// Challenge adds a verifier to check the challenge solved by clients
+// before transferring tokens requested via HTTP.
+func Challenge(v ChallengeVerifier) Option {
+	return func(f *Faucet) {
+		f.challengeVerifier = v
+	}
+}
+
+// ClientIPHeader sets the HTTP header to read client IPs from, like "X-Forwarded-For".
+// it must only be used when the faucet is served behind a proxy that sets the header.
+// the right-most IP of the header is used, which is the one added by the proxy.
+func ClientIPHeader(name string) Option {
+	return func(f *Faucet) {
+		f.clientIPHeader = name
+	}
+}
+
+// ExemptLoopback disables the per IP limit for clients running in the same host
+// as the faucet, like during local development.
+// it must not be used when the faucet is served behind a local reverse proxy.
+func ExemptLoopback() Option {
+	return func(f *Faucet) {
+		f.exemptLoopback = true
+	}
+}
+
 // ChainID adds chain id to faucet. faucet will automatically fetch when it isn't provided.
 func ChainID(id string) Option {
 	return func(f *Faucet) {
@@ -0,0 +1,232 @@
+package cosmosfaucet
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+)
+
+const (
+	grantsAddressNamespace = "cosmosfaucet.grants.address"
+	grantsIPNamespace      = "cosmosfaucet.grants.ip"
+
+	// grantsPruneInterval is the minimum time between the removals of expired grants.
+	grantsPruneInterval = time.Hour
+)
+
+// ErrLimitReached is returned when a transfer exceeds the max amount of coins
+// that can be transferred to an account or client IP.
+var ErrLimitReached = errors.New("faucet transfer limit reached")
+
+// grantsMutex is a mutex used for keeping grant reservations in a queue so checking and
+// saving the grants is atomic without waiting for the transfers to be confirmed.
+var grantsMutex = &sync.Mutex{}
+
+// grantsPrunedAt is the last time the expired grants were removed.
+// it's guarded by grantsMutex.
+var grantsPrunedAt time.Time
+
+// grant is a record of the coins transferred by the faucet.
+type grant struct {
+	Coins string
+	Time  time.Time
+}
+
+// grants keeps the coins transferred to accounts and client IPs.
+type grants struct {
+	byAddress cache.Cache[[]grant]
+	byIP      cache.Cache[[]grant]
+}
+
+// reserveGrant records a transfer of coins to an account requested from a client IP
+// when the max amounts that can be transferred to both within the refresh window
+// are not exceeded. The client IP is not limited when it's empty.
+func (f Faucet) reserveGrant(address, ip string, coins sdk.Coins) (grant, error) {
+	grantsMutex.Lock()
+	defer grantsMutex.Unlock()
+
+	now := time.Now().Round(0)
+	if now.Sub(grantsPrunedAt) >= grantsPruneInterval {
+		if err := f.pruneGrants(now); err != nil {
+			return grant{}, err
+		}
+
+		grantsPrunedAt = now
+	}
+
+	g := grant{
+		Coins: coins.String(),
+		Time:  now,
+	}
+
+	addressGrants, err := f.loadGrants(f.grants.byAddress, address, now)
+	if err != nil {
+		return grant{}, err
+	}
+
+	if err := f.checkGrants(addressGrants, coins, "account"); err != nil {
+		return grant{}, err
+	}
+
+	var ipGrants []grant
+	if ip != "" {
+		if ipGrants, err = f.loadGrants(f.grants.byIP, ip, now); err != nil {
+			return grant{}, err
+		}
+
+		if err := f.checkGrants(ipGrants, coins, "client IP"); err != nil {
+			return grant{}, err
+		}
+
+		if err := f.grants.byIP.Put(f.grantsKey(ip), append(ipGrants, g)); err != nil {
+			return grant{}, err
+		}
+	}
+
+	if err := f.grants.byAddress.Put(f.grantsKey(address), append(addressGrants, g)); err != nil {
+		return grant{}, err
+	}
+
+	return g, nil
+}
+
+// releaseGrant removes a reserved grant when its transfer fails.
+func (f Faucet) releaseGrant(address, ip string, g grant) error {
+	grantsMutex.Lock()
+	defer grantsMutex.Unlock()
+
+	if err := f.removeGrant(f.grants.byAddress, address, g); err != nil {
+		return err
+	}
+
+	if ip == "" {
+		return nil
+	}
+
+	return f.removeGrant(f.grants.byIP, ip, g)
+}
+
+// loadGrants returns the grants within the refresh window for an account or client IP.
+func (f Faucet) loadGrants(c cache.Cache[[]grant], key string, now time.Time) ([]grant, error) {
+	all, err := c.Get(f.grantsKey(key))
+	if err != nil && !errors.Is(err, cache.ErrorNotFound) {
+		return nil, err
+	}
+
+	var recent []grant
+	for _, g := range all {
+		if now.Sub(g.Time) < f.limitRefreshWindow {
+			recent = append(recent, g)
+		}
+	}
+
+	return recent, nil
+}
+
+// pruneGrants removes the accounts and client IPs with grants that are all
+// outside the refresh window, so the storage doesn't grow indefinitely.
+func (f Faucet) pruneGrants(now time.Time) error {
+	prefix := f.grantsKey("")
+	expired := func(key string, grants []grant) bool {
+		if !strings.HasPrefix(key, prefix) {
+			return false
+		}
+
+		for _, g := range grants {
+			if now.Sub(g.Time) < f.limitRefreshWindow {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if err := f.grants.byAddress.DeleteFunc(expired); err != nil {
+		return err
+	}
+
+	return f.grants.byIP.DeleteFunc(expired)
+}
+
+func (f Faucet) removeGrant(c cache.Cache[[]grant], key string, g grant) error {
+	all, err := c.Get(f.grantsKey(key))
+	if errors.Is(err, cache.ErrorNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	for i, v := range all {
+		if v.Coins == g.Coins && v.Time.Equal(g.Time) {
+			return c.Put(f.grantsKey(key), append(all[:i], all[i+1:]...))
+		}
+	}
+
+	return nil
+}
+
+// checkGrants checks that the coins to transfer don't exceed the max amounts when
+// they are added to the granted coins. The subject names the grants receiver.
+func (f Faucet) checkGrants(grants []grant, coins sdk.Coins, subject string) error {
+	granted := sdk.NewCoins()
+	for _, g := range grants {
+		c, err := sdk.ParseCoinsNormalized(g.Coins)
+		if err != nil {
+			return err
+		}
+
+		granted = granted.Add(c...)
+	}
+
+	for _, c := range coins {
+		if err := f.checkMaxAmount(granted.AmountOf(c.Denom), c, subject); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkMaxAmount checks that the coin amount added to the total amount
+// transferred for the coin's denom doesn't exceed its max amount.
+func (f Faucet) checkMaxAmount(totalSent sdkmath.Int, c sdk.Coin, subject string) error {
+	coinMax, found := f.coinsMax[c.Denom]
+	if !found || coinMax.IsNil() || coinMax.Equal(sdkmath.NewInt(0)) {
+		return nil
+	}
+
+	if totalSent.GTE(coinMax) {
+		return fmt.Errorf(
+			"%w: %s has reached to the max. allowed amount (%d) for %q denom",
+			ErrLimitReached,
+			subject,
+			coinMax,
+			c.Denom,
+		)
+	}
+
+	if (totalSent.Add(c.Amount)).GT(coinMax) {
+		return fmt.Errorf(
+			`%w: ask less amount for %q denom. %s is reaching to the limit (%d) that faucet can tolerate`,
+			ErrLimitReached,
+			c.Denom,
+			subject,
+			coinMax,
+		)
+	}
+
+	return nil
+}
+
+// grantsKey returns the storage key for the grants of an account or client IP.
+// grants are stored per chain because the same storage can be used by different chains.
+func (f Faucet) grantsKey(key string) string {
+	return cache.Key(f.chainID, "/", key)
+}
@@ -0,0 +1,118 @@
+package cosmosfaucet
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+)
+
+func newGrantsFaucet(t *testing.T, window time.Duration) Faucet {
+	storage, err := cache.NewStorage(filepath.Join(t.TempDir(), "grants.db"))
+	require.NoError(t, err)
+
+	f := Faucet{
+		chainID:            "test",
+		coinsMax:           make(map[string]sdkmath.Int),
+		limitRefreshWindow: window,
+	}
+
+	Coin(sdkmath.NewInt(10), sdkmath.NewInt(20), "token")(&f)
+	Storage(storage)(&f)
+
+	return f
+}
+
+func TestReserveGrant(t *testing.T) {
+	// Arrange
+	f := newGrantsFaucet(t, time.Hour)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("token", 10))
+
+	// Act
+	_, err1 := f.reserveGrant("cosmos1a", "10.0.0.1", coins)
+	_, err2 := f.reserveGrant("cosmos1a", "10.0.0.2", coins)
+	_, err3 := f.reserveGrant("cosmos1a", "10.0.0.3", coins)
+
+	// Assert
+	require.NoError(t, err1)
+	require.NoError(t, err2)
+	require.ErrorIs(t, err3, ErrLimitReached)
+	require.ErrorContains(t, err3, "account has reached")
+}
+
+func TestReserveGrantPerIP(t *testing.T) {
+	// Arrange
+	f := newGrantsFaucet(t, time.Hour)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("token", 15))
+
+	// Act
+	_, err1 := f.reserveGrant("cosmos1a", "10.0.0.1", coins)
+	_, err2 := f.reserveGrant("cosmos1b", "10.0.0.1", coins)
+	_, err3 := f.reserveGrant("cosmos1c", "10.0.0.2", coins)
+
+	// Assert
+	require.NoError(t, err1)
+	require.ErrorIs(t, err2, ErrLimitReached)
+	require.ErrorContains(t, err2, "client IP is reaching to the limit")
+	require.NoError(t, err3)
+}
+
+func TestReserveGrantRefreshWindow(t *testing.T) {
+	// Arrange
+	f := newGrantsFaucet(t, time.Millisecond)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("token", 20))
+
+	// Act
+	_, err1 := f.reserveGrant("cosmos1a", "10.0.0.1", coins)
+	time.Sleep(time.Millisecond * 5)
+	_, err2 := f.reserveGrant("cosmos1a", "10.0.0.1", coins)
+
+	// Assert
+	require.NoError(t, err1)
+	require.NoError(t, err2)
+}
+
+func TestReleaseGrant(t *testing.T) {
+	// Arrange
+	f := newGrantsFaucet(t, time.Hour)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("token", 20))
+
+	g, err := f.reserveGrant("cosmos1a", "10.0.0.1", coins)
+	require.NoError(t, err)
+
+	// Act
+	err = f.releaseGrant("cosmos1a", "10.0.0.1", g)
+
+	// Assert
+	require.NoError(t, err)
+
+	_, err = f.reserveGrant("cosmos1a", "10.0.0.1", coins)
+	require.NoError(t, err)
+}
+
+func TestPruneGrants(t *testing.T) {
+	// Arrange
+	f := newGrantsFaucet(t, time.Millisecond)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("token", 10))
+
+	_, err := f.reserveGrant("cosmos1a", "10.0.0.1", coins)
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond * 5)
+
+	// Act
+	err = f.pruneGrants(time.Now())
+
+	// Assert
+	require.NoError(t, err)
+
+	_, err = f.grants.byAddress.Get(f.grantsKey("cosmos1a"))
+	require.ErrorIs(t, err, cache.ErrorNotFound)
+
+	_, err = f.grants.byIP.Get(f.grantsKey("10.0.0.1"))
+	require.ErrorIs(t, err, cache.ErrorNotFound)
+}
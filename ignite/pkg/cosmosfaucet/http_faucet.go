@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"net"
 	"net/http"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -18,6 +20,9 @@ type TransferRequest struct {
 	// Coins that are requested.
 	// default ones used when this one isn't provided.
 	Coins []string `json:"coins"`
+
+	// Challenge is the solution of the challenge required by the faucet, if any.
+	Challenge string `json:"challenge,omitempty"`
 }
 
 func NewTransferRequest(accountAddress string, coins []string) TransferRequest {
@@ -47,17 +52,61 @@ func (f Faucet) faucetHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	clientIP := f.clientIP(r)
+
+	// verify the challenge solved by the client.
+	if f.challengeVerifier != nil {
+		if err := f.challengeVerifier.Verify(r.Context(), req, clientIP); err != nil {
+			responseError(w, http.StatusForbidden, err)
+			return
+		}
+	}
+
+	// clients running in the same host as the faucet are only exempted from
+	// the per IP limit when it's enabled, otherwise all requests forwarded by
+	// a local reverse proxy would be exempted.
+	var options []TransferOption
+	if ip := net.ParseIP(clientIP); !f.exemptLoopback || ip == nil || !ip.IsLoopback() {
+		options = append(options, ClientIP(clientIP))
+	}
+
 	// try performing the transfer
-	if err := f.Transfer(r.Context(), req.AccountAddress, coins); err != nil {
-		if errors.Is(err, context.Canceled) {
+	if err := f.Transfer(r.Context(), req.AccountAddress, coins, options...); err != nil {
+		switch {
+		case errors.Is(err, context.Canceled):
 			return
+		case errors.Is(err, ErrLimitReached):
+			responseError(w, http.StatusTooManyRequests, err)
+		default:
+			responseError(w, http.StatusInternalServerError, err)
 		}
-		responseError(w, http.StatusInternalServerError, err)
 	} else {
 		responseSuccess(w)
 	}
 }
 
+// clientIP returns the IP of the client that sent the request.
+func (f Faucet) clientIP(r *http.Request) string {
+	if f.clientIPHeader != "" {
+		// the header can contain a list of IPs where each proxy appends the IP
+		// it received the request from. Only the right-most one is used because
+		// the other entries can be set by the client to avoid the per IP limit.
+		if v := r.Header.Values(f.clientIPHeader); len(v) > 0 {
+			ips := strings.Split(v[len(v)-1], ",")
+			if ip := strings.TrimSpace(ips[len(ips)-1]); ip != "" {
+				return ip
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
 // FaucetInfoResponse is the faucet info payload.
 type FaucetInfoResponse struct {
 	// IsAFaucet indicates that this is a faucet endpoint.
@@ -1,12 +1,18 @@
 package cosmosfaucet_test
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ignite/cli/ignite/pkg/cache"
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
 	"github.com/ignite/cli/ignite/pkg/cosmosfaucet"
 )
 
@@ -42,3 +48,63 @@ func TestServeHTTPCORS(t *testing.T) {
 		})
 	}
 }
+
+func TestServeHTTPChallenge(t *testing.T) {
+	// Arrange
+	f, err := cosmosfaucet.New(
+		context.Background(),
+		chaincmdrunner.Runner{},
+		cosmosfaucet.ChainID("test"),
+		cosmosfaucet.Challenge(cosmosfaucet.NewProofOfWork(256)),
+	)
+	require.NoError(t, err)
+
+	res := httptest.NewRecorder()
+	body := strings.NewReader(`{"address":"cosmos1uzv4v9g9xln2qx2vtqhz99yxum33calja5vruz","challenge":"0"}`)
+	req, _ := http.NewRequest("POST", "/", body)
+
+	// Act
+	f.ServeHTTP(res, req)
+
+	// Assert
+	require.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+}
+
+func TestServeHTTPClientIPHeader(t *testing.T) {
+	// Arrange
+	storage, err := cache.NewStorage(filepath.Join(t.TempDir(), "grants.db"))
+	require.NoError(t, err)
+
+	f, err := cosmosfaucet.New(
+		context.Background(),
+		chaincmdrunner.Runner{},
+		cosmosfaucet.ChainID("test"),
+		cosmosfaucet.Coin(sdkmath.NewInt(10), sdkmath.NewInt(10), "token"),
+		cosmosfaucet.Storage(storage),
+		cosmosfaucet.ClientIPHeader("X-Forwarded-For"),
+		cosmosfaucet.Challenge(rejectIP("10.0.0.1")),
+	)
+	require.NoError(t, err)
+
+	body := `{"address":"cosmos1uzv4v9g9xln2qx2vtqhz99yxum33calja5vruz"}`
+	req, _ := http.NewRequest("POST", "/", strings.NewReader(body))
+	req.Header.Set("X-Forwarded-For", "1.2.3.4, 10.0.0.1")
+	res := httptest.NewRecorder()
+
+	// Act
+	f.ServeHTTP(res, req)
+
+	// Assert: the right-most IP set by the proxy is used
+	require.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+}
+
+// rejectIP is a challenge verifier that rejects the requests of a client IP.
+type rejectIP string
+
+func (ip rejectIP) Verify(_ context.Context, _ cosmosfaucet.TransferRequest, clientIP string) error {
+	if clientIP == string(ip) {
+		return cosmosfaucet.ErrInvalidChallenge
+	}
+
+	return nil
+}
@@ -2,13 +2,14 @@ package cosmosfaucet
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"strings"
 	"sync"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
 )
 
@@ -51,38 +52,72 @@ func (f Faucet) TotalTransferredAmount(ctx context.Context, toAccountAddress, de
 	return totalAmount, nil
 }
 
+// TransferOption configures a transfer.
+type TransferOption func(*transferOptions)
+
+type transferOptions struct {
+	clientIP string
+}
+
+// ClientIP sets the IP of the client that requested the transfer.
+// transfers are limited per client IP when the faucet uses a storage.
+func ClientIP(ip string) TransferOption {
+	return func(o *transferOptions) {
+		o.clientIP = ip
+	}
+}
+
 // Transfer transfers amount of tokens from the faucet account to toAccountAddress.
-func (f *Faucet) Transfer(ctx context.Context, toAccountAddress string, coins sdk.Coins) error {
+func (f *Faucet) Transfer(ctx context.Context, toAccountAddress string, coins sdk.Coins, options ...TransferOption) error {
+	var o transferOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	// the transferred coins are checked using the storage when available,
+	// which doesn't require to keep the transfer requests in a queue.
+	if f.grants != nil {
+		g, err := f.reserveGrant(toAccountAddress, o.clientIP, coins)
+		if err != nil {
+			return err
+		}
+
+		transferMutex.Lock()
+		defer transferMutex.Unlock()
+
+		if err := f.transfer(ctx, toAccountAddress, coins); err != nil {
+			if rerr := f.releaseGrant(toAccountAddress, o.clientIP, g); rerr != nil {
+				return errors.Join(err, rerr)
+			}
+
+			return err
+		}
+
+		return nil
+	}
+
 	transferMutex.Lock()
 	defer transferMutex.Unlock()
 
-	var coinsStr []string
-
 	// check for each coin, the max transferred amount hasn't been reached
 	for _, c := range coins {
 		totalSent, err := f.TotalTransferredAmount(ctx, toAccountAddress, c.Denom)
 		if err != nil {
 			return err
 		}
-		coinMax, found := f.coinsMax[c.Denom]
-		if found && !coinMax.IsNil() && !coinMax.Equal(sdkmath.NewInt(0)) {
-			if totalSent.GTE(coinMax) {
-				return fmt.Errorf(
-					"account has reached to the max. allowed amount (%d) for %q denom",
-					coinMax,
-					c.Denom,
-				)
-			}
 
-			if (totalSent.Add(c.Amount)).GT(coinMax) {
-				return fmt.Errorf(
-					`ask less amount for %q denom. account is reaching to the limit (%d) that faucet can tolerate`,
-					c.Denom,
-					coinMax,
-				)
-			}
+		if err := f.checkMaxAmount(totalSent, c, "account"); err != nil {
+			return err
 		}
+	}
 
+	return f.transfer(ctx, toAccountAddress, coins)
+}
+
+// transfer sends the coins from the faucet account and waits for the transaction to be confirmed.
+func (f *Faucet) transfer(ctx context.Context, toAccountAddress string, coins sdk.Coins) error {
+	var coinsStr []string
+	for _, c := range coins {
 		coinsStr = append(coinsStr, c.String())
 	}
 
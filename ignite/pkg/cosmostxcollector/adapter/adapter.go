@@ -40,3 +40,16 @@ type Adapter interface {
 	// Query executes a query in the data backend.
 	Query(context.Context, query.Query) (query.Cursor, error)
 }
+
+// Checkpointer is the interface implemented by adapters that save the collection progress.
+// The checkpoint is the height of the latest block collected, which includes blocks without
+// transactions, so an interrupted collection can be resumed from the exact block it stopped.
+type Checkpointer interface {
+	// SaveCheckpoint saves the height of the latest block collected.
+	// A checkpoint is never saved for a height lower than the current checkpoint.
+	SaveCheckpoint(ctx context.Context, height int64) error
+
+	// GetCheckpoint returns the height of the latest block collected.
+	// Zero is returned when there is no checkpoint.
+	GetCheckpoint(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,501 @@
+// Package adaptertest provides a behavioural test suite for transaction collector data backend adapters.
+package adaptertest
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/query"
+)
+
+const (
+	msgSendTypeURL    = "/cosmos.bank.v1beta1.MsgSend"
+	msgUnknownTypeURL = "/test.MsgUnknown"
+)
+
+// Filters contains the adapter specific query filter constructors.
+type Filters struct {
+	Equal             func(field string, value any) query.Filter
+	StringSlice       func(field string, values []string) query.Filter
+	EventType         func(eventType string) query.Filter
+	EventTXs          func(hashes ...string) query.Filter
+	EventAttrName     func(name string) query.Filter
+	EventAttrValue    func(v string) query.Filter
+	EventAttrValueInt func(v int64) query.Filter
+	MessageType       func(typeURL string) query.Filter
+	MessageSigner     func(address string) query.Filter
+}
+
+// NewAdapterFunc creates a new adapter with an empty database for each test.
+type NewAdapterFunc func(t *testing.T) adapter.Adapter
+
+// Run runs the behavioural test suite for an adapter.
+// The suite checks that the adapter implementation saves transactions and that
+// it selects them honoring the filter, sorting and paging semantics of the queries.
+func Run(t *testing.T, newAdapter NewAdapterFunc, f Filters) {
+	t.Run("init", func(t *testing.T) {
+		a := newAdapter(t)
+		ctx := context.Background()
+
+		// Assert: Initialization can be called more than once
+		require.NoError(t, a.Init(ctx))
+		require.NoError(t, a.Init(ctx))
+	})
+
+	t.Run("latest height", func(t *testing.T) {
+		a := newInitializedAdapter(t, newAdapter)
+		ctx := context.Background()
+
+		height, err := a.GetLatestHeight(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, height)
+
+		require.NoError(t, a.Save(ctx, newTXs()))
+
+		height, err = a.GetLatestHeight(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, height)
+	})
+
+	t.Run("save is atomic", func(t *testing.T) {
+		a := newInitializedAdapter(t, newAdapter)
+		ctx := context.Background()
+		txs := newTXs()
+
+		// Act: Saving the same transaction twice fails
+		err := a.Save(ctx, append(txs, txs[0]))
+
+		// Assert: None of the transactions are saved
+		require.Error(t, err)
+
+		height, err := a.GetLatestHeight(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, height)
+	})
+
+	t.Run("checkpoint", func(t *testing.T) {
+		a := newInitializedAdapter(t, newAdapter)
+		ctx := context.Background()
+
+		cp, ok := a.(adapter.Checkpointer)
+		if !ok {
+			t.Skip("adapter doesn't support checkpoints")
+		}
+
+		height, err := cp.GetCheckpoint(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, height)
+
+		require.NoError(t, cp.SaveCheckpoint(ctx, 42))
+
+		height, err = cp.GetCheckpoint(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 42, height)
+
+		// Act: Checkpoints never go back
+		require.NoError(t, cp.SaveCheckpoint(ctx, 10))
+
+		// Assert
+		height, err = cp.GetCheckpoint(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 42, height)
+	})
+
+	t.Run("query", func(t *testing.T) {
+		a := newInitializedAdapter(t, newAdapter)
+		ctx := context.Background()
+		require.NoError(t, a.Save(ctx, newTXs()))
+
+		// Act
+		hashes := queryStrings(t, a, query.New(
+			"tx",
+			query.Fields("hash"),
+			query.SortByFields(query.SortOrderDesc, "height", "index"),
+		))
+
+		// Assert
+		require.Equal(t, []string{txHash(2, 0), txHash(1, 1), txHash(1, 0)}, hashes)
+	})
+
+	t.Run("query with filters", func(t *testing.T) {
+		a := newInitializedAdapter(t, newAdapter)
+		ctx := context.Background()
+		require.NoError(t, a.Save(ctx, newTXs()))
+
+		// Act
+		hashes := queryStrings(t, a, query.New(
+			"tx",
+			query.Fields("hash"),
+			query.WithFilters(
+				f.StringSlice("hash", []string{txHash(1, 0), txHash(1, 1), txHash(2, 0)}),
+				f.Equal("height", 1),
+			),
+			query.WithSortBy(query.SortBy{Field: "hash", Order: query.SortOrderDesc}),
+		))
+
+		// Assert
+		require.Equal(t, []string{txHash(1, 1), txHash(1, 0)}, hashes)
+	})
+
+	t.Run("query paging", func(t *testing.T) {
+		a := newInitializedAdapter(t, newAdapter)
+		ctx := context.Background()
+		require.NoError(t, a.Save(ctx, newTXs()))
+
+		var pages [][]string
+		for page := uint32(1); page <= 3; page++ {
+			pages = append(pages, queryStrings(t, a, query.New(
+				"tx",
+				query.Fields("hash"),
+				query.SortByFields(query.SortOrderAsc, "height", "index"),
+				query.WithPageSize(2),
+				query.AtPage(page),
+			)))
+		}
+
+		// Assert
+		require.Equal(t, [][]string{{txHash(1, 0), txHash(1, 1)}, {txHash(2, 0)}, nil}, pages)
+
+		// Act: Select all the results when paging is disabled
+		hashes := queryStrings(t, a, query.New(
+			"tx",
+			query.Fields("hash"),
+			query.WithPageSize(1),
+			query.WithoutPaging(),
+		))
+
+		// Assert
+		require.Len(t, hashes, 3)
+	})
+
+	t.Run("query invalid sort order", func(t *testing.T) {
+		a := newInitializedAdapter(t, newAdapter)
+
+		_, err := a.Query(context.Background(), query.New(
+			"tx",
+			query.WithSortBy(query.SortBy{Field: "hash", Order: "invalid"}),
+		))
+
+		require.Error(t, err)
+	})
+
+	t.Run("query events", func(t *testing.T) {
+		a := newInitializedAdapter(t, newAdapter)
+		ctx := context.Background()
+		require.NoError(t, a.Save(ctx, newTXs()))
+
+		// Act
+		events, err := a.QueryEvents(ctx, query.NewEventQuery())
+
+		// Assert: Events are sorted by height, TX index and event index
+		require.NoError(t, err)
+		require.Len(t, events, 6)
+
+		var got []string
+		for _, e := range events {
+			got = append(got, fmt.Sprintf("%s:%d:%s", e.TXHash, e.Index, e.Type))
+			require.NotZero(t, e.ID)
+			require.False(t, e.CreatedAt.IsZero())
+		}
+
+		require.Equal(t, []string{
+			txHash(1, 0) + ":0:transfer",
+			txHash(1, 0) + ":1:message",
+			txHash(1, 1) + ":0:transfer",
+			txHash(1, 1) + ":1:message",
+			txHash(2, 0) + ":0:transfer",
+			txHash(2, 0) + ":1:message",
+		}, got)
+
+		// Assert: Event attributes are decoded from JSON
+		require.Len(t, events[0].Attributes, 2)
+
+		attrs := make(map[string]any)
+		for _, attr := range events[0].Attributes {
+			v, err := attr.Value()
+			require.NoError(t, err)
+
+			attrs[attr.Name] = v
+		}
+
+		require.Equal(t, map[string]any{"recipient": recipient(1, 0), "amount": float64(10)}, attrs)
+	})
+
+	t.Run("query events with filters", func(t *testing.T) {
+		a := newInitializedAdapter(t, newAdapter)
+		ctx := context.Background()
+		require.NoError(t, a.Save(ctx, newTXs()))
+
+		// Act
+		events, err := a.QueryEvents(ctx, query.NewEventQuery(
+			query.WithFilters(
+				f.EventType("message"),
+				f.EventTXs(txHash(1, 1), txHash(2, 0)),
+			),
+		))
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		require.Equal(t, txHash(1, 1), events[0].TXHash)
+		require.Equal(t, txHash(2, 0), events[1].TXHash)
+		require.Len(t, events[0].Attributes, 1)
+	})
+
+	t.Run("query events with attribute filters", func(t *testing.T) {
+		a := newInitializedAdapter(t, newAdapter)
+		ctx := context.Background()
+		require.NoError(t, a.Save(ctx, newTXs()))
+
+		// Act
+		events, err := a.QueryEvents(ctx, query.NewEventQuery(
+			query.WithFilters(
+				f.EventAttrName("recipient"),
+				f.EventAttrValue(recipient(1, 1)),
+			),
+		))
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, txHash(1, 1), events[0].TXHash)
+		require.Equal(t, "transfer", events[0].Type)
+
+		// Act
+		events, err = a.QueryEvents(ctx, query.NewEventQuery(
+			query.WithFilters(
+				f.EventAttrName("amount"),
+				f.EventAttrValueInt(20),
+			),
+		))
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		require.Equal(t, txHash(1, 1), events[0].TXHash)
+		require.Equal(t, txHash(2, 0), events[1].TXHash)
+	})
+
+	t.Run("query events paging", func(t *testing.T) {
+		a := newInitializedAdapter(t, newAdapter)
+		ctx := context.Background()
+		require.NoError(t, a.Save(ctx, newTXs()))
+
+		// Act
+		events, err := a.QueryEvents(ctx, query.NewEventQuery(
+			query.WithFilters(f.EventType("transfer")),
+			query.WithPageSize(2),
+			query.AtPage(2),
+		))
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, txHash(2, 0), events[0].TXHash)
+	})
+
+	t.Run("query blocks", func(t *testing.T) {
+		a := newInitializedAdapter(t, newAdapter)
+		ctx := context.Background()
+		require.NoError(t, a.Save(ctx, newTXs()))
+
+		// Act
+		hashes := queryStrings(t, a, query.New(
+			"block",
+			query.Fields("hash"),
+			query.SortByFields(query.SortOrderAsc, "height"),
+		))
+
+		// Assert: Blocks are saved once
+		require.Equal(t, []string{blockHash(1), blockHash(2)}, hashes)
+	})
+
+	t.Run("query decoded transactions", func(t *testing.T) {
+		a := newInitializedAdapter(t, newAdapter)
+		ctx := context.Background()
+		require.NoError(t, a.Save(ctx, newTXs()))
+
+		for _, field := range []string{"memo", "gas_limit", "gas_used"} {
+			// Act
+			values := queryStrings(t, a, query.New(
+				"tx",
+				query.Fields(field),
+				query.WithFilters(f.Equal("hash", txHash(1, 1))),
+			))
+
+			// Assert
+			want := map[string]string{"memo": "memo", "gas_limit": "200000", "gas_used": "50000"}[field]
+			require.Equal(t, []string{want}, values, field)
+		}
+	})
+
+	t.Run("query messages", func(t *testing.T) {
+		a := newInitializedAdapter(t, newAdapter)
+		ctx := context.Background()
+		require.NoError(t, a.Save(ctx, newTXs()))
+
+		// Act
+		hashes := queryStrings(t, a, query.New(
+			"message",
+			query.Fields("tx_hash"),
+			query.WithFilters(f.MessageType(msgSendTypeURL), f.MessageSigner(sender(0))),
+			query.SortByFields(query.SortOrderAsc, "tx_hash"),
+		))
+
+		// Assert
+		require.Equal(t, []string{txHash(1, 0), txHash(2, 0)}, hashes)
+
+		// Act
+		hashes = queryStrings(t, a, query.New(
+			"message",
+			query.Fields("tx_hash"),
+			query.WithFilters(f.MessageSigner(sender(1))),
+		))
+
+		// Assert
+		require.Equal(t, []string{txHash(1, 1)}, hashes)
+
+		// Act: Select the messages with types unknown to the collector
+		data := queryStrings(t, a, query.New(
+			"message",
+			query.Fields("data"),
+			query.WithFilters(f.MessageType(msgUnknownTypeURL)),
+		))
+
+		// Assert
+		require.Len(t, data, 1)
+		require.JSONEq(t, `{"@type":"/test.MsgUnknown","value":"AQI="}`, data[0])
+	})
+}
+
+func newInitializedAdapter(t *testing.T, newAdapter NewAdapterFunc) adapter.Adapter {
+	t.Helper()
+
+	a := newAdapter(t)
+	require.NoError(t, a.Init(context.Background()))
+
+	return a
+}
+
+// queryStrings returns the values of a query that selects a single field.
+func queryStrings(t *testing.T, a adapter.Adapter, q query.Query) (values []string) {
+	t.Helper()
+
+	cr, err := a.Query(context.Background(), q)
+	require.NoError(t, err)
+
+	defer cr.Close()
+
+	for cr.Next() {
+		var v string
+		require.NoError(t, cr.Scan(&v))
+
+		values = append(values, v)
+	}
+
+	require.NoError(t, cr.Err())
+
+	return values
+}
+
+// newTXs returns two transactions for the block one and one transaction for the block two.
+// Each transaction has a "transfer" event with a recipient and an amount, and a "message" event.
+// Each transaction also has a send message and the last one has a message with an unknown type.
+func newTXs() []cosmosclient.TX {
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	return []cosmosclient.TX{
+		newTX(1, 0, 10, blockTime),
+		newTX(1, 1, 20, blockTime),
+		newTX(2, 0, 20, blockTime.Add(time.Second)),
+	}
+}
+
+func newTX(height int64, index uint32, amount int, blockTime time.Time) cosmosclient.TX {
+	hash, _ := hex.DecodeString(txHash(height, index))
+
+	msgs := []cosmosclient.TXMessage{
+		{
+			TypeURL: msgSendTypeURL,
+			Value:   []byte(fmt.Sprintf(`{"@type":"%s","from_address":"%s"}`, msgSendTypeURL, sender(index))),
+			Signers: []string{sender(index)},
+		},
+	}
+
+	if height == 2 {
+		msgs = append(msgs, cosmosclient.TXMessage{
+			TypeURL: msgUnknownTypeURL,
+			Value:   []byte(`{"@type":"/test.MsgUnknown","value":"AQI="}`),
+		})
+	}
+
+	return cosmosclient.TX{
+		BlockTime: blockTime,
+		Block: cosmosclient.Block{
+			Height:          height,
+			Hash:            blockHash(height),
+			ChainID:         "test",
+			Time:            blockTime,
+			ProposerAddress: "PROPOSER",
+			AppHash:         "APPHASH",
+		},
+		Decoded: &cosmosclient.DecodedTX{
+			Messages: msgs,
+			Memo:     "memo",
+			Fee:      sdktypes.NewCoins(sdktypes.NewInt64Coin("token", 1)),
+			GasLimit: 200000,
+		},
+		Raw: &ctypes.ResultTx{
+			Hash:   hash,
+			Height: height,
+			Index:  index,
+			TxResult: abci.ResponseDeliverTx{
+				GasWanted: 200000,
+				GasUsed:   50000,
+				Events: []abci.Event{
+					{
+						Type: "transfer",
+						Attributes: []abci.EventAttribute{
+							{Key: "recipient", Value: recipient(height, index)},
+							{Key: "amount", Value: fmt.Sprint(amount)},
+						},
+					},
+					{
+						Type: "message",
+						Attributes: []abci.EventAttribute{
+							{Key: "action", Value: "send"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func blockHash(height int64) string {
+	return fmt.Sprintf("%064X", height)
+}
+
+// sender returns the sender of a transaction.
+// The transactions with index zero have the same sender.
+func sender(index uint32) string {
+	return fmt.Sprintf("cosmos1sender%d", index)
+}
+
+func txHash(height int64, index uint32) string {
+	return fmt.Sprintf("%062X%02X", height, index)
+}
+
+func recipient(height int64, index uint32) string {
+	return fmt.Sprintf("cosmos1recipient%d%d", height, index)
+}
@@ -0,0 +1,71 @@
+package postgres_test
+
+import (
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter/adaptertest"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter/postgres"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/query"
+)
+
+// envTestDatabaseURL defines the name of the environment variable with the URL of
+// an empty PostgreSQL database used to run the adapter behavioural test suite.
+const envTestDatabaseURL = "IGNITE_TEST_POSTGRES_URL"
+
+func TestAdapter(t *testing.T) {
+	dbURL := os.Getenv(envTestDatabaseURL)
+	if dbURL == "" {
+		t.Skipf("%s is not set", envTestDatabaseURL)
+	}
+
+	db, err := sql.Open("postgres", dbURL)
+	require.NoError(t, err)
+
+	defer db.Close()
+
+	newAdapter := func(t *testing.T) adapter.Adapter {
+		// Each test starts with an empty database schema
+		_, err := db.Exec("DROP SCHEMA public CASCADE; CREATE SCHEMA public")
+		require.NoError(t, err)
+
+		a, err := postgres.NewAdapterFromURL(dbURL)
+		require.NoError(t, err)
+
+		return a
+	}
+
+	adaptertest.Run(t, newAdapter, adaptertest.Filters{
+		Equal: func(field string, value any) query.Filter {
+			return postgres.NewFilter(field, value)
+		},
+		StringSlice: func(field string, values []string) query.Filter {
+			return postgres.NewStringSliceFilter(field, values)
+		},
+		EventType: func(eventType string) query.Filter {
+			return postgres.FilterByEventType(eventType)
+		},
+		EventTXs: func(hashes ...string) query.Filter {
+			return postgres.FilterByEventTXs(hashes...)
+		},
+		EventAttrName: func(name string) query.Filter {
+			return postgres.FilterByEventAttrName(name)
+		},
+		EventAttrValue: func(v string) query.Filter {
+			return postgres.FilterByEventAttrValue(v)
+		},
+		EventAttrValueInt: func(v int64) query.Filter {
+			return postgres.FilterByEventAttrValueInt(v)
+		},
+		MessageType: func(typeURL string) query.Filter {
+			return postgres.FilterByMessageType(typeURL)
+		},
+		MessageSigner: func(address string) query.Filter {
+			return postgres.FilterByMessageSigner(address)
+		},
+	})
+}
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 
@@ -12,6 +13,8 @@ const (
 	FieldEventAttrValue = "attribute.value"
 	FieldEventTXHash    = "event.tx_hash"
 	FieldEventType      = "event.type"
+	FieldMessageSigners = "message.signers"
+	FieldMessageType    = "message.type"
 )
 
 const (
@@ -111,6 +114,29 @@ func (f SliceFilter) Value() any {
 	return f.Filter.Value()
 }
 
+// NewJSONArrayContainsFilter creates a new filter to match JSON/JSONB array fields that contain a value.
+func NewJSONArrayContainsFilter(field string, value any) JSONArrayContainsFilter {
+	// Encoding the array with a single string or number value never fails
+	v, _ := json.Marshal([]any{value})
+
+	return JSONArrayContainsFilter{
+		Filter: NewFilter(field, string(v)),
+	}
+}
+
+// JSONArrayContainsFilter defines a filter to match JSON/JSONB array fields that contain a value.
+type JSONArrayContainsFilter struct {
+	Filter
+}
+
+func (f JSONArrayContainsFilter) String() string {
+	return fmt.Sprintf("%s @> %s::jsonb", f.applyModifiers(f.field), filterPlaceholder)
+}
+
+func (f JSONArrayContainsFilter) Value() any {
+	return f.Filter.Value()
+}
+
 // FilterByEventType creates a new filter to match events by type.
 func FilterByEventType(eventType string) Filter {
 	return NewFilter(FieldEventType, eventType)
@@ -140,3 +166,13 @@ func FilterByEventAttrValueInt(v int64) Filter {
 	// Use a field modifier to cast the event attribute value JSONB field to numeric
 	return NewFilter(FieldEventAttrValue, v, WithModifiers(CastJSONToNumeric))
 }
+
+// FilterByMessageType creates a new filter to match messages by type URL.
+func FilterByMessageType(typeURL string) Filter {
+	return NewFilter(FieldMessageType, typeURL)
+}
+
+// FilterByMessageSigner creates a new filter to match messages signed by an address.
+func FilterByMessageSigner(address string) JSONArrayContainsFilter {
+	return NewJSONArrayContainsFilter(FieldMessageSigners, address)
+}
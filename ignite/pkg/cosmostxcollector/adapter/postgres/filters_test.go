@@ -50,3 +50,13 @@ func TestFilterModifiers(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONArrayContainsFilter(t *testing.T) {
+	// Act
+	filter := postgres.FilterByMessageSigner("cosmos1signer")
+
+	// Assert
+	require.Equal(t, "message.signers @> ?::jsonb", filter.String())
+	require.Equal(t, postgres.FieldMessageSigners, filter.Field())
+	require.Equal(t, `["cosmos1signer"]`, filter.Value())
+}
@@ -0,0 +1,34 @@
+CREATE TABLE block (
+    height            BIGINT NOT NULL,
+    hash              CHAR(64) NOT NULL,
+    chain_id          VARCHAR NOT NULL,
+    proposer_address  VARCHAR NOT NULL,
+    app_hash          VARCHAR NOT NULL,
+    "time"            TIMESTAMP NOT NULL,
+    created_at        TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT block_pk PRIMARY KEY (height)
+);
+
+ALTER TABLE tx
+    ADD COLUMN code        INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN memo        TEXT NOT NULL DEFAULT '',
+    ADD COLUMN fee         JSONB NOT NULL DEFAULT '[]',
+    ADD COLUMN gas_limit   BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN gas_wanted  BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN gas_used    BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE message (
+    tx_hash     CHAR(64) NOT NULL,
+    "index"     SMALLINT NOT NULL,
+    "type"      VARCHAR NOT NULL,
+    signers     JSONB NOT NULL,
+    data        JSONB NOT NULL,
+    created_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT message_pk PRIMARY KEY (tx_hash, "index"),
+    CONSTRAINT message_tx_fk FOREIGN KEY (tx_hash) REFERENCES tx (hash) ON DELETE CASCADE
+);
+
+CREATE INDEX message_type_idx ON message ("type");
+CREATE INDEX message_signers_idx ON message USING GIN (signers);
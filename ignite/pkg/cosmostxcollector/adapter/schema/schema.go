@@ -1,4 +1,5 @@
-package postgres
+// Package schema manages the versions of the embedded SQL schemas of the data backend adapters.
+package schema
 
 import (
 	"bytes"
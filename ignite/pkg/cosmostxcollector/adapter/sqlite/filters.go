@@ -0,0 +1,193 @@
+package sqlite
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+const (
+	FieldEventAttrName  = "attribute.name"
+	FieldEventAttrValue = "attribute.value"
+	FieldEventTXHash    = "event.tx_hash"
+	FieldEventType      = "event.type"
+	FieldMessageSigners = "message.signers"
+	FieldMessageType    = "message.type"
+)
+
+const (
+	filterPlaceholder = "?"
+)
+
+// Modifier defines a function that can be used to modify a field name or value.
+type Modifier func(field string) string
+
+// CastJSONToText modifier normalizes a JSON field so it can be compared as text.
+func CastJSONToText(f string) string {
+	return fmt.Sprintf("json(%s)", f)
+}
+
+// CastJSONToNumeric modifier casts a JSON field to numeric.
+func CastJSONToNumeric(f string) string {
+	return fmt.Sprintf("CAST(%s AS NUMERIC)", f)
+}
+
+// FilterOption defines an option for filters.
+type FilterOption func(*Filter)
+
+// WithModifiers assigns one or more field modifier functions to the filter.
+// Field modifiers can be used to change the behavior of a filtered field.
+func WithModifiers(m ...Modifier) FilterOption {
+	return func(f *Filter) {
+		f.modifiers = m
+	}
+}
+
+// NewFilter creates a new generic equality filter.
+func NewFilter(field string, value any, options ...FilterOption) Filter {
+	f := Filter{
+		field: field,
+		value: value,
+	}
+
+	for _, o := range options {
+		o(&f)
+	}
+
+	return f
+}
+
+// Filter defines a generic equality filter.
+type Filter struct {
+	field     string
+	value     any
+	modifiers []Modifier
+}
+
+func (f Filter) String() string {
+	return fmt.Sprintf("%s = %s", f.applyModifiers(f.field), filterPlaceholder)
+}
+
+func (f Filter) Field() string {
+	return f.field
+}
+
+func (f Filter) Value() any {
+	return f.value
+}
+
+func (f Filter) applyModifiers(field string) string {
+	field = quoteIdentifier(field)
+
+	// Apply all the field modifiers in order
+	for _, m := range f.modifiers {
+		field = m(field)
+	}
+
+	return field
+}
+
+// NewStringSliceFilter creates a new string slice equality filter.
+func NewStringSliceFilter(field string, values []string) SliceFilter {
+	return SliceFilter{
+		Filter: NewFilter(field, jsonArray(values)),
+	}
+}
+
+// NewIntSliceFilter creates a new int64 slice equality filter.
+func NewIntSliceFilter(field string, values []int64) SliceFilter {
+	return SliceFilter{
+		Filter: NewFilter(field, jsonArray(values)),
+	}
+}
+
+// SliceFilter defines a generic slice/array equality filter.
+// SQLite doesn't support array values so the values are sent
+// as a JSON array which is expanded using the "json_each" function.
+type SliceFilter struct {
+	Filter
+}
+
+func (f SliceFilter) String() string {
+	return fmt.Sprintf("%s IN (SELECT value FROM json_each(%s))", f.applyModifiers(f.field), filterPlaceholder)
+}
+
+func (f SliceFilter) Value() any {
+	return f.Filter.Value()
+}
+
+// NewJSONArrayContainsFilter creates a new filter to match JSON array fields that contain a value.
+func NewJSONArrayContainsFilter(field string, value any) JSONArrayContainsFilter {
+	return JSONArrayContainsFilter{
+		Filter: NewFilter(field, value),
+	}
+}
+
+// JSONArrayContainsFilter defines a filter to match JSON array fields that contain a value.
+type JSONArrayContainsFilter struct {
+	Filter
+}
+
+func (f JSONArrayContainsFilter) String() string {
+	return fmt.Sprintf(
+		"EXISTS (SELECT 1 FROM json_each(%s) WHERE json_each.value = %s)",
+		f.applyModifiers(f.field),
+		filterPlaceholder,
+	)
+}
+
+func (f JSONArrayContainsFilter) Value() any {
+	return f.Filter.Value()
+}
+
+// FilterByEventType creates a new filter to match events by type.
+func FilterByEventType(eventType string) Filter {
+	return NewFilter(FieldEventType, eventType)
+}
+
+// FilterByEventTXs creates a new filter to match events by TX hashes.
+func FilterByEventTXs(hashes ...string) SliceFilter {
+	return NewStringSliceFilter(FieldEventTXHash, hashes)
+}
+
+// FilterByEventAttrName creates a new filter to match events by attribute name.
+func FilterByEventAttrName(name string) Filter {
+	return NewFilter(FieldEventAttrName, name)
+}
+
+// FilterByEventAttrValue creates a new filter to match events by attribute value.
+func FilterByEventAttrValue(v string) Filter {
+	// The string value must be quoted to match with the JSON text
+	v = strconv.Quote(v)
+
+	// Use a field modifier to normalize the event attribute value JSON field
+	return NewFilter(FieldEventAttrValue, v, WithModifiers(CastJSONToText))
+}
+
+// FilterByEventAttrValueInt creates a new filter to match events by attribute value.
+func FilterByEventAttrValueInt(v int64) Filter {
+	// Use a field modifier to cast the event attribute value JSON field to numeric
+	return NewFilter(FieldEventAttrValue, v, WithModifiers(CastJSONToNumeric))
+}
+
+func jsonArray[T any](values []T) string {
+	// Make sure that empty slices are encoded as an empty JSON array
+	if values == nil {
+		values = []T{}
+	}
+
+	// Encoding string and integer slices never fails
+	b, _ := json.Marshal(values)
+
+	return string(b)
+}
+
+// FilterByMessageType creates a new filter to match messages by type URL.
+func FilterByMessageType(typeURL string) Filter {
+	return NewFilter(FieldMessageType, typeURL)
+}
+
+// FilterByMessageSigner creates a new filter to match messages signed by an address.
+func FilterByMessageSigner(address string) JSONArrayContainsFilter {
+	return NewJSONArrayContainsFilter(FieldMessageSigners, address)
+}
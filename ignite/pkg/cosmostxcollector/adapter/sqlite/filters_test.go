@@ -0,0 +1,70 @@
+package sqlite_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter/sqlite"
+)
+
+func TestFilter(t *testing.T) {
+	// Arrange
+	name := "event.index"
+	value := "test"
+	repr := "`event`.`index` = ?"
+
+	// Act
+	filter := sqlite.NewFilter(name, value)
+
+	// Assert
+	require.Equal(t, repr, filter.String())
+	require.Equal(t, name, filter.Field())
+	require.Equal(t, value, filter.Value())
+}
+
+func TestSliceFilter(t *testing.T) {
+	// Act
+	filter := sqlite.NewStringSliceFilter("hash", []string{"foo", "bar"})
+
+	// Assert
+	require.Equal(t, "`hash` IN (SELECT value FROM json_each(?))", filter.String())
+	require.Equal(t, `["foo","bar"]`, filter.Value())
+}
+
+func TestFilterModifiers(t *testing.T) {
+	cases := []struct {
+		name     string
+		modifier sqlite.Modifier
+		want     string
+	}{
+		{
+			name:     "CastJSONToText",
+			modifier: sqlite.CastJSONToText,
+			want:     "json(`field`) = ?",
+		},
+		{
+			name:     "CastJSONToNumeric",
+			modifier: sqlite.CastJSONToNumeric,
+			want:     "CAST(`field` AS NUMERIC) = ?",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			field := sqlite.NewFilter("field", nil, sqlite.WithModifiers(tt.modifier))
+
+			require.EqualValues(t, tt.want, field.String())
+		})
+	}
+}
+
+func TestJSONArrayContainsFilter(t *testing.T) {
+	// Act
+	filter := sqlite.FilterByMessageSigner("cosmos1signer")
+
+	// Assert
+	require.Equal(t, "EXISTS (SELECT 1 FROM json_each(`message`.`signers`) WHERE json_each.value = ?)", filter.String())
+	require.Equal(t, sqlite.FieldMessageSigners, filter.Field())
+	require.Equal(t, "cosmos1signer", filter.Value())
+}
@@ -0,0 +1,32 @@
+CREATE TABLE block (
+    height            BIGINT NOT NULL,
+    hash              CHAR(64) NOT NULL,
+    chain_id          VARCHAR NOT NULL,
+    proposer_address  VARCHAR NOT NULL,
+    app_hash          VARCHAR NOT NULL,
+    "time"            TIMESTAMP NOT NULL,
+    created_at        TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT block_pk PRIMARY KEY (height)
+);
+
+ALTER TABLE tx ADD COLUMN code INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE tx ADD COLUMN memo TEXT NOT NULL DEFAULT '';
+ALTER TABLE tx ADD COLUMN fee TEXT NOT NULL DEFAULT '[]' CHECK (json_valid(fee));
+ALTER TABLE tx ADD COLUMN gas_limit BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE tx ADD COLUMN gas_wanted BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE tx ADD COLUMN gas_used BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE message (
+    tx_hash     CHAR(64) NOT NULL,
+    "index"     SMALLINT NOT NULL,
+    "type"      VARCHAR NOT NULL,
+    signers     TEXT NOT NULL CHECK (json_valid(signers)),
+    data        TEXT NOT NULL CHECK (json_valid(data)),
+    created_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT message_pk PRIMARY KEY (tx_hash, "index"),
+    CONSTRAINT message_tx_fk FOREIGN KEY (tx_hash) REFERENCES tx (hash) ON DELETE CASCADE
+);
+
+CREATE INDEX message_type_idx ON message ("type");
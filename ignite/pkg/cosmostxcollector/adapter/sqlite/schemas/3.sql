@@ -0,0 +1,7 @@
+CREATE TABLE checkpoint (
+    id          SMALLINT NOT NULL,
+    height      BIGINT NOT NULL,
+    updated_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT checkpoint_pk PRIMARY KEY (id)
+);
@@ -0,0 +1,552 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	_ "modernc.org/sqlite" // register the pure Go SQLite driver
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter/schema"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/query"
+)
+
+// MemoryDatabase is the database path to use for in-memory databases.
+const MemoryDatabase = ":memory:"
+
+const (
+	adapterType = "sqlite"
+
+	driverName = "sqlite"
+
+	sqlSelectBlockHeight = `
+		SELECT COALESCE(MAX(height), 0)
+		FROM tx
+	`
+	sqlSelectCheckpoint = `
+		SELECT COALESCE(MAX(height), 0)
+		FROM checkpoint
+	`
+	sqlUpsertCheckpoint = `
+		INSERT INTO checkpoint (id, height)
+		VALUES (1, ?)
+		ON CONFLICT (id) DO UPDATE
+		SET height = excluded.height, updated_at = CURRENT_TIMESTAMP
+		WHERE checkpoint.height < excluded.height
+	`
+	sqlSelectEventAttrs = `
+		SELECT event_id, name, value FROM attribute
+		WHERE event_id IN (SELECT value FROM json_each(?))
+		ORDER BY event_id
+	`
+	sqlInsertBlock = `
+		INSERT INTO block (height, hash, chain_id, proposer_address, app_hash, "time")
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (height) DO NOTHING
+	`
+	sqlInsertTX = `
+		INSERT INTO tx (hash, "index", height, block_time, code, memo, fee, gas_limit, gas_wanted, gas_used)
+		VALUES (?, ?, ?, ?, ?, ?, json(?), ?, ?, ?)
+	`
+	sqlInsertMessage = `
+		INSERT INTO message (tx_hash, "index", "type", signers, data)
+		VALUES (?, ?, ?, json(?), json(?))
+	`
+	sqlInsertEvent = `
+		INSERT INTO event (tx_hash, "type", "index")
+		VALUES (?, ?, ?) RETURNING id
+	`
+	sqlInsertEventAttr = `
+		INSERT INTO attribute (event_id, name, value)
+		VALUES (?, ?, json(?))
+	`
+	sqlInsertRawTX = `
+		INSERT INTO raw_tx (hash, data)
+		VALUES (?, ?)
+	`
+)
+
+//go:embed schemas/*
+var fsSchemas embed.FS
+
+// ErrClosed is returned when database connection is not open.
+var ErrClosed = errors.New("no database connection")
+
+// NewAdapter creates a new SQLite adapter.
+// The path is the name of the database file, which is created when it doesn't exist.
+// Use MemoryDatabase as path to create an in-memory database, in which case
+// the cursors returned by Query must be closed before using the adapter again.
+func NewAdapter(path string) (Adapter, error) {
+	if path == "" {
+		return Adapter{}, errors.New("SQLite database path is required")
+	}
+
+	db, err := sql.Open(driverName, createSQLiteDSN(path))
+	if err != nil {
+		return Adapter{}, err
+	}
+
+	// Each connection to an in-memory database opens a different
+	// database, so the same connection must be used for all the
+	// operations. File databases use a connection per operation
+	// to keep reading the rows of a cursor while saving.
+	if path == MemoryDatabase {
+		db.SetMaxOpenConns(1)
+	}
+
+	return Adapter{
+		db:      db,
+		schemas: schema.NewSchemas(fsSchemas, ""),
+	}, nil
+}
+
+// NewAdapterFromURL creates a new SQLite adapter from a database URL.
+// The URL has the "sqlite://path/to/database.db" format, where absolute
+// paths are defined with three slashes, like "sqlite:///var/lib/indexer.db".
+// The "sqlite::memory:" URL creates an in-memory database.
+func NewAdapterFromURL(rawURL string) (Adapter, error) {
+	uri, err := url.Parse(rawURL)
+	if err != nil {
+		return Adapter{}, err
+	}
+
+	if uri.Scheme != adapterType && uri.Scheme != "sqlite3" {
+		return Adapter{}, fmt.Errorf("invalid SQLite URL scheme: %s", uri.Scheme)
+	}
+
+	// URLs without slashes after the scheme, like "sqlite:indexer.db", are opaque
+	path := uri.Opaque
+	if path == "" {
+		path = uri.Host + uri.Path
+	}
+
+	if path == "" {
+		return Adapter{}, errors.New("SQLite URL must contain a database path")
+	}
+
+	return NewAdapter(path)
+}
+
+// Adapter implements a data backend adapter for SQLite.
+type Adapter struct {
+	db      *sql.DB
+	schemas schema.Schemas
+}
+
+// UpdateSchema updates the database schema to the latest version available.
+// It applies all available schemas that were not applied already.
+func (a Adapter) UpdateSchema(ctx context.Context, s schema.Schemas) error {
+	db, err := a.getDB()
+	if err != nil {
+		return err
+	}
+
+	// Create the schema table if it doesn't exist
+	if _, err := db.ExecContext(ctx, s.GetTableDDL()); err != nil {
+		return fmt.Errorf("failed to check schema table: %w", err)
+	}
+
+	// Get the current schema version
+	var v uint64
+	if err := db.QueryRowContext(ctx, s.GetSchemaVersionSQL()).Scan(&v); err != nil {
+		return fmt.Errorf("failed to read current schema version: %w", err)
+	}
+
+	return s.WalkFrom(v+1, func(version uint64, script []byte) error {
+		if _, err := db.ExecContext(ctx, string(script)); err != nil {
+			// Make sure that the connection is not left inside the failed transaction
+			_, _ = db.ExecContext(ctx, "ROLLBACK")
+
+			return fmt.Errorf("error applying schema version %d: %w", version, err)
+		}
+
+		return nil
+	})
+}
+
+// Close closes the database.
+func (a Adapter) Close() error {
+	db, err := a.getDB()
+	if err != nil {
+		return err
+	}
+
+	return db.Close()
+}
+
+func (a Adapter) GetType() string {
+	return adapterType
+}
+
+func (a Adapter) Init(ctx context.Context) error {
+	return a.UpdateSchema(ctx, a.schemas)
+}
+
+func (a Adapter) Save(ctx context.Context, txs []cosmosclient.TX) error {
+	db, err := a.getDB()
+	if err != nil {
+		return err
+	}
+
+	// Start a transaction
+	sqlTx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// Rollback won't have any effect if the transaction is committed before
+	defer sqlTx.Rollback()
+
+	// Prepare insert statements to speed up "bulk" saving times
+	txStmt, err := sqlTx.PrepareContext(ctx, sqlInsertTX)
+	if err != nil {
+		return err
+	}
+
+	defer txStmt.Close()
+
+	evtStmt, err := sqlTx.PrepareContext(ctx, sqlInsertEvent)
+	if err != nil {
+		return err
+	}
+
+	defer evtStmt.Close()
+
+	attrStmt, err := sqlTx.PrepareContext(ctx, sqlInsertEventAttr)
+	if err != nil {
+		return err
+	}
+
+	defer attrStmt.Close()
+
+	msgStmt, err := sqlTx.PrepareContext(ctx, sqlInsertMessage)
+	if err != nil {
+		return err
+	}
+
+	defer msgStmt.Close()
+
+	// All the transactions are saved within the context of the same database
+	// transactions and because of that either all block transactions are
+	// saved or none of them.
+	var blockHeight int64
+	for _, tx := range txs {
+		// Save the block once for all its transactions
+		if h := tx.Block.Height; h != 0 && h != blockHeight {
+			if err := saveBlock(ctx, sqlTx, tx.Block); err != nil {
+				return err
+			}
+
+			blockHeight = h
+		}
+
+		if err := saveRawTX(ctx, sqlTx, tx.Raw); err != nil {
+			return err
+		}
+
+		if err := saveTX(ctx, txStmt, evtStmt, attrStmt, tx); err != nil {
+			return err
+		}
+
+		if err := saveMessages(ctx, msgStmt, tx); err != nil {
+			return err
+		}
+	}
+
+	return sqlTx.Commit()
+}
+
+func (a Adapter) GetLatestHeight(ctx context.Context) (height int64, err error) {
+	db, err := a.getDB()
+	if err != nil {
+		return 0, err
+	}
+
+	row := db.QueryRowContext(ctx, sqlSelectBlockHeight)
+	if err = row.Scan(&height); err != nil {
+		return 0, err
+	}
+
+	return height, nil
+}
+
+func (a Adapter) SaveCheckpoint(ctx context.Context, height int64) error {
+	db, err := a.getDB()
+	if err != nil {
+		return err
+	}
+
+	if _, err := db.ExecContext(ctx, sqlUpsertCheckpoint, height); err != nil {
+		return fmt.Errorf("error saving checkpoint %d: %w", height, err)
+	}
+
+	return nil
+}
+
+func (a Adapter) GetCheckpoint(ctx context.Context) (height int64, err error) {
+	db, err := a.getDB()
+	if err != nil {
+		return 0, err
+	}
+
+	row := db.QueryRowContext(ctx, sqlSelectCheckpoint)
+	if err = row.Scan(&height); err != nil {
+		return 0, err
+	}
+
+	return height, nil
+}
+
+func (a Adapter) QueryEvents(ctx context.Context, q query.EventQuery) ([]query.Event, error) {
+	db, err := a.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	sql := parseEventQuery(q)
+	args := extractEventQueryArgs(q)
+	rows, err := db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var (
+		events   []query.Event
+		eventIDs []int64
+
+		// Keep an index of the event position within the events slice
+		// to find them later when updating their attributes.
+		eventIndexes = make(map[int64]int)
+	)
+
+	for i := 0; rows.Next(); i++ {
+		e := query.Event{}
+		if err := rows.Scan(&e.ID, &e.Index, &e.TXHash, &e.Type, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to read event: %w", err)
+		}
+
+		events = append(events, e)
+		eventIDs = append(eventIDs, e.ID)
+
+		eventIndexes[e.ID] = i
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Don't query attributes when there are no events
+	if len(events) == 0 {
+		return events, nil
+	}
+
+	// Select the attributes for the events that matched the query
+	attrRows, err := db.QueryContext(ctx, sqlSelectEventAttrs, jsonArray(eventIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	defer attrRows.Close()
+
+	// Update the attributes of the selected events
+	for attrRows.Next() {
+		var (
+			eventID int64
+			name    string
+			value   []byte
+		)
+
+		if err := attrRows.Scan(&eventID, &name, &value); err != nil {
+			return nil, fmt.Errorf("failed to read event attribute: %w", err)
+		}
+
+		i := eventIndexes[eventID]
+		events[i].Attributes = append(events[i].Attributes, query.NewAttribute(name, value))
+	}
+
+	return events, attrRows.Err()
+}
+
+func (a Adapter) Query(ctx context.Context, q query.Query) (query.Cursor, error) {
+	db, err := a.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	sql, err := parseQuery(q)
+	if err != nil {
+		return nil, err
+	}
+
+	args := extractQueryArgs(q)
+	rows, err := db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func (a Adapter) getDB() (*sql.DB, error) {
+	if a.db == nil {
+		return nil, ErrClosed
+	}
+
+	return a.db, nil
+}
+
+func createSQLiteDSN(path string) string {
+	// Enable foreign keys to delete the events and attributes
+	// in cascade and wait for locks instead of failing early.
+	params := url.Values{}
+	params.Add("_pragma", "foreign_keys(1)")
+	params.Add("_pragma", "busy_timeout(5000)")
+
+	// Use write-ahead logging so readers don't block the writer
+	if path != MemoryDatabase {
+		params.Add("_pragma", "journal_mode(WAL)")
+	}
+
+	return fmt.Sprintf("file:%s?%s", path, params.Encode())
+}
+
+func saveRawTX(ctx context.Context, sqlTx *sql.Tx, rtx *ctypes.ResultTx) error {
+	hash := rtx.Hash.String()
+	raw, err := json.Marshal(rtx)
+	if err != nil {
+		return fmt.Errorf("failed to encode raw TX %s: %w", hash, err)
+	}
+
+	if _, err := sqlTx.ExecContext(ctx, sqlInsertRawTX, hash, string(raw)); err != nil {
+		return fmt.Errorf("error saving raw TX %s: %w", hash, err)
+	}
+
+	return nil
+}
+
+func saveBlock(ctx context.Context, sqlTx *sql.Tx, b cosmosclient.Block) error {
+	_, err := sqlTx.ExecContext(ctx, sqlInsertBlock, b.Height, b.Hash, b.ChainID, b.ProposerAddress, b.AppHash, b.Time)
+	if err != nil {
+		return fmt.Errorf("error saving block %d: %w", b.Height, err)
+	}
+
+	return nil
+}
+
+func saveTX(ctx context.Context, txStmt, evtStmt, attrStmt *sql.Stmt, tx cosmosclient.TX) error {
+	var (
+		hash     = tx.Raw.Hash.String()
+		res      = tx.Raw.TxResult
+		memo     string
+		gasLimit uint64
+		fee      = "[]"
+	)
+
+	if tx.Decoded != nil {
+		memo = tx.Decoded.Memo
+		gasLimit = tx.Decoded.GasLimit
+
+		if len(tx.Decoded.Fee) > 0 {
+			v, err := json.Marshal(tx.Decoded.Fee)
+			if err != nil {
+				return fmt.Errorf("failed to encode TX %s fee: %w", hash, err)
+			}
+
+			fee = string(v)
+		}
+	}
+
+	_, err := txStmt.ExecContext(
+		ctx,
+		hash,
+		tx.Raw.Index,
+		tx.Raw.Height,
+		tx.BlockTime,
+		res.Code,
+		memo,
+		fee,
+		gasLimit,
+		res.GasWanted,
+		res.GasUsed,
+	)
+	if err != nil {
+		return fmt.Errorf("error saving TX %s: %w", hash, err)
+	}
+
+	events, err := tx.GetEvents()
+	if err != nil {
+		return err
+	}
+
+	for i, evt := range events {
+		var evtID int64
+
+		row := evtStmt.QueryRowContext(ctx, hash, evt.Type, i)
+		if err := row.Err(); err != nil {
+			return fmt.Errorf("error saving event '%s': %w", evt.Type, err)
+		}
+
+		if err := row.Scan(&evtID); err != nil {
+			return fmt.Errorf("error reading event ID: %w", err)
+		}
+
+		for _, attr := range evt.Attributes {
+			// Attribute values are saved as JSON text because SQLite JSON functions don't accept BLOBs
+			if _, err := attrStmt.ExecContext(ctx, evtID, attr.Key, string(attr.Value)); err != nil {
+				return fmt.Errorf("error saving event attr '%s.%s': %w", evt.Type, attr.Key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func saveMessages(ctx context.Context, msgStmt *sql.Stmt, tx cosmosclient.TX) error {
+	// Messages are only available when the transaction is decoded
+	if tx.Decoded == nil {
+		return nil
+	}
+
+	hash := tx.Raw.Hash.String()
+	for i, msg := range tx.Decoded.Messages {
+		signers := jsonArray(msg.Signers)
+		if _, err := msgStmt.ExecContext(ctx, hash, i, msg.TypeURL, signers, string(msg.Value)); err != nil {
+			return fmt.Errorf("error saving TX %s message '%s': %w", hash, msg.TypeURL, err)
+		}
+	}
+
+	return nil
+}
+
+func extractQueryArgs(q query.Query) []any {
+	// When the query is a call to a table-valued function
+	// add the arguments before the filter values
+	args := q.Args()
+
+	// Add the values from the filters
+	for _, f := range q.Filters() {
+		if a := f.Value(); a != nil {
+			args = append(args, a)
+		}
+	}
+
+	return args
+}
+
+func extractEventQueryArgs(q query.EventQuery) (args []any) {
+	for _, f := range q.Filters() {
+		if a := f.Value(); a != nil {
+			args = append(args, a)
+		}
+	}
+
+	return args
+}
@@ -0,0 +1,150 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter/adaptertest"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/query"
+)
+
+func TestAdapter(t *testing.T) {
+	newAdapter := func(t *testing.T) adapter.Adapter {
+		a, err := NewAdapter(filepath.Join(t.TempDir(), "indexer.db"))
+		require.NoError(t, err)
+
+		t.Cleanup(func() { a.Close() })
+
+		return a
+	}
+
+	adaptertest.Run(t, newAdapter, adaptertest.Filters{
+		Equal: func(field string, value any) query.Filter {
+			return NewFilter(field, value)
+		},
+		StringSlice: func(field string, values []string) query.Filter {
+			return NewStringSliceFilter(field, values)
+		},
+		EventType: func(eventType string) query.Filter {
+			return FilterByEventType(eventType)
+		},
+		EventTXs: func(hashes ...string) query.Filter {
+			return FilterByEventTXs(hashes...)
+		},
+		EventAttrName: func(name string) query.Filter {
+			return FilterByEventAttrName(name)
+		},
+		EventAttrValue: func(v string) query.Filter {
+			return FilterByEventAttrValue(v)
+		},
+		EventAttrValueInt: func(v int64) query.Filter {
+			return FilterByEventAttrValueInt(v)
+		},
+		MessageType: func(typeURL string) query.Filter {
+			return FilterByMessageType(typeURL)
+		},
+		MessageSigner: func(address string) query.Filter {
+			return FilterByMessageSigner(address)
+		},
+	})
+}
+
+func TestNewAdapterFromURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{
+			name: "relative path",
+			url:  "sqlite://indexer.db",
+		},
+		{
+			name: "opaque path",
+			url:  "sqlite:indexer.db",
+		},
+		{
+			name: "absolute path",
+			url:  "sqlite:///" + filepath.Join(t.TempDir(), "indexer.db"),
+		},
+		{
+			name: "memory",
+			url:  "sqlite::memory:",
+		},
+		{
+			name:    "missing path",
+			url:     "sqlite://",
+			wantErr: true,
+		},
+		{
+			name:    "invalid scheme",
+			url:     "postgres://localhost/indexer",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			adapter, err := NewAdapterFromURL(tt.url)
+
+			// Assert
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NoError(t, adapter.Close())
+		})
+	}
+}
+
+func TestMemoryDatabase(t *testing.T) {
+	// Arrange
+	a, err := NewAdapter(MemoryDatabase)
+	require.NoError(t, err)
+
+	defer a.Close()
+
+	// Act
+	err = a.Init(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestQueryWithOpenCursor(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	a, err := NewAdapter(filepath.Join(t.TempDir(), "indexer.db"))
+	require.NoError(t, err)
+
+	defer a.Close()
+
+	require.NoError(t, a.Init(ctx))
+	require.NoError(t, a.SaveCheckpoint(ctx, 1))
+
+	cr, err := a.Query(ctx, query.New("checkpoint", query.Fields("height")))
+	require.NoError(t, err)
+
+	defer cr.Close()
+
+	require.True(t, cr.Next())
+
+	// Act
+	errSave := a.SaveCheckpoint(ctx, 2)
+	height, errGet := a.GetCheckpoint(ctx)
+
+	// Assert
+	require.NoError(t, errSave)
+	require.NoError(t, errGet)
+	require.EqualValues(t, 2, height)
+}
@@ -2,6 +2,7 @@ package cosmostxcollector
 
 import (
 	"context"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 
@@ -9,45 +10,264 @@ import (
 	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
 )
 
+const (
+	// DefaultFollowInterval is the default interval to check for new blocks when following a chain.
+	DefaultFollowInterval = time.Second
+
+	// DefaultWorkers is the default number of blocks that are fetched concurrently.
+	DefaultWorkers = 1
+)
+
 // TXsCollector defines the interface for Cosmos clients that support collection of transactions.
 //
 //go:generate mockery --name TXsCollector --filename txs_collector.go --with-expecter
 type TXsCollector interface {
-	CollectTXs(ctx context.Context, fromHeight int64, tc chan<- []cosmosclient.TX) error
+	GetBlockTXs(ctx context.Context, height int64) ([]cosmosclient.TX, error)
+	LatestBlockHeight(ctx context.Context) (int64, error)
+}
+
+// BlockSubscriber defines the interface for Cosmos clients that support new block subscriptions.
+// Clients that implement it are notified of new blocks when following a chain instead of
+// checking for new blocks after each interval.
+type BlockSubscriber interface {
+	SubscribeNewBlockHeights(ctx context.Context) (<-chan int64, error)
+}
+
+// Option configures the collector.
+type Option func(*Collector)
+
+// WithWorkers sets the number of blocks that are fetched concurrently.
+// The transactions are always saved in block order.
+func WithWorkers(n int) Option {
+	return func(c *Collector) {
+		if n > 0 {
+			c.workers = n
+		}
+	}
 }
 
 // New creates a new Cosmos transaction collector.
-func New(db adapter.Saver, client TXsCollector) Collector {
-	return Collector{db, client}
+func New(db adapter.Saver, client TXsCollector, options ...Option) Collector {
+	c := Collector{
+		db:      db,
+		client:  client,
+		workers: DefaultWorkers,
+	}
+
+	for _, apply := range options {
+		apply(&c)
+	}
+
+	return c
 }
 
 // Collector defines a type to collect and save Cosmos transactions in a data backend.
+// When the data backend adapter supports checkpoints the height of each collected
+// block is saved after its transactions, so collections can be resumed exactly.
 type Collector struct {
-	db     adapter.Saver
-	client TXsCollector
+	db      adapter.Saver
+	client  TXsCollector
+	workers int
 }
 
-// Collect gathers transactions for all blocks starting from a specific height.
-// Each group of block transactions is saved sequentially after being collected.
+// Collect gathers transactions for all blocks starting from a specific height
+// until the latest block height available at the moment this method is called.
+// Blocks are fetched concurrently by the configured number of workers while their
+// transactions are saved sequentially to avoid block height gaps.
 func (c Collector) Collect(ctx context.Context, fromHeight int64) error {
-	tc := make(chan []cosmosclient.TX)
-	wg, ctx := errgroup.WithContext(ctx)
+	latestHeight, err := c.client.LatestBlockHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.collect(ctx, fromHeight, latestHeight)
+}
+
+// Follow gathers transactions for all blocks starting from a specific height and
+// keeps gathering the transactions of the new blocks until the context is done.
+// Once all blocks are collected new blocks are collected as soon as the client is
+// notified of them when it supports new block subscriptions, otherwise the latest
+// block height is checked for new blocks after each interval.
+func (c Collector) Follow(ctx context.Context, fromHeight int64, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		latestHeight, err := c.client.LatestBlockHeight(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := c.collect(ctx, fromHeight, latestHeight); err != nil {
+			return err
+		}
+
+		if latestHeight >= fromHeight {
+			fromHeight = latestHeight + 1
+		}
+
+		// Switch to live tailing once the collection caught up with the chain
+		if s, ok := c.client.(BlockSubscriber); ok {
+			if fromHeight, err = c.followSubscription(ctx, s, fromHeight); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// followSubscription collects the transactions of the new blocks notified by the client.
+// It returns the height of the next block to collect when the subscription ends, so the
+// blocks produced while the client is not subscribed are collected by checking the
+// latest block height.
+func (c Collector) followSubscription(ctx context.Context, s BlockSubscriber, fromHeight int64) (int64, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	heights, err := s.SubscribeNewBlockHeights(ctx)
+	if err != nil {
+		// Fallback to check the latest block height after each interval
+		return fromHeight, nil
+	}
+
+	for height := range heights {
+		if err := c.collect(ctx, fromHeight, height); err != nil {
+			return fromHeight, err
+		}
+
+		if height >= fromHeight {
+			fromHeight = height + 1
+		}
+	}
+
+	return fromHeight, nil
+}
 
-	// Start collecting block transactions.
-	// The transactions channel is closed by the client when all transactions
-	// are collected or when an error occurs during the collection.
+// ResumeHeight returns the height to start collecting from to resume a previous collection.
+// It is the height after the latest block known by the data backend, or the given height
+// when it is greater. The checkpoint is used as the latest block when the data backend
+// supports checkpoints because it includes the blocks without transactions.
+func ResumeHeight(ctx context.Context, db adapter.Adapter, fromHeight int64) (int64, error) {
+	latestHeight, err := db.GetLatestHeight(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if cp, ok := db.(adapter.Checkpointer); ok {
+		height, err := cp.GetCheckpoint(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		if height > latestHeight {
+			latestHeight = height
+		}
+	}
+
+	if latestHeight >= fromHeight {
+		return latestHeight + 1, nil
+	}
+	return fromHeight, nil
+}
+
+// block contains the transactions of a block once they are fetched.
+type block struct {
+	height int64
+	txs    chan []cosmosclient.TX
+}
+
+// collect gathers and saves transactions for all blocks between two heights.
+func (c Collector) collect(ctx context.Context, fromHeight, toHeight int64) error {
+	if fromHeight == 0 {
+		fromHeight = 1
+	}
+
+	if fromHeight > toHeight {
+		return nil
+	}
+
+	var (
+		wg, wctx = errgroup.WithContext(ctx)
+
+		// Blocks to be fetched by the workers
+		fetches = make(chan block)
+
+		// Blocks to be saved in order. The size of the buffer limits the
+		// number of fetched blocks that are kept in memory until saved.
+		saves = make(chan block, c.workers)
+	)
+
+	// Enqueue the blocks to fetch and to save in the same order
 	wg.Go(func() error {
-		return c.client.CollectTXs(ctx, fromHeight, tc)
+		defer close(fetches)
+		defer close(saves)
+
+		for height := fromHeight; height <= toHeight; height++ {
+			b := block{height, make(chan []cosmosclient.TX, 1)}
+
+			select {
+			case <-wctx.Done():
+				return wctx.Err()
+			case saves <- b:
+			}
+
+			select {
+			case <-wctx.Done():
+				return wctx.Err()
+			case fetches <- b:
+			}
+		}
+
+		return nil
 	})
 
+	// Start the workers that fetch the block transactions concurrently
+	for i := 0; i < c.workers; i++ {
+		wg.Go(func() error {
+			for b := range fetches {
+				txs, err := c.client.GetBlockTXs(wctx, b.height)
+				if err != nil {
+					return err
+				}
+
+				b.txs <- txs
+			}
+
+			return nil
+		})
+	}
+
 	// The transactions for each block are saved in "bulks" so they are not
 	// kept in memory. Also, they are saved sequentially to avoid block height
 	// gaps that can occur if a group of transactions from a previous block
 	// fail to be saved.
 	wg.Go(func() error {
-		for txs := range tc {
-			if err := c.db.Save(ctx, txs); err != nil {
-				return err
+		cp, _ := c.db.(adapter.Checkpointer)
+
+		for b := range saves {
+			var txs []cosmosclient.TX
+
+			select {
+			case <-wctx.Done():
+				return wctx.Err()
+			case txs = <-b.txs:
+			}
+
+			if len(txs) > 0 {
+				if err := c.db.Save(wctx, txs); err != nil {
+					return err
+				}
+			}
+
+			if cp != nil {
+				if err := cp.SaveCheckpoint(wctx, b.height); err != nil {
+					return err
+				}
 			}
 		}
 
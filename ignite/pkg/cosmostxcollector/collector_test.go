@@ -3,13 +3,17 @@ package cosmostxcollector_test
 import (
 	"context"
 	"errors"
+	"math/rand"
 	"testing"
+	"time"
 
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ignite/cli/ignite/pkg/cosmosclient"
 	"github.com/ignite/cli/ignite/pkg/cosmostxcollector"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
 	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/mocks"
 )
 
@@ -21,24 +25,15 @@ func TestCollector(t *testing.T) {
 		fromHeight int64 = 1
 	)
 
-	txs := [][]cosmosclient.TX{{}, {}}
+	txs := [][]cosmosclient.TX{{newTX(1)}, {newTX(2)}}
 
 	client := mocks.NewTXsCollector(t)
-	client.EXPECT().
-		CollectTXs(
-			mock.Anything,
-			fromHeight,
-			mock.AnythingOfType("chan<- []cosmosclient.TX"),
-		).
-		Run(func(ctx context.Context, fromHeight int64, tc chan<- []cosmosclient.TX) {
-			defer close(tc)
+	client.EXPECT().LatestBlockHeight(mock.Anything).Return(3, nil).Times(1)
+	client.EXPECT().GetBlockTXs(mock.Anything, int64(1)).Return(txs[0], nil).Times(1)
+	client.EXPECT().GetBlockTXs(mock.Anything, int64(2)).Return(txs[1], nil).Times(1)
 
-			// Send the collected block transactions
-			tc <- txs[0]
-			tc <- txs[1]
-		}).
-		Return(nil).
-		Times(1)
+	// Blocks without transactions are not saved
+	client.EXPECT().GetBlockTXs(mock.Anything, int64(3)).Return(nil, nil).Times(1)
 
 	db := mocks.NewSaver(t)
 	db.EXPECT().
@@ -64,21 +59,67 @@ func TestCollector(t *testing.T) {
 	require.Equal(t, savedTXs, txs)
 }
 
+func TestCollectorWithWorkers(t *testing.T) {
+	// Arrange
+	const latestHeight = 50
+
+	client := &fakeClient{latestHeight: latestHeight}
+	db := &checkpointSaver{}
+	c := cosmostxcollector.New(db, client, cosmostxcollector.WithWorkers(8))
+	ctx := context.Background()
+
+	// Act
+	err := c.Collect(ctx, 1)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, db.heights, latestHeight)
+	require.Len(t, db.checkpoints, latestHeight)
+
+	// Transactions and checkpoints must be saved in block order
+	for i := 0; i < latestHeight; i++ {
+		height := int64(i + 1)
+
+		require.Equal(t, height, db.heights[i])
+		require.Equal(t, height, db.checkpoints[i])
+	}
+}
+
+func TestCollectorCheckpointWithError(t *testing.T) {
+	// Arrange
+	wantErr := errors.New("expected error")
+
+	client := &fakeClient{
+		latestHeight: 10,
+		errs:         map[int64]error{6: wantErr},
+	}
+	db := &checkpointSaver{}
+	c := cosmostxcollector.New(db, client, cosmostxcollector.WithWorkers(4))
+	ctx := context.Background()
+
+	// Act
+	err := c.Collect(ctx, 1)
+
+	// Assert
+	require.ErrorIs(t, err, wantErr)
+
+	// Blocks fetched before the failed one might not be saved but
+	// checkpoints must never skip a block or go past the failed one.
+	require.LessOrEqual(t, len(db.checkpoints), 5)
+	for i, height := range db.checkpoints {
+		require.EqualValues(t, i+1, height)
+	}
+}
+
 func TestCollectorWithCollectError(t *testing.T) {
 	// Arrange
 	wantErr := errors.New("expected error")
 
 	client := mocks.NewTXsCollector(t)
+	client.EXPECT().LatestBlockHeight(mock.Anything).Return(1, nil).Times(1)
 	client.EXPECT().
-		CollectTXs(
-			mock.Anything,
-			mock.AnythingOfType("int64"),
-			mock.AnythingOfType("chan<- []cosmosclient.TX"),
-		).
-		Run(func(ctx context.Context, fromHeight int64, tc chan<- []cosmosclient.TX) {
-			close(tc)
-		}).
-		Return(wantErr).
+		GetBlockTXs(mock.Anything, mock.AnythingOfType("int64")).
+		Return(nil, wantErr).
 		Times(1)
 
 	db := mocks.NewSaver(t)
@@ -97,22 +138,12 @@ func TestCollectorWithCollectError(t *testing.T) {
 func TestCollectorWithSaveError(t *testing.T) {
 	// Arrange
 	wantErr := errors.New("expected error")
-	var txs []cosmosclient.TX
 
 	client := mocks.NewTXsCollector(t)
+	client.EXPECT().LatestBlockHeight(mock.Anything).Return(1, nil).Times(1)
 	client.EXPECT().
-		CollectTXs(
-			mock.Anything,
-			mock.AnythingOfType("int64"),
-			mock.AnythingOfType("chan<- []cosmosclient.TX"),
-		).
-		Run(func(ctx context.Context, fromHeight int64, tc chan<- []cosmosclient.TX) {
-			defer close(tc)
-
-			// Send the collected block transactions
-			tc <- txs
-		}).
-		Return(nil).
+		GetBlockTXs(mock.Anything, mock.AnythingOfType("int64")).
+		Return([]cosmosclient.TX{newTX(1)}, nil).
 		Times(1)
 
 	db := mocks.NewSaver(t)
@@ -133,3 +164,246 @@ func TestCollectorWithSaveError(t *testing.T) {
 	// Assert
 	require.ErrorIs(t, err, wantErr)
 }
+
+func TestCollectorFollow(t *testing.T) {
+	// Arrange
+	var savedTXs []cosmosclient.TX
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := mocks.NewTXsCollector(t)
+	client.EXPECT().LatestBlockHeight(mock.Anything).Return(2, nil).Once()
+	client.EXPECT().LatestBlockHeight(mock.Anything).Return(2, nil).Once()
+	client.EXPECT().LatestBlockHeight(mock.Anything).Return(3, nil)
+
+	// Each block must be collected only once
+	for height := int64(1); height <= 3; height++ {
+		client.EXPECT().
+			GetBlockTXs(mock.Anything, height).
+			Return([]cosmosclient.TX{newTX(height)}, nil).
+			Once()
+	}
+
+	db := mocks.NewSaver(t)
+	db.EXPECT().
+		Save(mock.Anything, mock.AnythingOfType("[]cosmosclient.TX")).
+		Run(func(ctx context.Context, txs []cosmosclient.TX) {
+			savedTXs = append(savedTXs, txs...)
+
+			// Stop following after the last block is collected
+			if txs[0].Raw.Height == 3 {
+				cancel()
+			}
+		}).
+		Return(nil).
+		Times(3)
+
+	c := cosmostxcollector.New(db, client)
+
+	// Act
+	err := c.Follow(ctx, 1, time.Millisecond)
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []cosmosclient.TX{newTX(1), newTX(2), newTX(3)}, savedTXs)
+}
+
+func TestCollectorFollowSubscription(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	heights := make(chan int64)
+	client := &subscriberClient{
+		fakeClient: fakeClient{latestHeight: 3},
+		heights:    heights,
+	}
+	db := &checkpointSaver{
+		onCheckpoint: func(height int64) {
+			// Stop following after the last notified block is collected
+			if height == 6 {
+				cancel()
+			}
+		},
+	}
+	c := cosmostxcollector.New(db, client, cosmostxcollector.WithWorkers(2))
+
+	// Notify new blocks once the collector is subscribed.
+	// The second notification skips a block which must be collected too.
+	go func() {
+		heights <- 4
+		heights <- 6
+	}()
+
+	// Act
+	err := c.Follow(ctx, 1, time.Hour)
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6}, db.checkpoints)
+}
+
+type latestHeightAdapter struct {
+	adapter.Adapter
+
+	height int64
+}
+
+func (a latestHeightAdapter) GetLatestHeight(context.Context) (int64, error) {
+	return a.height, nil
+}
+
+func TestResumeHeight(t *testing.T) {
+	tests := []struct {
+		name         string
+		latestHeight int64
+		fromHeight   int64
+		want         int64
+	}{
+		{
+			name:         "empty database",
+			latestHeight: 0,
+			fromHeight:   1,
+			want:         1,
+		},
+		{
+			name:         "resume after latest height",
+			latestHeight: 42,
+			fromHeight:   1,
+			want:         43,
+		},
+		{
+			name:         "start from height after latest height",
+			latestHeight: 42,
+			fromHeight:   100,
+			want:         100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			height, err := cosmostxcollector.ResumeHeight(
+				context.Background(),
+				latestHeightAdapter{height: tt.latestHeight},
+				tt.fromHeight,
+			)
+
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, tt.want, height)
+		})
+	}
+}
+
+type checkpointAdapter struct {
+	latestHeightAdapter
+
+	checkpoint int64
+}
+
+func (a checkpointAdapter) SaveCheckpoint(context.Context, int64) error {
+	return nil
+}
+
+func (a checkpointAdapter) GetCheckpoint(context.Context) (int64, error) {
+	return a.checkpoint, nil
+}
+
+func TestResumeHeightWithCheckpoint(t *testing.T) {
+	// Arrange
+	db := checkpointAdapter{
+		latestHeightAdapter: latestHeightAdapter{height: 42},
+		checkpoint:          50,
+	}
+
+	// Act
+	height, err := cosmostxcollector.ResumeHeight(context.Background(), db, 1)
+
+	// Assert
+	require.NoError(t, err)
+	require.EqualValues(t, 51, height)
+}
+
+func newTX(height int64) cosmosclient.TX {
+	return cosmosclient.TX{Raw: &ctypes.ResultTx{Height: height}}
+}
+
+// fakeClient returns a transaction for each block.
+// Block TXs are returned in random order by delaying each call.
+type fakeClient struct {
+	latestHeight int64
+	errs         map[int64]error
+}
+
+func (c *fakeClient) LatestBlockHeight(context.Context) (int64, error) {
+	return c.latestHeight, nil
+}
+
+func (c *fakeClient) GetBlockTXs(_ context.Context, height int64) ([]cosmosclient.TX, error) {
+	time.Sleep(time.Duration(rand.Intn(1000)) * time.Microsecond) //nolint:gosec
+
+	if err, ok := c.errs[height]; ok {
+		return nil, err
+	}
+
+	return []cosmosclient.TX{newTX(height)}, nil
+}
+
+type subscriberClient struct {
+	fakeClient
+
+	heights chan int64
+}
+
+func (c *subscriberClient) SubscribeNewBlockHeights(ctx context.Context) (<-chan int64, error) {
+	heights := make(chan int64)
+
+	go func() {
+		defer close(heights)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case h := <-c.heights:
+				heights <- h
+			}
+		}
+	}()
+
+	return heights, nil
+}
+
+type checkpointSaver struct {
+	heights      []int64
+	checkpoints  []int64
+	onCheckpoint func(int64)
+}
+
+func (s *checkpointSaver) Save(_ context.Context, txs []cosmosclient.TX) error {
+	for _, tx := range txs {
+		s.heights = append(s.heights, tx.Raw.Height)
+	}
+
+	return nil
+}
+
+func (s *checkpointSaver) SaveCheckpoint(_ context.Context, height int64) error {
+	s.checkpoints = append(s.checkpoints, height)
+
+	if s.onCheckpoint != nil {
+		s.onCheckpoint(height)
+	}
+
+	return nil
+}
+
+func (s *checkpointSaver) GetCheckpoint(context.Context) (int64, error) {
+	if len(s.checkpoints) == 0 {
+		return 0, nil
+	}
+
+	return s.checkpoints[len(s.checkpoints)-1], nil
+}
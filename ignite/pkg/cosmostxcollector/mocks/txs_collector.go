@@ -23,42 +23,94 @@ func (_m *TXsCollector) EXPECT() *TXsCollector_Expecter {
 	return &TXsCollector_Expecter{mock: &_m.Mock}
 }
 
-// CollectTXs provides a mock function with given fields: ctx, fromHeight, tc
-func (_m *TXsCollector) CollectTXs(ctx context.Context, fromHeight int64, tc chan<- []cosmosclient.TX) error {
-	ret := _m.Called(ctx, fromHeight, tc)
+// GetBlockTXs provides a mock function with given fields: ctx, height
+func (_m *TXsCollector) GetBlockTXs(ctx context.Context, height int64) ([]cosmosclient.TX, error) {
+	ret := _m.Called(ctx, height)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, chan<- []cosmosclient.TX) error); ok {
-		r0 = rf(ctx, fromHeight, tc)
+	var r0 []cosmosclient.TX
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []cosmosclient.TX); ok {
+		r0 = rf(ctx, height)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]cosmosclient.TX)
+		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TXsCollector_GetBlockTXs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockTXs'
+type TXsCollector_GetBlockTXs_Call struct {
+	*mock.Call
+}
+
+// GetBlockTXs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - height int64
+func (_e *TXsCollector_Expecter) GetBlockTXs(ctx interface{}, height interface{}) *TXsCollector_GetBlockTXs_Call {
+	return &TXsCollector_GetBlockTXs_Call{Call: _e.mock.On("GetBlockTXs", ctx, height)}
+}
+
+func (_c *TXsCollector_GetBlockTXs_Call) Run(run func(ctx context.Context, height int64)) *TXsCollector_GetBlockTXs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *TXsCollector_GetBlockTXs_Call) Return(_a0 []cosmosclient.TX, _a1 error) *TXsCollector_GetBlockTXs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// LatestBlockHeight provides a mock function with given fields: ctx
+func (_m *TXsCollector) LatestBlockHeight(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// TXsCollector_CollectTXs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CollectTXs'
-type TXsCollector_CollectTXs_Call struct {
+// TXsCollector_LatestBlockHeight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LatestBlockHeight'
+type TXsCollector_LatestBlockHeight_Call struct {
 	*mock.Call
 }
 
-// CollectTXs is a helper method to define mock.On call
+// LatestBlockHeight is a helper method to define mock.On call
 //   - ctx context.Context
-//   - fromHeight int64
-//   - tc chan<- []cosmosclient.TX
-func (_e *TXsCollector_Expecter) CollectTXs(ctx interface{}, fromHeight interface{}, tc interface{}) *TXsCollector_CollectTXs_Call {
-	return &TXsCollector_CollectTXs_Call{Call: _e.mock.On("CollectTXs", ctx, fromHeight, tc)}
+func (_e *TXsCollector_Expecter) LatestBlockHeight(ctx interface{}) *TXsCollector_LatestBlockHeight_Call {
+	return &TXsCollector_LatestBlockHeight_Call{Call: _e.mock.On("LatestBlockHeight", ctx)}
 }
 
-func (_c *TXsCollector_CollectTXs_Call) Run(run func(ctx context.Context, fromHeight int64, tc chan<- []cosmosclient.TX)) *TXsCollector_CollectTXs_Call {
+func (_c *TXsCollector_LatestBlockHeight_Call) Run(run func(ctx context.Context)) *TXsCollector_LatestBlockHeight_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(chan<- []cosmosclient.TX))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *TXsCollector_CollectTXs_Call) Return(_a0 error) *TXsCollector_CollectTXs_Call {
-	_c.Call.Return(_a0)
+func (_c *TXsCollector_LatestBlockHeight_Call) Return(_a0 int64, _a1 error) *TXsCollector_LatestBlockHeight_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
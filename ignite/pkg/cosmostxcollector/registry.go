@@ -0,0 +1,61 @@
+package cosmostxcollector
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	ibctypes "github.com/cosmos/ibc-go/v7/modules/core/types"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+)
+
+// NewInterfaceRegistry returns an interface registry with the messages of the Cosmos SDK
+// and IBC modules registered, to decode the transactions of a chain when its types are
+// not available. The messages of the app specific modules are not registered.
+func NewInterfaceRegistry() codectypes.InterfaceRegistry {
+	registry := codectypes.NewInterfaceRegistry()
+
+	for _, register := range []func(codectypes.InterfaceRegistry){
+		std.RegisterInterfaces,
+		authtypes.RegisterInterfaces,
+		vestingtypes.RegisterInterfaces,
+		authz.RegisterInterfaces,
+		banktypes.RegisterInterfaces,
+		consensustypes.RegisterInterfaces,
+		crisistypes.RegisterInterfaces,
+		distrtypes.RegisterInterfaces,
+		evidencetypes.RegisterInterfaces,
+		feegrant.RegisterInterfaces,
+		govv1.RegisterInterfaces,
+		govv1beta1.RegisterInterfaces,
+		group.RegisterInterfaces,
+		minttypes.RegisterInterfaces,
+		paramsproposal.RegisterInterfaces,
+		slashingtypes.RegisterInterfaces,
+		stakingtypes.RegisterInterfaces,
+		upgradetypes.RegisterInterfaces,
+		ibctransfertypes.RegisterInterfaces,
+		ibctypes.RegisterInterfaces,
+		ibctm.RegisterInterfaces,
+	} {
+		register(registry)
+	}
+
+	return registry
+}
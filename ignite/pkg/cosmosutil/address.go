@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // ChangeAddressPrefix returns the address with another prefix.
@@ -23,3 +24,11 @@ func GetAddressPrefix(address string) (string, error) {
 	prefix, _, err := bech32.DecodeAndConvert(address)
 	return prefix, err
 }
+
+// ModuleAddress returns the address of the account of a module with a bech 32 prefix.
+func ModuleAddress(moduleName, prefix string) (string, error) {
+	if prefix == "" {
+		return "", errors.New("empty prefix")
+	}
+	return bech32.ConvertAndEncode(prefix, authtypes.NewModuleAddress(moduleName))
+}
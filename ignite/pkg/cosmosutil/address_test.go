@@ -79,3 +79,42 @@ func TestGetPrefix(t *testing.T) {
 	_, err = cosmosutil.GetAddressPrefix("mars1c6ac48k2ur9tl3tf0cpntlw5068kvp8xf4xq37")
 	require.Error(t, err)
 }
+
+func TestModuleAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		module  string
+		prefix  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "distribution module",
+			module: "distribution",
+			prefix: "cosmos",
+			want:   "cosmos1jv65s3grqf6v6jl3dp4t6c9t9rk99cd88lyufl",
+		},
+		{
+			name:   "gov module",
+			module: "gov",
+			prefix: "cosmos",
+			want:   "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+		},
+		{
+			name:    "empty prefix",
+			module:  "gov",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cosmosutil.ModuleAddress(tt.module, tt.prefix)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
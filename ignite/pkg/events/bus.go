@@ -2,6 +2,7 @@ package events
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/ignite/cli/ignite/pkg/cliui/colors"
 )
@@ -21,6 +22,7 @@ type (
 	Bus struct {
 		evChan  chan Event
 		stopped bool
+		hooks   *hooks
 	}
 
 	// BusOption configures the Bus.
@@ -38,6 +40,7 @@ func WithBufferSize(size int) BusOption {
 func NewBus(options ...BusOption) Bus {
 	bus := Bus{
 		evChan: make(chan Event, DefaultBufferSize),
+		hooks:  &hooks{fns: make(map[int]func(Event))},
 	}
 
 	for _, apply := range options {
@@ -50,11 +53,29 @@ func NewBus(options ...BusOption) Bus {
 // Send sends a new event to bus.
 // This method will block if the event bus buffer is full.
 func (b Bus) Send(message string, options ...Option) {
+	b.SendEvent(New(message, options...))
+}
+
+// SendEvent sends an existing event to bus.
+// This method will block if the event bus buffer is full.
+func (b Bus) SendEvent(e Event) {
 	if b.evChan == nil || b.stopped {
 		return
 	}
 
-	b.evChan <- New(message, options...)
+	b.hooks.call(e)
+	b.evChan <- e
+}
+
+// Hook calls fn with each event sent to the bus until the returned function is called.
+// The hook is called before the event is queued, so it must not block.
+// Hooks are ignored by buses that are not created with NewBus.
+func (b Bus) Hook(fn func(Event)) (remove func()) {
+	if b.hooks == nil {
+		return func() {}
+	}
+
+	return b.hooks.add(fn)
 }
 
 // Sendf sends a new event with a formatted message to bus.
@@ -95,3 +116,40 @@ func (b *Bus) Stop() {
 
 	close(b.evChan)
 }
+
+// hooks are the functions called with the events sent to a bus.
+// They are shared by the copies of the bus.
+type hooks struct {
+	mu   sync.RWMutex
+	fns  map[int]func(Event)
+	next int
+}
+
+func (h *hooks) add(fn func(Event)) (remove func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	id := h.next
+	h.next++
+	h.fns[id] = fn
+
+	return func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		delete(h.fns, id)
+	}
+}
+
+func (h *hooks) call(e Event) {
+	if h == nil {
+		return
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for _, fn := range h.fns {
+		fn(e)
+	}
+}
@@ -148,6 +148,25 @@ func TestBusSendError(t *testing.T) {
 	}
 }
 
+func TestBusSendEvent(t *testing.T) {
+	// Arrange
+	bus := events.NewBus()
+	defer bus.Stop()
+
+	want := events.New("test", events.ProgressFinish(), events.Icon("*"), events.Group("path"))
+
+	// Act
+	bus.SendEvent(want)
+
+	// Assert
+	select {
+	case e := <-bus.Events():
+		require.Equal(t, want, e)
+	default:
+		t.Error("expected an event to be received")
+	}
+}
+
 type testEventView struct {
 	message string
 }
@@ -209,3 +228,24 @@ func TestBusStop(t *testing.T) {
 	// Assert
 	require.False(t, ok, "expected no events after bus stopped")
 }
+
+func TestBusHook(t *testing.T) {
+	// Arrange
+	bus := events.NewBus()
+	defer bus.Stop()
+
+	var hooked []string
+	remove := bus.Hook(func(e events.Event) {
+		hooked = append(hooked, e.Message)
+	})
+
+	// Act
+	bus.Send("foo")
+	remove()
+	bus.Send("bar")
+
+	// Assert
+	require.Equal(t, []string{"foo"}, hooked)
+	require.Equal(t, "foo", (<-bus.Events()).Message)
+	require.Equal(t, "bar", (<-bus.Events()).Message)
+}
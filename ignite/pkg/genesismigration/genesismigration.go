@@ -0,0 +1,294 @@
+// Package genesismigration applies migrations to the module states of an exported genesis,
+// so a chain state can be imported after the state schema of a module changed.
+//
+// Migrations are discovered in a directory with a subdirectory for each module:
+//
+//	migrations/
+//	  blog/
+//	    001_rename_title.json
+//	    002_split_posts.go
+//
+// Migrations are applied in the order of their file names and receive the state
+// of the module, which is the value of "app_state.<module>" in the genesis.
+//
+// JSON migrations contain a list of operations that use jq-style paths, see Operation.
+// Go migrations are programs that read the module state from the standard input and
+// write the new state to the standard output. They are run with "go run" in the app
+// directory and must use the "ignore" build constraint, so they are not built as
+// part of the app packages.
+package genesismigration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/ignite/pkg/gocmd"
+)
+
+const (
+	// DefaultDir is the default migrations directory relative to the app directory.
+	DefaultDir = "migrations"
+
+	extJSON = ".json"
+	extGo   = ".go"
+
+	fieldAppState = "app_state"
+)
+
+// Kind defines the kind of migration.
+type Kind int
+
+const (
+	// KindJSON is a migration defined as a list of JSON operations.
+	KindJSON Kind = iota
+
+	// KindGo is a migration defined as a Go program.
+	KindGo
+)
+
+// Migration defines a migration of a module genesis state.
+type Migration struct {
+	// ID is the unique ID of the migration which is "<module>/<file name>".
+	ID string
+
+	// Module is the name of the module whose state is migrated.
+	Module string
+
+	// Path is the path to the migration file.
+	Path string
+
+	// Kind is the kind of migration.
+	Kind Kind
+}
+
+// Apply migrates a module state. The workdir is the app directory where Go migrations are run.
+func (m Migration) Apply(ctx context.Context, workdir string, state []byte) ([]byte, error) {
+	switch m.Kind {
+	case KindJSON:
+		ops, err := ParseOperations(m.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		return applyOperations(state, ops)
+	case KindGo:
+		return m.run(ctx, workdir, state)
+	default:
+		return nil, fmt.Errorf("unknown migration kind: %d", m.Kind)
+	}
+}
+
+func (m Migration) run(ctx context.Context, workdir string, state []byte) ([]byte, error) {
+	path, err := filepath.Abs(m.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	err = exec.Exec(
+		ctx,
+		[]string{gocmd.Name(), "run", path},
+		exec.StepOption(step.Workdir(workdir)),
+		exec.StepOption(step.Stdin(bytes.NewReader(state))),
+		exec.StepOption(step.Stdout(&out)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !json.Valid(out.Bytes()) {
+		return nil, fmt.Errorf("migration %s returned an invalid JSON state", m.ID)
+	}
+
+	return out.Bytes(), nil
+}
+
+// Discover returns the migrations found in a directory sorted by ID.
+// No migrations are returned when the directory doesn't exist.
+func Discover(dir string) ([]Migration, error) {
+	modules, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var migrations []Migration
+	for _, module := range modules {
+		if !module.IsDir() {
+			continue
+		}
+
+		files, err := os.ReadDir(filepath.Join(dir, module.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, f := range files {
+			if f.IsDir() {
+				continue
+			}
+
+			var kind Kind
+			switch filepath.Ext(f.Name()) {
+			case extJSON:
+				kind = KindJSON
+			case extGo:
+				if strings.HasSuffix(f.Name(), "_test.go") {
+					continue
+				}
+
+				kind = KindGo
+			default:
+				continue
+			}
+
+			migrations = append(migrations, Migration{
+				ID:     fmt.Sprintf("%s/%s", module.Name(), f.Name()),
+				Module: module.Name(),
+				Path:   filepath.Join(dir, module.Name(), f.Name()),
+				Kind:   kind,
+			})
+		}
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].ID < migrations[j].ID
+	})
+
+	return migrations, nil
+}
+
+// Result contains the result of applying a migration.
+type Result struct {
+	// Migration is the applied migration.
+	Migration Migration
+
+	// Diff is a unified diff of the module state changes.
+	// It's empty when the migration didn't change the state.
+	Diff string
+}
+
+// Migrate applies migrations to the module states of a genesis file.
+// The genesis file is only written when at least one migration changed a module state.
+// Migrations of modules without state in the genesis are applied to an empty state.
+func Migrate(ctx context.Context, genesisPath, workdir string, migrations []Migration) ([]Result, error) {
+	data, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var genesis map[string]json.RawMessage
+	if err := json.Unmarshal(data, &genesis); err != nil {
+		return nil, fmt.Errorf("invalid genesis: %w", err)
+	}
+
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(genesis[fieldAppState], &appState); err != nil {
+		return nil, fmt.Errorf("invalid genesis app state: %w", err)
+	}
+
+	if appState == nil {
+		appState = make(map[string]json.RawMessage)
+	}
+
+	var (
+		results []Result
+		changed bool
+	)
+
+	for _, m := range migrations {
+		state, ok := appState[m.Module]
+		if !ok {
+			state = json.RawMessage("{}")
+		}
+
+		newState, err := m.Apply(ctx, workdir, state)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s failed: %w", m.ID, err)
+		}
+
+		diff, err := Diff(m.ID, state, newState)
+		if err != nil {
+			return nil, err
+		}
+
+		if diff != "" {
+			appState[m.Module] = newState
+			changed = true
+		}
+
+		results = append(results, Result{
+			Migration: m,
+			Diff:      diff,
+		})
+	}
+
+	if !changed {
+		return results, nil
+	}
+
+	if genesis[fieldAppState], err = json.Marshal(appState); err != nil {
+		return nil, err
+	}
+
+	if data, err = json.MarshalIndent(genesis, "", "  "); err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(genesisPath, data, 0o644); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// Diff returns a unified diff between two JSON values.
+// The values are indented before comparing them so the diff shows the changed fields.
+// An empty diff is returned when the values are equal.
+func Diff(name string, a, b []byte) (string, error) {
+	ia, err := indentJSON(a)
+	if err != nil {
+		return "", err
+	}
+
+	ib, err := indentJSON(b)
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(ia),
+		B:        difflib.SplitLines(ib),
+		FromFile: fmt.Sprintf("a/%s", name),
+		ToFile:   fmt.Sprintf("b/%s", name),
+		Context:  3,
+	})
+}
+
+func indentJSON(data []byte) (string, error) {
+	// decode and encode the value so the keys are sorted and the diff only shows value changes
+	var v any
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return "", err
+	}
+
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(b) + "\n", nil
+}
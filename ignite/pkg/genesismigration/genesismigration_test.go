@@ -0,0 +1,160 @@
+package genesismigration_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/genesismigration"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func TestDiscover(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "blog", "002_b.go"), "")
+	writeFile(t, filepath.Join(dir, "blog", "001_a.json"), "")
+	writeFile(t, filepath.Join(dir, "blog", "001_a_test.go"), "")
+	writeFile(t, filepath.Join(dir, "blog", "README.md"), "")
+	writeFile(t, filepath.Join(dir, "bank", "001_a.json"), "")
+	writeFile(t, filepath.Join(dir, "README.md"), "")
+
+	// Act
+	migrations, err := genesismigration.Discover(dir)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []genesismigration.Migration{
+		{
+			ID:     "bank/001_a.json",
+			Module: "bank",
+			Path:   filepath.Join(dir, "bank", "001_a.json"),
+			Kind:   genesismigration.KindJSON,
+		},
+		{
+			ID:     "blog/001_a.json",
+			Module: "blog",
+			Path:   filepath.Join(dir, "blog", "001_a.json"),
+			Kind:   genesismigration.KindJSON,
+		},
+		{
+			ID:     "blog/002_b.go",
+			Module: "blog",
+			Path:   filepath.Join(dir, "blog", "002_b.go"),
+			Kind:   genesismigration.KindGo,
+		},
+	}, migrations)
+}
+
+func TestDiscoverMissingDir(t *testing.T) {
+	migrations, err := genesismigration.Discover(filepath.Join(t.TempDir(), "missing"))
+
+	require.NoError(t, err)
+	require.Empty(t, migrations)
+}
+
+func TestMigrate(t *testing.T) {
+	// Arrange
+	var (
+		ctx         = context.Background()
+		dir         = t.TempDir()
+		genesisPath = filepath.Join(dir, "genesis.json")
+	)
+
+	writeFile(t, genesisPath, `{
+		"chain_id": "test-1",
+		"app_state": {
+			"bank": {"balances": []},
+			"blog": {"posts": [{"id": "1", "title": "hello"}]}
+		}
+	}`)
+	writeFile(t, filepath.Join(dir, "migrations", "blog", "001_rename.json"), `[
+		{"op": "rename", "path": ".posts[].title", "to": "name"}
+	]`)
+	writeFile(t, filepath.Join(dir, "migrations", "blog", "002_noop.json"), `[
+		{"op": "delete", "path": ".missing"}
+	]`)
+	writeFile(t, filepath.Join(dir, "migrations", "blog", "003_count.go"), `//go:build ignore
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+)
+
+func main() {
+	var state map[string]any
+	if err := json.NewDecoder(os.Stdin).Decode(&state); err != nil {
+		panic(err)
+	}
+
+	state["count"] = len(state["posts"].([]any))
+
+	if err := json.NewEncoder(os.Stdout).Encode(state); err != nil {
+		panic(err)
+	}
+}
+`)
+
+	migrations, err := genesismigration.Discover(filepath.Join(dir, "migrations"))
+	require.NoError(t, err)
+
+	// Act
+	results, err := genesismigration.Migrate(ctx, genesisPath, dir, migrations)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	require.Contains(t, results[0].Diff, `-      "title": "hello"`)
+	require.Contains(t, results[0].Diff, `+      "name": "hello"`)
+	require.Empty(t, results[1].Diff)
+	require.Contains(t, results[2].Diff, `+  "count": 1,`)
+
+	data, err := os.ReadFile(genesisPath)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"chain_id": "test-1",
+		"app_state": {
+			"bank": {"balances": []},
+			"blog": {"count": 1, "posts": [{"id": "1", "name": "hello"}]}
+		}
+	}`, string(data))
+}
+
+func TestMigrateWithError(t *testing.T) {
+	// Arrange
+	var (
+		ctx         = context.Background()
+		dir         = t.TempDir()
+		genesisPath = filepath.Join(dir, "genesis.json")
+		genesis     = `{"app_state": {"blog": {"count": "foo"}}}`
+	)
+
+	writeFile(t, genesisPath, genesis)
+	writeFile(t, filepath.Join(dir, "migrations", "blog", "001_convert.json"), `[
+		{"op": "convert", "path": ".count", "type": "number"}
+	]`)
+
+	migrations, err := genesismigration.Discover(filepath.Join(dir, "migrations"))
+	require.NoError(t, err)
+
+	// Act
+	_, err = genesismigration.Migrate(ctx, genesisPath, dir, migrations)
+
+	// Assert
+	require.ErrorContains(t, err, "blog/001_convert.json")
+
+	data, err := os.ReadFile(genesisPath)
+	require.NoError(t, err)
+	require.Equal(t, genesis, string(data))
+}
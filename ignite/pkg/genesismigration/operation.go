@@ -0,0 +1,379 @@
+package genesismigration
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	// OpSet sets a value, creating the missing objects of the path.
+	OpSet = "set"
+
+	// OpDefault sets a value only when it doesn't exist.
+	OpDefault = "default"
+
+	// OpDelete deletes an object field.
+	OpDelete = "delete"
+
+	// OpRename renames an object field.
+	OpRename = "rename"
+
+	// OpConvert converts a value to a "string", "number" or "bool" type.
+	OpConvert = "convert"
+)
+
+const (
+	typeString = "string"
+	typeNumber = "number"
+	typeBool   = "bool"
+)
+
+// ErrInvalidPath is returned when an operation path is not valid.
+var ErrInvalidPath = errors.New("invalid path")
+
+// Operation defines a JSON migration operation.
+//
+// Paths use a subset of the jq syntax where ".a.b" selects the field "b" of
+// the object in field "a", ".a[0]" selects the first element of the array in
+// field "a" and ".a[]" selects all the elements. Fields with special characters
+// can be quoted like `."a.b"`. For example, to rename the "title" field of all
+// the posts in the state of a module:
+//
+//	[{"op": "rename", "path": ".posts[].title", "to": "name"}]
+//
+// Fields of paths that don't exist are ignored, except when they are set.
+type Operation struct {
+	// Op is the name of the operation.
+	Op string `json:"op"`
+
+	// Path selects the values to change.
+	Path string `json:"path"`
+
+	// Value is the value for "set" and "default" operations.
+	Value json.RawMessage `json:"value,omitempty"`
+
+	// To is the new field name for "rename" operations.
+	To string `json:"to,omitempty"`
+
+	// Type is the value type for "convert" operations.
+	Type string `json:"type,omitempty"`
+}
+
+// ParseOperations parses the operations of a JSON migration file.
+func ParseOperations(path string) ([]Operation, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var ops []Operation
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.DisallowUnknownFields()
+	if err := d.Decode(&ops); err != nil {
+		return nil, fmt.Errorf("invalid migration %s: %w", path, err)
+	}
+
+	return ops, nil
+}
+
+type segmentKind int
+
+const (
+	segmentField segmentKind = iota
+	segmentIndex
+	segmentIterate
+)
+
+type segment struct {
+	kind  segmentKind
+	field string
+	index int
+}
+
+func parsePath(path string) ([]segment, error) {
+	var (
+		segs []segment
+		s    = path
+	)
+
+	for s != "" {
+		switch {
+		case strings.HasPrefix(s, "[]"):
+			segs = append(segs, segment{kind: segmentIterate})
+			s = s[2:]
+		case strings.HasPrefix(s, "["):
+			end := strings.IndexByte(s, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("%w %q: missing ']'", ErrInvalidPath, path)
+			}
+
+			i, err := strconv.Atoi(s[1:end])
+			if err != nil || i < 0 {
+				return nil, fmt.Errorf("%w %q: invalid index %q", ErrInvalidPath, path, s[1:end])
+			}
+
+			segs = append(segs, segment{kind: segmentIndex, index: i})
+			s = s[end+1:]
+		case strings.HasPrefix(s, `."`):
+			end := strings.IndexByte(s[2:], '"')
+			if end == -1 {
+				return nil, fmt.Errorf("%w %q: missing '\"'", ErrInvalidPath, path)
+			}
+
+			segs = append(segs, segment{kind: segmentField, field: s[2 : end+2]})
+			s = s[end+3:]
+		case strings.HasPrefix(s, "."):
+			end := strings.IndexAny(s[1:], ".[")
+			if end == -1 {
+				end = len(s) - 1
+			}
+
+			field := s[1 : end+1]
+			if field == "" {
+				return nil, fmt.Errorf("%w %q: empty field name", ErrInvalidPath, path)
+			}
+
+			segs = append(segs, segment{kind: segmentField, field: field})
+			s = s[end+1:]
+		default:
+			return nil, fmt.Errorf("%w %q: expected '.' or '['", ErrInvalidPath, path)
+		}
+	}
+
+	if len(segs) == 0 {
+		return nil, fmt.Errorf("%w %q: the path must select a field or element", ErrInvalidPath, path)
+	}
+
+	return segs, nil
+}
+
+func applyOperations(state []byte, ops []Operation) ([]byte, error) {
+	var root any
+	d := json.NewDecoder(bytes.NewReader(state))
+	d.UseNumber()
+	if err := d.Decode(&root); err != nil {
+		return nil, err
+	}
+
+	for i, op := range ops {
+		if err := applyOperation(root, op); err != nil {
+			return nil, fmt.Errorf("operation %d (%s %s): %w", i+1, op.Op, op.Path, err)
+		}
+	}
+
+	return json.Marshal(root)
+}
+
+func applyOperation(root any, op Operation) error {
+	segs, err := parsePath(op.Path)
+	if err != nil {
+		return err
+	}
+
+	var value any
+	switch op.Op {
+	case OpSet, OpDefault:
+		if len(op.Value) == 0 {
+			return errors.New("missing value")
+		}
+
+		d := json.NewDecoder(bytes.NewReader(op.Value))
+		d.UseNumber()
+		if err := d.Decode(&value); err != nil {
+			return err
+		}
+	case OpRename:
+		if op.To == "" {
+			return errors.New("missing new field name")
+		}
+	case OpConvert:
+		if op.Type != typeString && op.Type != typeNumber && op.Type != typeBool {
+			return fmt.Errorf("invalid type %q, expected %q, %q or %q", op.Type, typeString, typeNumber, typeBool)
+		}
+	case OpDelete:
+	default:
+		return fmt.Errorf("unknown operation %q", op.Op)
+	}
+
+	create := op.Op == OpSet || op.Op == OpDefault
+	parents, err := resolve([]any{root}, segs[:len(segs)-1], create)
+	if err != nil {
+		return err
+	}
+
+	last := segs[len(segs)-1]
+	for _, p := range parents {
+		if err := applyToChildren(p, last, op, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolve returns the values selected by the path segments.
+// When create is true the missing object fields are created.
+func resolve(nodes []any, segs []segment, create bool) ([]any, error) {
+	for _, seg := range segs {
+		var next []any
+
+		for _, n := range nodes {
+			switch seg.kind {
+			case segmentField:
+				obj, ok := n.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("field %q: value is not an object", seg.field)
+				}
+
+				v, ok := obj[seg.field]
+				if !ok {
+					if !create {
+						continue
+					}
+
+					v = make(map[string]any)
+					obj[seg.field] = v
+				}
+
+				next = append(next, v)
+			case segmentIndex:
+				arr, ok := n.([]any)
+				if !ok {
+					return nil, fmt.Errorf("index %d: value is not an array", seg.index)
+				}
+
+				if seg.index < len(arr) {
+					next = append(next, arr[seg.index])
+				}
+			case segmentIterate:
+				arr, ok := n.([]any)
+				if !ok {
+					return nil, errors.New("iterate: value is not an array")
+				}
+
+				next = append(next, arr...)
+			}
+		}
+
+		nodes = next
+	}
+
+	return nodes, nil
+}
+
+// applyToChildren applies an operation to the children of a value selected by a path segment.
+func applyToChildren(parent any, seg segment, op Operation, value any) error {
+	if seg.kind == segmentField {
+		obj, ok := parent.(map[string]any)
+		if !ok {
+			return fmt.Errorf("field %q: value is not an object", seg.field)
+		}
+
+		current, exists := obj[seg.field]
+
+		switch op.Op {
+		case OpSet:
+			obj[seg.field] = value
+		case OpDefault:
+			if !exists {
+				obj[seg.field] = value
+			}
+		case OpDelete:
+			delete(obj, seg.field)
+		case OpRename:
+			if exists {
+				delete(obj, seg.field)
+				obj[op.To] = current
+			}
+		case OpConvert:
+			if exists {
+				v, err := convert(current, op.Type)
+				if err != nil {
+					return fmt.Errorf("field %q: %w", seg.field, err)
+				}
+
+				obj[seg.field] = v
+			}
+		}
+
+		return nil
+	}
+
+	arr, ok := parent.([]any)
+	if !ok {
+		return errors.New("value is not an array")
+	}
+
+	if op.Op == OpDelete || op.Op == OpRename {
+		return fmt.Errorf("the %q operation can only be applied to object fields", op.Op)
+	}
+
+	for i := range arr {
+		if seg.kind == segmentIndex && i != seg.index {
+			continue
+		}
+
+		switch op.Op {
+		case OpSet:
+			arr[i] = value
+		case OpConvert:
+			v, err := convert(arr[i], op.Type)
+			if err != nil {
+				return fmt.Errorf("index %d: %w", i, err)
+			}
+
+			arr[i] = v
+		}
+	}
+
+	return nil
+}
+
+func convert(v any, typ string) (any, error) {
+	// null values are kept because they represent unset values
+	if v == nil {
+		return nil, nil
+	}
+
+	s := fmt.Sprint(v)
+	if _, ok := v.(map[string]any); ok {
+		return nil, errors.New("objects can't be converted")
+	}
+
+	if _, ok := v.([]any); ok {
+		return nil, errors.New("arrays can't be converted")
+	}
+
+	switch typ {
+	case typeString:
+		return s, nil
+	case typeNumber:
+		if s == "" {
+			return json.Number("0"), nil
+		}
+
+		if _, err := strconv.ParseFloat(s, 64); err != nil {
+			return nil, fmt.Errorf("%q is not a number", s)
+		}
+
+		return json.Number(s), nil
+	case typeBool:
+		if s == "" {
+			return false, nil
+		}
+
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a boolean", s)
+		}
+
+		return b, nil
+	}
+
+	return nil, fmt.Errorf("invalid type %q", typ)
+}
@@ -0,0 +1,151 @@
+package genesismigration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePath(t *testing.T) {
+	cases := []struct {
+		path    string
+		want    []segment
+		wantErr bool
+	}{
+		{
+			path: ".params.max",
+			want: []segment{{field: "params"}, {field: "max"}},
+		},
+		{
+			path: ".posts[].title",
+			want: []segment{{field: "posts"}, {kind: segmentIterate}, {field: "title"}},
+		},
+		{
+			path: ".posts[2]",
+			want: []segment{{field: "posts"}, {kind: segmentIndex, index: 2}},
+		},
+		{
+			path: `."a.b".c`,
+			want: []segment{{field: "a.b"}, {field: "c"}},
+		},
+		{
+			path:    "",
+			wantErr: true,
+		},
+		{
+			path:    "params",
+			wantErr: true,
+		},
+		{
+			path:    ".posts[x]",
+			wantErr: true,
+		},
+		{
+			path:    ".posts..title",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.path, func(t *testing.T) {
+			segs, err := parsePath(tt.path)
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidPath)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, segs)
+		})
+	}
+}
+
+func TestApplyOperations(t *testing.T) {
+	cases := []struct {
+		name    string
+		state   string
+		ops     []Operation
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "set creates missing objects",
+			state: `{}`,
+			ops:   []Operation{{Op: OpSet, Path: ".params.max", Value: []byte(`10`)}},
+			want:  `{"params":{"max":10}}`,
+		},
+		{
+			name:  "default keeps existing values",
+			state: `{"params":{"max":5}}`,
+			ops: []Operation{
+				{Op: OpDefault, Path: ".params.max", Value: []byte(`10`)},
+				{Op: OpDefault, Path: ".params.min", Value: []byte(`1`)},
+			},
+			want: `{"params":{"max":5,"min":1}}`,
+		},
+		{
+			name:  "rename fields of all array elements",
+			state: `{"posts":[{"title":"a"},{"title":"b"},{"body":"c"}]}`,
+			ops:   []Operation{{Op: OpRename, Path: ".posts[].title", To: "name"}},
+			want:  `{"posts":[{"name":"a"},{"name":"b"},{"body":"c"}]}`,
+		},
+		{
+			name:  "delete field",
+			state: `{"posts":[{"id":"1","legacy":true}]}`,
+			ops:   []Operation{{Op: OpDelete, Path: ".posts[0].legacy"}},
+			want:  `{"posts":[{"id":"1"}]}`,
+		},
+		{
+			name:  "convert values keeping big numbers",
+			state: `{"count":18446744073709551615,"ids":["1","2"],"flag":"true"}`,
+			ops: []Operation{
+				{Op: OpConvert, Path: ".count", Type: "string"},
+				{Op: OpConvert, Path: ".ids[]", Type: "number"},
+				{Op: OpConvert, Path: ".flag", Type: "bool"},
+			},
+			want: `{"count":"18446744073709551615","flag":true,"ids":[1,2]}`,
+		},
+		{
+			name:  "ignore missing paths",
+			state: `{"posts":[]}`,
+			ops: []Operation{
+				{Op: OpRename, Path: ".posts[].title", To: "name"},
+				{Op: OpDelete, Path: ".comments[].legacy"},
+			},
+			want: `{"posts":[]}`,
+		},
+		{
+			name:    "invalid conversion",
+			state:   `{"count":"foo"}`,
+			ops:     []Operation{{Op: OpConvert, Path: ".count", Type: "number"}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown operation",
+			state:   `{}`,
+			ops:     []Operation{{Op: "move", Path: ".a"}},
+			wantErr: true,
+		},
+		{
+			name:    "field of a non object value",
+			state:   `{"count":1}`,
+			ops:     []Operation{{Op: OpDelete, Path: ".count.value"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			state, err := applyOperations([]byte(tt.state), tt.ops)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.JSONEq(t, tt.want, string(state))
+		})
+	}
+}
@@ -60,6 +60,8 @@ const (
 	FlagModValueReadOnly = "readonly"
 	// FlagOut represents out go flag.
 	FlagOut = "-o"
+	// FlagTrimpath represents trimpath go flag.
+	FlagTrimpath = "-trimpath"
 )
 
 // Env returns the value of `go env name`.
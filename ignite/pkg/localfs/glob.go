@@ -0,0 +1,71 @@
+package localfs
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const globAnyDirs = "**"
+
+// MatchGlob reports whether a slash separated path matches a glob pattern.
+// Patterns use the syntax of path.Match, with the addition of "**" path
+// elements that match any number of directories, including none.
+// For example "x/**/*.go" matches "x/a.go" and "x/blog/keeper/a.go".
+func MatchGlob(pattern, name string) (bool, error) {
+	return matchGlobElems(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchGlobElems(pattern, name []string) (bool, error) {
+	for len(pattern) > 0 {
+		if pattern[0] == globAnyDirs {
+			// "**" matches the rest of the path when it's the last element of the pattern
+			if len(pattern) == 1 {
+				return true, nil
+			}
+
+			for i := 0; i <= len(name); i++ {
+				ok, err := matchGlobElems(pattern[1:], name[i:])
+				if err != nil || ok {
+					return ok, err
+				}
+			}
+
+			return false, nil
+		}
+
+		if len(name) == 0 {
+			return false, nil
+		}
+
+		ok, err := path.Match(pattern[0], name[0])
+		if err != nil || !ok {
+			return false, err
+		}
+
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0, nil
+}
+
+// GlobRoot returns the directory of a glob pattern that contains all the matching paths,
+// which is the part of the pattern before the first element with special characters.
+// The returned path uses the OS specific path separator.
+func GlobRoot(pattern string) string {
+	var root []string
+	for _, elem := range strings.Split(pattern, "/") {
+		if strings.ContainsAny(elem, `*?[\`) {
+			break
+		}
+
+		root = append(root, elem)
+	}
+
+	// the pattern without special characters matches a single file or directory
+	if len(root) == 0 {
+		return "."
+	}
+
+	return filepath.FromSlash(path.Join(root...))
+}
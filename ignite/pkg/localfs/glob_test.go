@@ -0,0 +1,61 @@
+package localfs_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/localfs"
+)
+
+func TestMatchGlob(t *testing.T) {
+	cases := []struct {
+		pattern, name string
+		want          bool
+	}{
+		{pattern: "x/**", name: "x/blog/keeper/msg.go", want: true},
+		{pattern: "x/**", name: "app/app.go", want: false},
+		{pattern: "x/**/*.go", name: "x/msg.go", want: true},
+		{pattern: "x/**/*.go", name: "x/blog/keeper/msg.go", want: true},
+		{pattern: "x/**/*.go", name: "x/blog/README.md", want: false},
+		{pattern: "**/*.md", name: "README.md", want: true},
+		{pattern: "**/*.md", name: "docs/static/README.md", want: true},
+		{pattern: "**/*.pb.go", name: "x/blog/types/tx.pb.go", want: true},
+		{pattern: "app/*.go", name: "app/app.go", want: true},
+		{pattern: "app/*.go", name: "app/params/config.go", want: false},
+		{pattern: "go.mod", name: "go.mod", want: true},
+		{pattern: "pkg", name: "pkg/util.go", want: false},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.pattern+" "+tt.name, func(t *testing.T) {
+			got, err := localfs.MatchGlob(tt.pattern, tt.name)
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMatchGlobWithInvalidPattern(t *testing.T) {
+	_, err := localfs.MatchGlob("x/[", "x/a")
+
+	require.Error(t, err)
+}
+
+func TestGlobRoot(t *testing.T) {
+	cases := []struct {
+		pattern, want string
+	}{
+		{pattern: "x/**", want: "x"},
+		{pattern: "pkg/util/*.go", want: "pkg/util"},
+		{pattern: "**/*.go", want: "."},
+		{pattern: "go.mod", want: "go.mod"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.pattern, func(t *testing.T) {
+			require.Equal(t, tt.want, localfs.GlobRoot(tt.pattern))
+		})
+	}
+}
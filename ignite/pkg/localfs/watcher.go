@@ -18,7 +18,9 @@ type watcher struct {
 	ignoreHidden  bool
 	ignoreFolders bool
 	ignoreExts    []string
+	ignoreGlobs   []string
 	onChange      func()
+	onChangePaths func([]string)
 	interval      time.Duration
 	ctx           context.Context
 	done          *sync.WaitGroup
@@ -41,6 +43,15 @@ func WatcherOnChange(hook func()) WatcherOption {
 	}
 }
 
+// WatcherOnChangePaths sets a hook that executed on every change on filesystem
+// with the absolute paths of the changed files. The changes detected within
+// the same polling interval are reported together.
+func WatcherOnChangePaths(hook func(paths []string)) WatcherOption {
+	return func(w *watcher) {
+		w.onChangePaths = hook
+	}
+}
+
 // WatcherPollingInterval overwrites default polling interval to check filesystem changes.
 func WatcherPollingInterval(d time.Duration) WatcherOption {
 	return func(w *watcher) {
@@ -68,6 +79,15 @@ func WatcherIgnoreExt(exts ...string) WatcherOption {
 	}
 }
 
+// WatcherIgnoreGlobs ignores the files and directories matching the glob
+// patterns, relative to the workdir. The patterns are matched with MatchGlob.
+// The content of the ignored directories isn't walked.
+func WatcherIgnoreGlobs(patterns ...string) WatcherOption {
+	return func(w *watcher) {
+		w.ignoreGlobs = patterns
+	}
+}
+
 // Watch starts watching changes on the paths. options are used to configure the
 // behaviour of watch operation.
 func Watch(ctx context.Context, paths []string, options ...WatcherOption) error {
@@ -78,13 +98,27 @@ func Watch(ctx context.Context, paths []string, options ...WatcherOption) error
 		done:     &sync.WaitGroup{},
 		ctx:      ctx,
 	}
-	w.wt.SetMaxEvents(1)
-
 	for _, o := range options {
 		o(w)
 	}
 
+	// all the changes are required to report the changed paths
+	if w.onChangePaths == nil {
+		w.wt.SetMaxEvents(1)
+	}
+
 	w.wt.AddFilterHook(func(info os.FileInfo, fullPath string) error {
+		ignored, err := w.isGlobIgnored(fullPath)
+		if err != nil {
+			return err
+		}
+		if ignored {
+			if info.IsDir() {
+				// returned to the directory walk to not list the directory content
+				return filepath.SkipDir
+			}
+			return wt.ErrSkip
+		}
 		if info.IsDir() && w.ignoreFolders {
 			return wt.ErrSkip
 		}
@@ -117,8 +151,12 @@ func (w *watcher) listen() {
 	defer w.done.Done()
 	for {
 		select {
-		case <-w.wt.Event:
+		case e := <-w.wt.Event:
 			w.onChange()
+
+			if w.onChangePaths != nil {
+				w.onChangePaths(w.collectPaths(e))
+			}
 		case <-w.wt.Closed:
 			return
 		case <-w.ctx.Done():
@@ -127,6 +165,37 @@ func (w *watcher) listen() {
 	}
 }
 
+// collectPaths returns the paths of an event together with the paths
+// of the events received until no changes are detected in an interval.
+func (w *watcher) collectPaths(e wt.Event) []string {
+	var (
+		paths []string
+		seen  = make(map[string]bool)
+	)
+
+	add := func(e wt.Event) {
+		for _, p := range []string{e.Path, e.OldPath} {
+			if p != "" && !seen[p] {
+				seen[p] = true
+				paths = append(paths, p)
+			}
+		}
+	}
+
+	add(e)
+
+	for {
+		select {
+		case e := <-w.wt.Event:
+			add(e)
+		case <-time.After(w.interval):
+			return paths
+		case <-w.ctx.Done():
+			return paths
+		}
+	}
+}
+
 func (w *watcher) addPaths(paths ...string) error {
 	for _, path := range paths {
 		if !filepath.IsAbs(path) {
@@ -154,3 +223,21 @@ func (w *watcher) isFileIgnored(path string) bool {
 	}
 	return false
 }
+
+func (w *watcher) isGlobIgnored(path string) (bool, error) {
+	if len(w.ignoreGlobs) == 0 {
+		return false, nil
+	}
+	name, err := filepath.Rel(w.workdir, path)
+	if err != nil {
+		return false, err
+	}
+	name = filepath.ToSlash(name)
+	for _, p := range w.ignoreGlobs {
+		ok, err := MatchGlob(p, name)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
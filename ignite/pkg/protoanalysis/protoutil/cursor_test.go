@@ -550,4 +550,44 @@ func TestGetNextId(t *testing.T) {
 	m, err = GetMessageByName(f, "Hello")
 	require.NoError(t, err)
 	require.Equal(t, 6, NextUniqueID(m))
+
+	f, err = parseStringProto(`syntax = "proto3"
+
+	message Hello {
+		string g = 1;
+		reserved 2, 7 to 9;
+		reserved 100 to max;
+	}`)
+	require.NoError(t, err)
+	m, err = GetMessageByName(f, "Hello")
+	require.NoError(t, err)
+	require.Equal(t, 10, NextUniqueID(m))
+}
+
+func TestRemoveFields(t *testing.T) {
+	f, err := parseStringProto(`syntax = "proto3"
+
+	message Hello {
+		string g = 1;
+		string foo = 2;
+		int32 bar = 3;
+		message World {
+			string foo = 1;
+		}
+	}`)
+	require.NoError(t, err)
+
+	m, err := GetMessageByName(f, "Hello")
+	require.NoError(t, err)
+	require.Equal(t, 2, RemoveFields(m, "foo", "bar", "baz"))
+	require.Len(t, m.Elements, 4)
+	require.Equal(t, 4, NextUniqueID(m))
+	require.True(t, IsReservedName(m, "foo"))
+	require.False(t, IsReservedName(m, "g"))
+	require.Contains(t, Print(f), "reserved 2, 3;")
+	require.Contains(t, Print(f), `reserved "foo", "bar";`)
+
+	m, err = GetMessageByName(f, "World")
+	require.NoError(t, err)
+	require.Len(t, m.Elements, 1)
 }
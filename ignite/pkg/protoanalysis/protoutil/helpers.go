@@ -124,7 +124,7 @@ func AddImports(f *proto.Proto, fallback bool, imports ...*proto.Import) (err er
 
 // NextUniqueID goes through the fields of the given Message and returns
 // an id > max(fieldIds). It does not try to 'plug the holes' by selecting the
-// least available id.
+// least available id. Reserved ids are never returned.
 //
 //	 // In 'example.proto' file
 //	 syntax = "proto3"
@@ -145,15 +145,89 @@ func NextUniqueID(m *proto.Message) int {
 	// if no elements exist => 1.
 	max := 0
 	for _, el := range m.Elements {
-		if f, ok := el.(*proto.NormalField); ok {
-			if f.Sequence > max {
-				max = f.Sequence
+		switch el := el.(type) {
+		case *proto.NormalField:
+			if el.Sequence > max {
+				max = el.Sequence
+			}
+		case *proto.Reserved:
+			for _, r := range el.Ranges {
+				if !r.Max && r.To > max {
+					max = r.To
+				}
 			}
 		}
 	}
 	return max + 1
 }
 
+// IsReservedName checks if the field name is reserved in the Message.
+func IsReservedName(m *proto.Message, name string) bool {
+	for _, el := range m.Elements {
+		if r, ok := el.(*proto.Reserved); ok {
+			for _, n := range r.FieldNames {
+				if n == name {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// RemoveFields removes the fields with the given names from the Message and
+// returns the number of removed fields. Fields of nested messages are not removed.
+// The numbers and names of the removed fields are reserved so they are not reused.
+//
+//	// In 'example.proto' file
+//	syntax = "proto3"
+//
+//	message Hello {
+//		string g = 1;
+//		string foo = 2;
+//	}
+//	f := ParseProtoPath("example.proto")
+//	m := GetMessageByName(f, "Hello")
+//	RemoveFields(m, "foo") // 1
+//
+//	// Message after removing the field
+//	message Hello {
+//		string g = 1;
+//		reserved 2;
+//		reserved "foo";
+//	}
+func RemoveFields(m *proto.Message, names ...string) int {
+	remove := make(map[string]struct{})
+	for _, name := range names {
+		remove[name] = struct{}{}
+	}
+
+	var (
+		elements = make([]proto.Visitee, 0, len(m.Elements))
+		ranges   []proto.Range
+		removed  []string
+	)
+	for _, el := range m.Elements {
+		if f, ok := el.(*proto.NormalField); ok {
+			if _, ok := remove[f.Name]; ok {
+				ranges = append(ranges, proto.Range{From: f.Sequence, To: f.Sequence})
+				removed = append(removed, f.Name)
+				continue
+			}
+		}
+		elements = append(elements, el)
+	}
+	if len(removed) > 0 {
+		elements = append(
+			elements,
+			&proto.Reserved{Ranges: ranges, Parent: m},
+			&proto.Reserved{FieldNames: removed, Parent: m},
+		)
+	}
+	m.Elements = elements
+	return len(removed)
+}
+
 // GetMessageByName returns the message with the given name or nil if not found.
 // Only traverses in proto.Proto and proto.Message since they are the only nodes
 // that contain messages:
@@ -72,6 +72,7 @@ type Path struct {
 
 type PathEnd struct {
 	ChainID      string `json:"chain_id" yaml:"chain_id"`
+	ClientID     string `json:"client_id" yaml:"client_id,omitempty"`
 	ConnectionID string `json:"connection_id" yaml:"connection_id,omitempty"`
 	ChannelID    string `json:"channel_id" yaml:"channel_id,omitempty"`
 	PortID       string `json:"port_id" yaml:"port_id"`
@@ -0,0 +1,388 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	cmttypes "github.com/cometbft/cometbft/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctypes "github.com/cosmos/ibc-go/v7/modules/core/types"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+)
+
+const (
+	// ibcStoreQueryPath is the ABCI query path of the IBC store keys.
+	ibcStoreQueryPath = "store/" + ibcexported.StoreKey + "/key"
+
+	// validatorsPerPage is the number of validators fetched per RPC request.
+	validatorsPerPage = 100
+
+	// maxClockDrift is the max time difference between the clocks of the chains allowed by the clients.
+	maxClockDrift = 10 * time.Minute
+
+	gasAdjustment = 1.3
+)
+
+// defaultUpgradePath is the path of the upgraded client states in the Cosmos SDK upgrade store.
+var defaultUpgradePath = []string{upgradetypes.StoreKey, upgradetypes.KeyUpgradedIBCState}
+
+// endpoint is one of the chains of a path.
+type endpoint struct {
+	chain   relayerconf.Chain
+	end     relayerconf.PathEnd
+	client  cosmosclient.Client
+	account cosmosaccount.Account
+	address string
+
+	// txLock is held while a transaction of the account is broadcast.
+	txLock *sync.Mutex
+
+	// clientID is the ID of the client on the chain that tracks the counterparty chain.
+	clientID string
+
+	// revision is the revision number of the chain parsed from its ID.
+	revision uint64
+
+	// order is the order of the channel of the path.
+	order channeltypes.Order
+}
+
+// newEndpoint creates the endpoint of the chain of a path end.
+func (r Relayer) newEndpoint(ctx context.Context, conf relayerconf.Config, end relayerconf.PathEnd) (*endpoint, error) {
+	chain, err := r.prepare(ctx, conf, end.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := r.ca.GetByName(chain.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := account.Address(chain.AddressPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	gas := cosmosclient.GasAuto
+	if chain.GasLimit > 0 {
+		gas = strconv.FormatInt(chain.GasLimit, 10)
+	}
+
+	client, err := cosmosclient.New(
+		ctx,
+		cosmosclient.WithNodeAddress(chain.RPCAddress),
+		cosmosclient.WithAddressPrefix(chain.AddressPrefix),
+		cosmosclient.WithAccountRegistry(r.ca),
+		cosmosclient.WithInterfaceRegistry(newInterfaceRegistry()),
+		cosmosclient.WithGas(gas),
+		cosmosclient.WithGasPrices(chain.GasPrice),
+		cosmosclient.WithGasAdjustment(gasAdjustment),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &endpoint{
+		chain:    chain,
+		end:      end,
+		client:   client,
+		account:  account,
+		address:  address,
+		txLock:   r.txLock(chain.ID, chain.Account),
+		clientID: end.ClientID,
+		revision: clienttypes.ParseChainID(chain.ID),
+	}, nil
+}
+
+// newInterfaceRegistry returns an interface registry with the IBC types registered.
+func newInterfaceRegistry() codectypes.InterfaceRegistry {
+	registry := codectypes.NewInterfaceRegistry()
+	ibctypes.RegisterInterfaces(registry)
+	ibctm.RegisterInterfaces(registry)
+	return registry
+}
+
+// height returns an IBC height of the chain.
+func (e *endpoint) height(h int64) clienttypes.Height {
+	return clienttypes.NewHeight(e.revision, uint64(h))
+}
+
+// send broadcasts the messages and waits for the next block,
+// so the state changes of the messages can be proven.
+func (e *endpoint) send(ctx context.Context, msgs ...sdk.Msg) (cosmosclient.Response, error) {
+	// the transaction is included in a block when BroadcastTx returns,
+	// so the next transaction of the account uses the next sequence.
+	e.txLock.Lock()
+	res, err := e.client.BroadcastTx(ctx, e.account, msgs...)
+	e.txLock.Unlock()
+	if err != nil {
+		return cosmosclient.Response{}, fmt.Errorf("%s: %w", e.chain.ID, err)
+	}
+
+	return res, e.client.WaitForNextBlock(ctx)
+}
+
+// query returns the value of an IBC store key and its proof at a height.
+func (e *endpoint) query(ctx context.Context, key []byte, height int64) (value, proof []byte, err error) {
+	res, err := e.client.RPC.ABCIQueryWithOptions(ctx, ibcStoreQueryPath, key, rpcclient.ABCIQueryOptions{
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !res.Response.IsOK() {
+		return nil, nil, fmt.Errorf("%s: query %s: %s", e.chain.ID, key, res.Response.Log)
+	}
+
+	merkleProof, err := commitmenttypes.ConvertProofs(res.Response.ProofOps)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	proof, err = e.client.Context().Codec.Marshal(&merkleProof)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res.Response.Value, proof, nil
+}
+
+// clientState returns the state of the client that tracks the counterparty chain.
+func (e *endpoint) clientState(ctx context.Context) (*ibctm.ClientState, error) {
+	value, _, err := e.query(ctx, host.FullClientStateKey(e.clientID), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.decodeClientState(value)
+}
+
+func (e *endpoint) decodeClientState(value []byte) (*ibctm.ClientState, error) {
+	if len(value) == 0 {
+		return nil, fmt.Errorf("%s: client %s not found", e.chain.ID, e.clientID)
+	}
+
+	cs, err := clienttypes.UnmarshalClientState(e.client.Context().Codec, value)
+	if err != nil {
+		return nil, err
+	}
+
+	tmcs, ok := cs.(*ibctm.ClientState)
+	if !ok {
+		return nil, fmt.Errorf("%s: client %s is not a Tendermint client", e.chain.ID, e.clientID)
+	}
+
+	return tmcs, nil
+}
+
+// consensusTime returns the time of the consensus state of the client at a height.
+func (e *endpoint) consensusTime(ctx context.Context, height clienttypes.Height) (time.Time, error) {
+	value, _, err := e.query(ctx, host.FullConsensusStateKey(e.clientID, height), 0)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	cs, err := clienttypes.UnmarshalConsensusState(e.client.Context().Codec, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(0, int64(cs.GetTimestamp())), nil
+}
+
+// header returns the header of the chain at a height to update a client
+// of the chain that trusts a previous height.
+func (e *endpoint) header(ctx context.Context, height int64, trustedHeight clienttypes.Height) (*ibctm.Header, error) {
+	commit, err := e.client.RPC.Commit(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	validators, err := e.validatorSet(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	// the trusted validators are the next validators of the trusted header
+	trustedValidators, err := e.validatorSet(ctx, int64(trustedHeight.RevisionHeight)+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ibctm.Header{
+		SignedHeader:      commit.SignedHeader.ToProto(),
+		ValidatorSet:      validators,
+		TrustedHeight:     trustedHeight,
+		TrustedValidators: trustedValidators,
+	}, nil
+}
+
+func (e *endpoint) validatorSet(ctx context.Context, height int64) (*cmtproto.ValidatorSet, error) {
+	var (
+		validators []*cmttypes.Validator
+		perPage    = validatorsPerPage
+	)
+
+	for page := 1; ; page++ {
+		res, err := e.client.RPC.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, err
+		}
+
+		validators = append(validators, res.Validators...)
+		if len(validators) >= res.Total || len(res.Validators) == 0 {
+			break
+		}
+	}
+
+	return cmttypes.NewValidatorSet(validators).ToProto()
+}
+
+// createClient creates a client on the chain that tracks the counterparty chain.
+func (e *endpoint) createClient(ctx context.Context, counterparty *endpoint) error {
+	height, err := counterparty.client.LatestBlockHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	commit, err := counterparty.client.RPC.Commit(ctx, &height)
+	if err != nil {
+		return err
+	}
+
+	stakingParams, err := stakingtypes.NewQueryClient(counterparty.client.Context()).
+		Params(ctx, &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+
+	var (
+		header         = commit.SignedHeader.Header
+		unbonding      = stakingParams.Params.UnbondingTime
+		trustingPeriod = unbonding * 2 / 3
+		clientState    = ibctm.NewClientState(
+			counterparty.chain.ID,
+			ibctm.DefaultTrustLevel,
+			trustingPeriod,
+			unbonding,
+			maxClockDrift,
+			counterparty.height(height),
+			commitmenttypes.GetSDKSpecs(),
+			defaultUpgradePath,
+		)
+		consensusState = ibctm.NewConsensusState(
+			header.Time,
+			commitmenttypes.NewMerkleRoot(header.AppHash),
+			header.NextValidatorsHash,
+		)
+	)
+
+	msg, err := clienttypes.NewMsgCreateClient(clientState, consensusState, e.address)
+	if err != nil {
+		return err
+	}
+
+	res, err := e.send(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	e.clientID, err = eventAttribute(res, clienttypes.EventTypeCreateClient, clienttypes.AttributeKeyClientID)
+	return err
+}
+
+// updateClient returns the messages to update the client of the chain to the latest
+// height of the counterparty chain, and the height to use to prove the counterparty state.
+// The state must be queried at the proof height minus one because the app hash of a
+// header is the hash of the state after the previous block.
+func (e *endpoint) updateClient(ctx context.Context, counterparty *endpoint) (clienttypes.Height, []sdk.Msg, error) {
+	cs, err := e.clientState(ctx)
+	if err != nil {
+		return clienttypes.Height{}, nil, err
+	}
+
+	height, err := counterparty.client.LatestBlockHeight(ctx)
+	if err != nil {
+		return clienttypes.Height{}, nil, err
+	}
+
+	trustedHeight := cs.LatestHeight
+	if int64(trustedHeight.RevisionHeight) >= height {
+		return trustedHeight, nil, nil
+	}
+
+	header, err := counterparty.header(ctx, height, trustedHeight)
+	if err != nil {
+		return clienttypes.Height{}, nil, err
+	}
+
+	msg, err := clienttypes.NewMsgUpdateClient(e.clientID, header, e.address)
+	if err != nil {
+		return clienttypes.Height{}, nil, err
+	}
+
+	return counterparty.height(height), []sdk.Msg{msg}, nil
+}
+
+// refreshClient updates the client of the chain when its latest consensus state is older
+// than a third of the trusting period, so the client doesn't expire when there are no packets.
+func (e *endpoint) refreshClient(ctx context.Context, counterparty *endpoint) error {
+	cs, err := e.clientState(ctx)
+	if err != nil {
+		return err
+	}
+
+	updatedAt, err := e.consensusTime(ctx, cs.LatestHeight)
+	if err != nil {
+		return err
+	}
+
+	if time.Since(updatedAt) < cs.TrustingPeriod/3 {
+		return nil
+	}
+
+	_, msgs, err := e.updateClient(ctx, counterparty)
+	if err != nil || len(msgs) == 0 {
+		return err
+	}
+
+	_, err = e.send(ctx, msgs...)
+	return err
+}
+
+// eventAttribute returns the value of the attribute of the first event with a type in a tx response.
+func eventAttribute(res cosmosclient.Response, eventType, key string) (string, error) {
+	for _, e := range res.Events {
+		if e.Type != eventType {
+			continue
+		}
+
+		for _, a := range e.Attributes {
+			if a.Key == key {
+				return a.Value, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("attribute %s of event %s not found in tx %s", key, eventType, res.TxHash)
+}
@@ -0,0 +1,381 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+)
+
+// ibcPrefix is the prefix of the IBC store keys of the chains.
+var ibcPrefix = commitmenttypes.NewMerklePrefix([]byte(ibcexported.StoreKey))
+
+// link creates the clients, the connection and the channel of a path.
+// Existing clients are used when their IDs are defined in the config.
+func (r Relayer) link(ctx context.Context, conf relayerconf.Config, path relayerconf.Path) (relayerconf.Path, error) {
+	src, dst, err := r.newEndpoints(ctx, conf, path)
+	if err != nil {
+		return path, err
+	}
+
+	for _, e := range [][2]*endpoint{{src, dst}, {dst, src}} {
+		if e[0].clientID == "" {
+			e[0].clientID = e[0].chain.ClientID
+		}
+
+		if e[0].clientID == "" {
+			if err := e[0].createClient(ctx, e[1]); err != nil {
+				return path, err
+			}
+		}
+	}
+
+	if err := openConnection(ctx, src, dst); err != nil {
+		return path, err
+	}
+
+	if err := openChannel(ctx, src, dst); err != nil {
+		return path, err
+	}
+
+	path.Src = src.pathEnd()
+	path.Dst = dst.pathEnd()
+
+	return path, nil
+}
+
+// pathEnd returns the path end of the endpoint with the IDs created by the handshakes.
+func (e *endpoint) pathEnd() relayerconf.PathEnd {
+	end := e.end
+	end.ClientID = e.clientID
+	return end
+}
+
+// connectionClientID returns the ID of the client of the connection of the endpoint.
+func (e *endpoint) connectionClientID(ctx context.Context) (string, error) {
+	conn, _, err := e.connection(ctx, 0)
+	if err != nil {
+		return "", err
+	}
+
+	return conn.ClientId, nil
+}
+
+// connection returns the connection of the endpoint and its proof at a height.
+func (e *endpoint) connection(ctx context.Context, height int64) (connectiontypes.ConnectionEnd, []byte, error) {
+	value, proof, err := e.query(ctx, host.ConnectionKey(e.end.ConnectionID), height)
+	if err != nil {
+		return connectiontypes.ConnectionEnd{}, nil, err
+	}
+
+	if len(value) == 0 {
+		return connectiontypes.ConnectionEnd{}, nil, fmt.Errorf("%s: connection %s not found", e.chain.ID, e.end.ConnectionID)
+	}
+
+	var conn connectiontypes.ConnectionEnd
+	if err := e.client.Context().Codec.Unmarshal(value, &conn); err != nil {
+		return connectiontypes.ConnectionEnd{}, nil, err
+	}
+
+	return conn, proof, nil
+}
+
+// channel returns the channel of the endpoint and its proof at a height.
+func (e *endpoint) channel(ctx context.Context, height int64) (channeltypes.Channel, []byte, error) {
+	value, proof, err := e.query(ctx, host.ChannelKey(e.end.PortID, e.end.ChannelID), height)
+	if err != nil {
+		return channeltypes.Channel{}, nil, err
+	}
+
+	if len(value) == 0 {
+		return channeltypes.Channel{}, nil, fmt.Errorf("%s: channel %s not found", e.chain.ID, e.end.ChannelID)
+	}
+
+	var channel channeltypes.Channel
+	if err := e.client.Context().Codec.Unmarshal(value, &channel); err != nil {
+		return channeltypes.Channel{}, nil, err
+	}
+
+	return channel, proof, nil
+}
+
+// connectionProof is the proof of the state of a connection and of its client.
+type connectionProof struct {
+	connection      connectiontypes.ConnectionEnd
+	clientState     *ibctm.ClientState
+	consensusHeight clienttypes.Height
+	proofConnection []byte
+	proofClient     []byte
+	proofConsensus  []byte
+}
+
+// connectionProof returns the proof of the connection of the endpoint and its client at a height
+// of the chain. The height is the height of the client of the counterparty chain.
+func (e *endpoint) connectionProof(ctx context.Context, proofHeight clienttypes.Height) (connectionProof, error) {
+	height := int64(proofHeight.RevisionHeight) - 1
+
+	conn, proofConnection, err := e.connection(ctx, height)
+	if err != nil {
+		return connectionProof{}, err
+	}
+
+	value, proofClient, err := e.query(ctx, host.FullClientStateKey(e.clientID), height)
+	if err != nil {
+		return connectionProof{}, err
+	}
+
+	cs, err := e.decodeClientState(value)
+	if err != nil {
+		return connectionProof{}, err
+	}
+
+	_, proofConsensus, err := e.query(ctx, host.FullConsensusStateKey(e.clientID, cs.LatestHeight), height)
+	if err != nil {
+		return connectionProof{}, err
+	}
+
+	return connectionProof{
+		connection:      conn,
+		clientState:     cs,
+		consensusHeight: cs.LatestHeight,
+		proofConnection: proofConnection,
+		proofClient:     proofClient,
+		proofConsensus:  proofConsensus,
+	}, nil
+}
+
+// openConnection opens a connection between the clients of the endpoints.
+func openConnection(ctx context.Context, src, dst *endpoint) error {
+	// init
+	res, err := src.send(ctx, connectiontypes.NewMsgConnectionOpenInit(
+		src.clientID,
+		dst.clientID,
+		ibcPrefix,
+		nil,
+		0,
+		src.address,
+	))
+	if err != nil {
+		return err
+	}
+
+	src.end.ConnectionID, err = eventAttribute(
+		res,
+		connectiontypes.EventTypeConnectionOpenInit,
+		connectiontypes.AttributeKeyConnectionID,
+	)
+	if err != nil {
+		return err
+	}
+
+	// try
+	proofHeight, msgs, err := dst.updateClient(ctx, src)
+	if err != nil {
+		return err
+	}
+
+	proof, err := src.connectionProof(ctx, proofHeight)
+	if err != nil {
+		return err
+	}
+
+	msgs = append(msgs, connectiontypes.NewMsgConnectionOpenTry(
+		dst.clientID,
+		src.end.ConnectionID,
+		src.clientID,
+		proof.clientState,
+		ibcPrefix,
+		connectiontypes.ExportedVersionsToProto(connectiontypes.GetCompatibleVersions()),
+		0,
+		proof.proofConnection,
+		proof.proofClient,
+		proof.proofConsensus,
+		proofHeight,
+		proof.consensusHeight,
+		dst.address,
+	))
+
+	if res, err = dst.send(ctx, msgs...); err != nil {
+		return err
+	}
+
+	dst.end.ConnectionID, err = eventAttribute(
+		res,
+		connectiontypes.EventTypeConnectionOpenTry,
+		connectiontypes.AttributeKeyConnectionID,
+	)
+	if err != nil {
+		return err
+	}
+
+	// ack
+	if proofHeight, msgs, err = src.updateClient(ctx, dst); err != nil {
+		return err
+	}
+
+	if proof, err = dst.connectionProof(ctx, proofHeight); err != nil {
+		return err
+	}
+
+	if len(proof.connection.Versions) == 0 {
+		return fmt.Errorf("%s: connection %s has no version", dst.chain.ID, dst.end.ConnectionID)
+	}
+
+	msgs = append(msgs, connectiontypes.NewMsgConnectionOpenAck(
+		src.end.ConnectionID,
+		dst.end.ConnectionID,
+		proof.clientState,
+		proof.proofConnection,
+		proof.proofClient,
+		proof.proofConsensus,
+		proofHeight,
+		proof.consensusHeight,
+		proof.connection.Versions[0],
+		src.address,
+	))
+
+	if _, err := src.send(ctx, msgs...); err != nil {
+		return err
+	}
+
+	// confirm
+	if proofHeight, msgs, err = dst.updateClient(ctx, src); err != nil {
+		return err
+	}
+
+	_, proofAck, err := src.connection(ctx, int64(proofHeight.RevisionHeight)-1)
+	if err != nil {
+		return err
+	}
+
+	msgs = append(msgs, connectiontypes.NewMsgConnectionOpenConfirm(
+		dst.end.ConnectionID,
+		proofAck,
+		proofHeight,
+		dst.address,
+	))
+
+	_, err = dst.send(ctx, msgs...)
+	return err
+}
+
+// openChannel opens a channel between the ports of the endpoints on their connection.
+func openChannel(ctx context.Context, src, dst *endpoint) error {
+	// init
+	res, err := src.send(ctx, channeltypes.NewMsgChannelOpenInit(
+		src.end.PortID,
+		src.end.Version,
+		src.order,
+		[]string{src.end.ConnectionID},
+		dst.end.PortID,
+		src.address,
+	))
+	if err != nil {
+		return err
+	}
+
+	src.end.ChannelID, err = eventAttribute(res, channeltypes.EventTypeChannelOpenInit, channeltypes.AttributeKeyChannelID)
+	if err != nil {
+		return err
+	}
+
+	// try
+	proofHeight, msgs, err := dst.updateClient(ctx, src)
+	if err != nil {
+		return err
+	}
+
+	channel, proofInit, err := src.channel(ctx, int64(proofHeight.RevisionHeight)-1)
+	if err != nil {
+		return err
+	}
+
+	msgs = append(msgs, channeltypes.NewMsgChannelOpenTry(
+		dst.end.PortID,
+		dst.end.Version,
+		dst.order,
+		[]string{dst.end.ConnectionID},
+		src.end.PortID,
+		src.end.ChannelID,
+		channel.Version,
+		proofInit,
+		proofHeight,
+		dst.address,
+	))
+
+	if res, err = dst.send(ctx, msgs...); err != nil {
+		return err
+	}
+
+	dst.end.ChannelID, err = eventAttribute(res, channeltypes.EventTypeChannelOpenTry, channeltypes.AttributeKeyChannelID)
+	if err != nil {
+		return err
+	}
+
+	// ack
+	if proofHeight, msgs, err = src.updateClient(ctx, dst); err != nil {
+		return err
+	}
+
+	channel, proofTry, err := dst.channel(ctx, int64(proofHeight.RevisionHeight)-1)
+	if err != nil {
+		return err
+	}
+
+	msgs = append(msgs, channeltypes.NewMsgChannelOpenAck(
+		src.end.PortID,
+		src.end.ChannelID,
+		dst.end.ChannelID,
+		channel.Version,
+		proofTry,
+		proofHeight,
+		src.address,
+	))
+
+	if _, err := src.send(ctx, msgs...); err != nil {
+		return err
+	}
+
+	// confirm
+	if proofHeight, msgs, err = dst.updateClient(ctx, src); err != nil {
+		return err
+	}
+
+	_, proofAck, err := src.channel(ctx, int64(proofHeight.RevisionHeight)-1)
+	if err != nil {
+		return err
+	}
+
+	msgs = append(msgs, channeltypes.NewMsgChannelOpenConfirm(
+		dst.end.PortID,
+		dst.end.ChannelID,
+		proofAck,
+		proofHeight,
+		dst.address,
+	))
+
+	_, err = dst.send(ctx, msgs...)
+	return err
+}
+
+// channelOrder returns the order of the channels of a path.
+func channelOrder(ordering string) (channeltypes.Order, error) {
+	if ordering == "" {
+		return channeltypes.UNORDERED, nil
+	}
+
+	order, ok := channeltypes.Order_value[ordering]
+	if !ok || channeltypes.Order(order) == channeltypes.NONE {
+		return channeltypes.NONE, fmt.Errorf("invalid channel ordering %q", ordering)
+	}
+
+	return channeltypes.Order(order), nil
+}
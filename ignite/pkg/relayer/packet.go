@@ -0,0 +1,445 @@
+package relayer
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+const (
+	// recvHeightMargin and recvTimeMargin are the margins before the timeout of a packet
+	// under which the packet is not received anymore, so the receive tx doesn't fail
+	// when the packet times out before it's included in a block.
+	recvHeightMargin = 2
+	recvTimeMargin   = 10 * time.Second
+)
+
+// relay relays the packets and the acknowledgements of the channel of the endpoints
+// in both directions and updates the clients before they expire.
+func relay(ctx context.Context, src, dst *endpoint) error {
+	for _, e := range [][2]*endpoint{{src, dst}, {dst, src}} {
+		if err := relayPackets(ctx, e[0], e[1]); err != nil {
+			return err
+		}
+	}
+
+	for _, e := range [][2]*endpoint{{src, dst}, {dst, src}} {
+		if err := relayAcks(ctx, e[0], e[1]); err != nil {
+			return err
+		}
+	}
+
+	for _, e := range [][2]*endpoint{{src, dst}, {dst, src}} {
+		if err := e[0].refreshClient(ctx, e[1]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// relayPackets receives the packets sent by src on dst, or times them out on src
+// when they can't be received anymore.
+func relayPackets(ctx context.Context, src, dst *endpoint) error {
+	commitments, err := src.packetCommitments(ctx)
+	if err != nil || len(commitments) == 0 {
+		return err
+	}
+
+	unreceived, err := channeltypes.NewQueryClient(dst.client.Context()).UnreceivedPackets(
+		ctx,
+		&channeltypes.QueryUnreceivedPacketsRequest{
+			PortId:                    dst.end.PortID,
+			ChannelId:                 dst.end.ChannelID,
+			PacketCommitmentSequences: commitments,
+		},
+	)
+	if err != nil || len(unreceived.Sequences) == 0 {
+		return err
+	}
+
+	status, err := dst.client.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	var (
+		dstHeight = dst.height(status.SyncInfo.LatestBlockHeight)
+		dstTime   = status.SyncInfo.LatestBlockTime
+		recvLimit = dst.height(status.SyncInfo.LatestBlockHeight + recvHeightMargin)
+		received  []channeltypes.Packet
+		timedOut  []channeltypes.Packet
+	)
+
+	for _, seq := range unreceived.Sequences {
+		packet, ok, err := src.sentPacket(ctx, seq)
+		if err != nil {
+			return err
+		}
+
+		// packets sent by the chain outside of txs can't be relayed
+		if !ok {
+			continue
+		}
+
+		switch {
+		case packetTimedOut(packet, dstHeight, dstTime):
+			timedOut = append(timedOut, packet)
+		case !packetTimedOut(packet, recvLimit, dstTime.Add(recvTimeMargin)):
+			received = append(received, packet)
+		}
+	}
+
+	if err := recvPackets(ctx, src, dst, received); err != nil {
+		return err
+	}
+
+	return timeoutPackets(ctx, src, dst, timedOut)
+}
+
+// recvPackets receives on dst packets sent by src.
+func recvPackets(ctx context.Context, src, dst *endpoint, packets []channeltypes.Packet) error {
+	if len(packets) == 0 {
+		return nil
+	}
+
+	proofHeight, msgs, err := dst.updateClient(ctx, src)
+	if err != nil {
+		return err
+	}
+
+	var recv int
+	for _, p := range packets {
+		key := host.PacketCommitmentKey(p.SourcePort, p.SourceChannel, p.Sequence)
+		value, proof, err := src.query(ctx, key, int64(proofHeight.RevisionHeight)-1)
+		if err != nil {
+			return err
+		}
+
+		// the packet is committed after the proof height, it's received the next time
+		if len(value) == 0 {
+			continue
+		}
+
+		msgs = append(msgs, channeltypes.NewMsgRecvPacket(p, proof, proofHeight, dst.address))
+		recv++
+	}
+
+	if recv == 0 {
+		return nil
+	}
+
+	_, err = dst.send(ctx, msgs...)
+	return err
+}
+
+// timeoutPackets times out on src packets that weren't received by dst before their timeout.
+func timeoutPackets(ctx context.Context, src, dst *endpoint, packets []channeltypes.Packet) error {
+	if len(packets) == 0 {
+		return nil
+	}
+
+	proofHeight, msgs, err := src.updateClient(ctx, dst)
+	if err != nil {
+		return err
+	}
+
+	height := int64(proofHeight.RevisionHeight) - 1
+
+	for _, p := range packets {
+		var (
+			nextSeq = p.Sequence
+			proof   []byte
+		)
+
+		if src.order == channeltypes.ORDERED {
+			var value []byte
+			value, proof, err = dst.query(ctx, host.NextSequenceRecvKey(p.DestinationPort, p.DestinationChannel), height)
+			if err != nil {
+				return err
+			}
+
+			nextSeq = sdk.BigEndianToUint64(value)
+		} else {
+			_, proof, err = dst.query(ctx, host.PacketReceiptKey(p.DestinationPort, p.DestinationChannel, p.Sequence), height)
+			if err != nil {
+				return err
+			}
+		}
+
+		msgs = append(msgs, channeltypes.NewMsgTimeout(p, nextSeq, proof, proofHeight, src.address))
+	}
+
+	_, err = src.send(ctx, msgs...)
+	return err
+}
+
+// relayAcks relays to src the acknowledgements written by dst for the packets sent by src.
+func relayAcks(ctx context.Context, src, dst *endpoint) error {
+	acks, err := dst.packetAcknowledgements(ctx)
+	if err != nil || len(acks) == 0 {
+		return err
+	}
+
+	unreceived, err := channeltypes.NewQueryClient(src.client.Context()).UnreceivedAcks(
+		ctx,
+		&channeltypes.QueryUnreceivedAcksRequest{
+			PortId:             src.end.PortID,
+			ChannelId:          src.end.ChannelID,
+			PacketAckSequences: acks,
+		},
+	)
+	if err != nil || len(unreceived.Sequences) == 0 {
+		return err
+	}
+
+	proofHeight, msgs, err := src.updateClient(ctx, dst)
+	if err != nil {
+		return err
+	}
+
+	var relayed int
+	for _, seq := range unreceived.Sequences {
+		packet, ack, ok, err := dst.writtenAck(ctx, seq)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			continue
+		}
+
+		key := host.PacketAcknowledgementKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+		value, proof, err := dst.query(ctx, key, int64(proofHeight.RevisionHeight)-1)
+		if err != nil {
+			return err
+		}
+
+		// the acknowledgement is written after the proof height, it's relayed the next time
+		if len(value) == 0 {
+			continue
+		}
+
+		msgs = append(msgs, channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, src.address))
+		relayed++
+	}
+
+	if relayed == 0 {
+		return nil
+	}
+
+	_, err = src.send(ctx, msgs...)
+	return err
+}
+
+// packetCommitments returns the sequences of the packets sent by the endpoint
+// that weren't acknowledged or timed out.
+func (e *endpoint) packetCommitments(ctx context.Context) ([]uint64, error) {
+	var (
+		queryClient = channeltypes.NewQueryClient(e.client.Context())
+		sequences   []uint64
+		nextKey     []byte
+	)
+
+	for {
+		res, err := queryClient.PacketCommitments(ctx, &channeltypes.QueryPacketCommitmentsRequest{
+			PortId:     e.end.PortID,
+			ChannelId:  e.end.ChannelID,
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range res.Commitments {
+			sequences = append(sequences, c.Sequence)
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return sequences, nil
+		}
+
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+// packetAcknowledgements returns the sequences of the packets acknowledged by the endpoint.
+func (e *endpoint) packetAcknowledgements(ctx context.Context) ([]uint64, error) {
+	var (
+		queryClient = channeltypes.NewQueryClient(e.client.Context())
+		sequences   []uint64
+		nextKey     []byte
+	)
+
+	for {
+		res, err := queryClient.PacketAcknowledgements(ctx, &channeltypes.QueryPacketAcknowledgementsRequest{
+			PortId:     e.end.PortID,
+			ChannelId:  e.end.ChannelID,
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range res.Acknowledgements {
+			sequences = append(sequences, a.Sequence)
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return sequences, nil
+		}
+
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+// sentPacket returns a packet sent by the endpoint from the events of the tx that sent it.
+// False is returned when the tx is not found.
+func (e *endpoint) sentPacket(ctx context.Context, seq uint64) (channeltypes.Packet, bool, error) {
+	event, ok, err := e.searchPacketEvent(
+		ctx,
+		channeltypes.EventTypeSendPacket,
+		channeltypes.AttributeKeySrcPort,
+		channeltypes.AttributeKeySrcChannel,
+		seq,
+	)
+	if err != nil || !ok {
+		return channeltypes.Packet{}, false, err
+	}
+
+	packet, err := packetFromEvent(event)
+	return packet, err == nil, err
+}
+
+// writtenAck returns a packet received by the endpoint and its acknowledgement
+// from the events of the tx that received it. False is returned when the tx is not found.
+func (e *endpoint) writtenAck(ctx context.Context, seq uint64) (channeltypes.Packet, []byte, bool, error) {
+	event, ok, err := e.searchPacketEvent(
+		ctx,
+		channeltypes.EventTypeWriteAck,
+		channeltypes.AttributeKeyDstPort,
+		channeltypes.AttributeKeyDstChannel,
+		seq,
+	)
+	if err != nil || !ok {
+		return channeltypes.Packet{}, nil, false, err
+	}
+
+	packet, err := packetFromEvent(event)
+	if err != nil {
+		return channeltypes.Packet{}, nil, false, err
+	}
+
+	ack, err := hex.DecodeString(eventAttributes(event)[channeltypes.AttributeKeyAckHex])
+	if err != nil {
+		return channeltypes.Packet{}, nil, false, err
+	}
+
+	return packet, ack, true, nil
+}
+
+// searchPacketEvent searches the txs of the endpoint for a packet event
+// with the port and the channel of the endpoint.
+func (e *endpoint) searchPacketEvent(
+	ctx context.Context,
+	eventType, portKey, channelKey string,
+	seq uint64,
+) (abci.Event, bool, error) {
+	q := fmt.Sprintf(
+		"%[1]s.%[2]s='%[3]s' AND %[1]s.%[4]s='%[5]s' AND %[1]s.%[6]s=%[7]d",
+		eventType,
+		portKey,
+		e.end.PortID,
+		channelKey,
+		e.end.ChannelID,
+		channeltypes.AttributeKeySequence,
+		seq,
+	)
+
+	res, err := e.client.RPC.TxSearch(ctx, q, false, nil, nil, "asc")
+	if err != nil {
+		return abci.Event{}, false, err
+	}
+
+	// a tx can contain several packets
+	sequence := strconv.FormatUint(seq, 10)
+	for _, tx := range res.Txs {
+		for _, event := range tx.TxResult.Events {
+			if event.Type != eventType {
+				continue
+			}
+
+			attrs := eventAttributes(event)
+			if attrs[portKey] == e.end.PortID &&
+				attrs[channelKey] == e.end.ChannelID &&
+				attrs[channeltypes.AttributeKeySequence] == sequence {
+				return event, true, nil
+			}
+		}
+	}
+
+	return abci.Event{}, false, nil
+}
+
+// packetFromEvent returns the packet of a send packet or a write acknowledgement event.
+func packetFromEvent(event abci.Event) (channeltypes.Packet, error) {
+	attrs := eventAttributes(event)
+
+	seq, err := strconv.ParseUint(attrs[channeltypes.AttributeKeySequence], 10, 64)
+	if err != nil {
+		return channeltypes.Packet{}, fmt.Errorf("invalid packet sequence: %w", err)
+	}
+
+	data, err := hex.DecodeString(attrs[channeltypes.AttributeKeyDataHex])
+	if err != nil {
+		return channeltypes.Packet{}, fmt.Errorf("invalid packet data: %w", err)
+	}
+
+	timeoutHeight, err := clienttypes.ParseHeight(attrs[channeltypes.AttributeKeyTimeoutHeight])
+	if err != nil {
+		return channeltypes.Packet{}, fmt.Errorf("invalid packet timeout height: %w", err)
+	}
+
+	timeoutTimestamp, err := strconv.ParseUint(attrs[channeltypes.AttributeKeyTimeoutTimestamp], 10, 64)
+	if err != nil {
+		return channeltypes.Packet{}, fmt.Errorf("invalid packet timeout timestamp: %w", err)
+	}
+
+	return channeltypes.NewPacket(
+		data,
+		seq,
+		attrs[channeltypes.AttributeKeySrcPort],
+		attrs[channeltypes.AttributeKeySrcChannel],
+		attrs[channeltypes.AttributeKeyDstPort],
+		attrs[channeltypes.AttributeKeyDstChannel],
+		timeoutHeight,
+		timeoutTimestamp,
+	), nil
+}
+
+// packetTimedOut checks if a packet can't be received anymore by a chain at a height and a time.
+func packetTimedOut(packet channeltypes.Packet, height clienttypes.Height, t time.Time) bool {
+	if !packet.TimeoutHeight.IsZero() && packet.TimeoutHeight.LTE(height) {
+		return true
+	}
+
+	return packet.TimeoutTimestamp != 0 && packet.TimeoutTimestamp <= uint64(t.UnixNano())
+}
+
+func eventAttributes(event abci.Event) map[string]string {
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, a := range event.Attributes {
+		attrs[a.Key] = a.Value
+	}
+
+	return attrs
+}
@@ -0,0 +1,140 @@
+package relayer
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPacketFromEvent(t *testing.T) {
+	tests := []struct {
+		name    string
+		attrs   map[string]string
+		want    channeltypes.Packet
+		wantErr bool
+	}{
+		{
+			name: "send packet",
+			attrs: map[string]string{
+				channeltypes.AttributeKeySequence:         "3",
+				channeltypes.AttributeKeyDataHex:          "7b7d",
+				channeltypes.AttributeKeyTimeoutHeight:    "1-100",
+				channeltypes.AttributeKeyTimeoutTimestamp: "1700000000000000000",
+				channeltypes.AttributeKeySrcPort:          "transfer",
+				channeltypes.AttributeKeySrcChannel:       "channel-0",
+				channeltypes.AttributeKeyDstPort:          "transfer",
+				channeltypes.AttributeKeyDstChannel:       "channel-1",
+			},
+			want: channeltypes.NewPacket(
+				[]byte("{}"),
+				3,
+				"transfer",
+				"channel-0",
+				"transfer",
+				"channel-1",
+				clienttypes.NewHeight(1, 100),
+				1700000000000000000,
+			),
+		},
+		{
+			name: "invalid sequence",
+			attrs: map[string]string{
+				channeltypes.AttributeKeySequence: "three",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid timeout height",
+			attrs: map[string]string{
+				channeltypes.AttributeKeySequence:         "3",
+				channeltypes.AttributeKeyTimeoutHeight:    "100",
+				channeltypes.AttributeKeyTimeoutTimestamp: "0",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			event := abci.Event{Type: channeltypes.EventTypeSendPacket}
+			for k, v := range tt.attrs {
+				event.Attributes = append(event.Attributes, abci.EventAttribute{Key: k, Value: v})
+			}
+
+			// Act
+			packet, err := packetFromEvent(event)
+
+			// Assert
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, packet)
+		})
+	}
+}
+
+func TestPacketTimedOut(t *testing.T) {
+	var (
+		now    = time.Now()
+		height = clienttypes.NewHeight(1, 100)
+	)
+
+	tests := []struct {
+		name   string
+		packet channeltypes.Packet
+		want   bool
+	}{
+		{
+			name:   "no timeout",
+			packet: channeltypes.Packet{},
+		},
+		{
+			name:   "timeout height reached",
+			packet: channeltypes.Packet{TimeoutHeight: clienttypes.NewHeight(1, 100)},
+			want:   true,
+		},
+		{
+			name:   "timeout height not reached",
+			packet: channeltypes.Packet{TimeoutHeight: clienttypes.NewHeight(1, 101)},
+		},
+		{
+			name:   "timeout timestamp reached",
+			packet: channeltypes.Packet{TimeoutTimestamp: uint64(now.Add(-time.Second).UnixNano())},
+			want:   true,
+		},
+		{
+			name:   "timeout timestamp not reached",
+			packet: channeltypes.Packet{TimeoutTimestamp: uint64(now.Add(time.Second).UnixNano())},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, packetTimedOut(tt.packet, height, now))
+		})
+	}
+}
+
+func TestChannelOrder(t *testing.T) {
+	order, err := channelOrder("")
+	require.NoError(t, err)
+	require.Equal(t, channeltypes.UNORDERED, order)
+
+	order, err = channelOrder(OrderingOrdered)
+	require.NoError(t, err)
+	require.Equal(t, channeltypes.ORDERED, order)
+
+	_, err = channelOrder("ORDER_NONE_UNSPECIFIED")
+	require.Error(t, err)
+
+	_, err = channelOrder("sorted")
+	require.Error(t, err)
+}
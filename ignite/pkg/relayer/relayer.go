@@ -2,14 +2,12 @@ package relayer
 
 import (
 	"context"
-	"encoding/hex"
 	"errors"
 	"fmt"
 	"strings"
 	"sync"
 	"time"
 
-	"github.com/cosmos/cosmos-sdk/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"golang.org/x/sync/errgroup"
@@ -17,13 +15,11 @@ import (
 	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite/cli/ignite/pkg/cosmosclient"
 	"github.com/ignite/cli/ignite/pkg/ctxticker"
-	tsrelayer "github.com/ignite/cli/ignite/pkg/nodetime/programs/ts-relayer"
 	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
 	"github.com/ignite/cli/ignite/pkg/xurl"
 )
 
 const (
-	algoSecp256k1       = "secp256k1"
 	ibcSetupGas   int64 = 2256000
 	relayDuration       = time.Second * 5
 )
@@ -34,15 +30,26 @@ var ErrLinkedPath = errors.New("path already linked")
 // Relayer is an IBC relayer.
 type Relayer struct {
 	ca cosmosaccount.Registry
+
+	// txLocks serializes the transactions sent by an account on a chain, so the
+	// paths relayed at the same time don't use the same account sequence.
+	txLocks *sync.Map
 }
 
 // New creates a new IBC relayer and uses ca to access accounts.
 func New(ca cosmosaccount.Registry) Relayer {
 	return Relayer{
-		ca: ca,
+		ca:      ca,
+		txLocks: &sync.Map{},
 	}
 }
 
+// txLock returns the lock of the transactions sent by an account on a chain.
+func (r Relayer) txLock(chainID, account string) *sync.Mutex {
+	m, _ := r.txLocks.LoadOrStore(chainID+"/"+account, &sync.Mutex{})
+	return m.(*sync.Mutex)
+}
+
 // LinkPaths links all chains that has a path from config file to each other.
 // paths are optional and acts as a filter to only link some chains.
 // calling Link multiple times for the same paths does not have any side effects.
@@ -86,7 +93,7 @@ func (r Relayer) Link(
 		return conf, fmt.Errorf("%w: %s", ErrLinkedPath, path.ID)
 	}
 
-	if path, err = r.call(ctx, conf, path, "link"); err != nil {
+	if path, err = r.link(ctx, conf, path); err != nil {
 		return conf, err
 	}
 
@@ -117,24 +124,41 @@ func (r Relayer) StartPaths(ctx context.Context, pathIDs ...string) error {
 }
 
 // Start relays packets for linked path until ctx is canceled.
+// The clients of the path are also updated before they expire.
 func (r Relayer) Start(
 	ctx context.Context,
 	conf relayerconf.Config,
 	pathID string,
 	postExecute func(path relayerconf.Config) error,
 ) error {
-	return ctxticker.DoNow(ctx, relayDuration, func() error {
-		path, err := conf.PathByID(pathID)
-		if err != nil {
-			return err
-		}
-		path, err = r.call(ctx, conf, path, "start")
-		if err != nil {
-			return err
+	path, err := conf.PathByID(pathID)
+	if err != nil {
+		return err
+	}
+
+	if path.Src.ChannelID == "" || path.Dst.ChannelID == "" {
+		return fmt.Errorf("path %s is not linked", path.ID)
+	}
+
+	src, dst, err := r.newEndpoints(ctx, conf, path)
+	if err != nil {
+		return err
+	}
+
+	// paths linked by previous versions of the relayer don't save the client IDs
+	for _, e := range []*endpoint{src, dst} {
+		if e.clientID == "" {
+			if e.clientID, err = e.connectionClientID(ctx); err != nil {
+				return err
+			}
 		}
-		if err := conf.UpdatePath(path); err != nil {
+	}
+
+	return ctxticker.DoNow(ctx, relayDuration, func() error {
+		if err := relay(ctx, src, dst); err != nil {
 			return err
 		}
+
 		if postExecute != nil {
 			return postExecute(conf)
 		}
@@ -142,60 +166,51 @@ func (r Relayer) Start(
 	})
 }
 
-func (r Relayer) call(
-	ctx context.Context,
-	conf relayerconf.Config,
-	path relayerconf.Path,
-	action string,
-) (
-	reply relayerconf.Path, err error,
-) {
-	srcChain, srcKey, err := r.prepare(ctx, conf, path.Src.ChainID)
+// newEndpoints creates the endpoints of the chains of a path.
+func (r Relayer) newEndpoints(ctx context.Context, conf relayerconf.Config, path relayerconf.Path) (src, dst *endpoint, err error) {
+	order, err := channelOrder(path.Ordering)
 	if err != nil {
-		return relayerconf.Path{}, err
+		return nil, nil, err
 	}
 
-	dstChain, dstKey, err := r.prepare(ctx, conf, path.Dst.ChainID)
-	if err != nil {
-		return relayerconf.Path{}, err
+	if src, err = r.newEndpoint(ctx, conf, path.Src); err != nil {
+		return nil, nil, err
 	}
 
-	args := []interface{}{
-		path,
-		srcChain,
-		dstChain,
-		srcKey,
-		dstKey,
+	if dst, err = r.newEndpoint(ctx, conf, path.Dst); err != nil {
+		return nil, nil, err
 	}
-	return reply, tsrelayer.Call(ctx, action, args, &reply)
+
+	src.order, dst.order = order, order
+
+	return src, dst, nil
 }
 
-func (r Relayer) prepare(ctx context.Context, conf relayerconf.Config, chainID string) (
-	chain relayerconf.Chain, privKey string, err error,
-) {
-	chain, err = conf.ChainByID(chainID)
+// prepare returns the config of a chain and checks that the relayer account has enough balance.
+func (r Relayer) prepare(ctx context.Context, conf relayerconf.Config, chainID string) (relayerconf.Chain, error) {
+	chain, err := conf.ChainByID(chainID)
 	if err != nil {
-		return relayerconf.Chain{}, "", err
+		return relayerconf.Chain{}, err
 	}
 
 	coins, err := r.balance(ctx, chain.RPCAddress, chain.Account, chain.AddressPrefix)
 	if err != nil {
-		return relayerconf.Chain{}, "", err
+		return relayerconf.Chain{}, err
 	}
 
 	gasPrice, err := sdk.ParseCoinNormalized(chain.GasPrice)
 	if err != nil {
-		return relayerconf.Chain{}, "", err
+		return relayerconf.Chain{}, err
 	}
 
 	account, err := r.ca.GetByName(chain.Account)
 	if err != nil {
-		return relayerconf.Chain{}, "", err
+		return relayerconf.Chain{}, err
 	}
 
 	addr, err := account.Address(chain.AddressPrefix)
 	if err != nil {
-		return relayerconf.Chain{}, "", err
+		return relayerconf.Chain{}, err
 	}
 
 	errMissingBalance := fmt.Errorf(`account "%s(%s)" on %q chain does not have enough balances`,
@@ -205,7 +220,7 @@ func (r Relayer) prepare(ctx context.Context, conf relayerconf.Config, chainID s
 	)
 
 	if len(coins) == 0 {
-		return relayerconf.Chain{}, "", errMissingBalance
+		return relayerconf.Chain{}, errMissingBalance
 	}
 
 	for _, coin := range coins {
@@ -214,33 +229,19 @@ func (r Relayer) prepare(ctx context.Context, conf relayerconf.Config, chainID s
 		}
 
 		if gasPrice.Amount.Int64()*ibcSetupGas > coin.Amount.Int64() {
-			return relayerconf.Chain{}, "", errMissingBalance
+			return relayerconf.Chain{}, errMissingBalance
 		}
 	}
 
-	// Get the key in ASCII armored format
-	passphrase := ""
-	key, err := r.ca.Export(chain.Account, passphrase)
-	if err != nil {
-		return relayerconf.Chain{}, "", err
-	}
-
-	// Unarmor the key to be able to read it as bytes
-	priv, algo, err := crypto.UnarmorDecryptPrivKey(key, passphrase)
-	if err != nil {
-		return relayerconf.Chain{}, "", err
-	}
-
-	// Check the algorithm because the TS relayer expects a secp256k1 private key
-	if algo != algoSecp256k1 {
-		return relayerconf.Chain{}, "", fmt.Errorf("private key algorithm must be secp256k1 instead of %s", algo)
-	}
-
-	return chain, hex.EncodeToString(priv.Bytes()), nil
+	return chain, nil
 }
 
 func (r Relayer) balance(ctx context.Context, rpcAddress, account, addressPrefix string) (sdk.Coins, error) {
-	client, err := cosmosclient.New(ctx, cosmosclient.WithNodeAddress(rpcAddress))
+	client, err := cosmosclient.New(
+		ctx,
+		cosmosclient.WithNodeAddress(rpcAddress),
+		cosmosclient.WithAccountRegistry(r.ca),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +101,60 @@ func (c Client) GetGenesis(ctx context.Context) (Genesis, error) {
 	return out.Result.Genesis, nil
 }
 
+// get requests an endpoint and decodes the JSON-RPC response.
+// The response errors are returned as Go errors.
+func (c Client) get(ctx context.Context, endpoint string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url(endpoint), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var res struct {
+		Error *struct {
+			Message string `json:"message"`
+			Data    string `json:"data"`
+		} `json:"error"`
+	}
+
+	if err := json.Unmarshal(body, &res); err == nil && res.Error != nil {
+		return fmt.Errorf("%s: %s", res.Error.Message, res.Error.Data)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%d", resp.StatusCode)
+	}
+
+	return json.Unmarshal(body, out)
+}
+
+// GetLatestBlockHeight retrieves the height of the latest block of the node.
+func (c Client) GetLatestBlockHeight(ctx context.Context) (int64, error) {
+	var out struct {
+		Result struct {
+			SyncInfo struct {
+				LatestBlockHeight string `json:"latest_block_height"`
+			} `json:"sync_info"`
+		} `json:"result"`
+	}
+
+	if err := c.get(ctx, endpointStatus, &out); err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(out.Result.SyncInfo.LatestBlockHeight, 10, 64)
+}
+
 // NodeInfo holds node info.
 type NodeInfo struct {
 	Network string
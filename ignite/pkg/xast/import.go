@@ -0,0 +1,30 @@
+package xast
+
+import (
+	"bytes"
+	"go/format"
+	"go/parser"
+	"go/token"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// AppendImport adds an import to the Go source code when the file doesn't import it yet.
+// The name is the alias of the import, or empty to use the package name.
+func AppendImport(fileContent, name, path string) (string, error) {
+	fileSet := token.NewFileSet()
+	f, err := parser.ParseFile(fileSet, "", fileContent, parser.ParseComments)
+	if err != nil {
+		return "", err
+	}
+
+	if !astutil.AddNamedImport(fileSet, f, name, path) {
+		return fileContent, nil
+	}
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fileSet, f); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
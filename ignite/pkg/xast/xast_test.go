@@ -69,3 +69,64 @@ func TestParseDir(t *testing.T) {
 	require.NotNil(t, fileSet)
 	require.Equal(t, "file", pkg.Name)
 }
+
+func TestAppendImport(t *testing.T) {
+	content := `package foo
+
+import (
+	"testing"
+)
+
+// Foo is a test function.
+func Foo(t *testing.T) {}
+`
+
+	tests := []struct {
+		name     string
+		alias    string
+		path     string
+		expected string
+	}{
+		{
+			name: "new import",
+			path: "time",
+			expected: `package foo
+
+import (
+	"testing"
+	"time"
+)
+
+// Foo is a test function.
+func Foo(t *testing.T) {}
+`,
+		},
+		{
+			name:  "new import with alias",
+			alias: "sdk",
+			path:  "github.com/cosmos/cosmos-sdk/types",
+			expected: `package foo
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"testing"
+)
+
+// Foo is a test function.
+func Foo(t *testing.T) {}
+`,
+		},
+		{
+			name:     "existing import",
+			path:     "testing",
+			expected: content,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := xast.AppendImport(content, tt.alias, tt.path)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, got)
+		})
+	}
+}
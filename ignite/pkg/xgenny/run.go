@@ -45,6 +45,7 @@ func RunWithValidation(
 		}
 		return runner.Run()
 	}
+	sm = NewSourceModification()
 	for _, gen := range gens {
 		// check with a dry runner the generators
 		dryRunner := DryRunner(context.Background())
@@ -59,7 +60,6 @@ func RunWithValidation(
 		}
 
 		// fetch the source modification
-		sm = NewSourceModification()
 		for _, file := range dryRunner.Results().Files {
 			fileName := file.Name()
 			_, err := os.Stat(fileName)
@@ -72,7 +72,9 @@ func RunWithValidation(
 				return sm, err
 			} else {
 				// the file has been modified by the runner
-				sm.AppendModifiedFiles(fileName)
+				if err := sm.appendModifiedFileContent(fileName); err != nil {
+					return sm, err
+				}
 			}
 		}
 
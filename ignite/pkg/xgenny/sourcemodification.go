@@ -1,15 +1,19 @@
 package xgenny
 
+import "os"
+
 // SourceModification describes modified and created files in the source code after a run.
 type SourceModification struct {
 	modified map[string]struct{}
 	created  map[string]struct{}
+	original map[string][]byte
 }
 
 func NewSourceModification() SourceModification {
 	return SourceModification{
 		make(map[string]struct{}),
 		make(map[string]struct{}),
+		make(map[string][]byte),
 	}
 }
 
@@ -29,6 +33,13 @@ func (sm SourceModification) CreatedFiles() (createdFiles []string) {
 	return
 }
 
+// OriginalContent returns the content that a modified file had before the first run that modified it.
+// The content is only available for files modified by RunWithValidation.
+func (sm SourceModification) OriginalContent(modifiedFile string) (content []byte, ok bool) {
+	content, ok = sm.original[modifiedFile]
+	return
+}
+
 // AppendModifiedFiles appends modified files in the source modification that are not already documented.
 func (sm *SourceModification) AppendModifiedFiles(modifiedFiles ...string) {
 	for _, modifiedFile := range modifiedFiles {
@@ -53,6 +64,33 @@ func (sm *SourceModification) AppendCreatedFiles(createdFiles ...string) {
 
 // Merge merges new source modification to an existing one.
 func (sm *SourceModification) Merge(newSm SourceModification) {
+	for file, content := range newSm.original {
+		if _, ok := sm.modified[file]; ok {
+			continue
+		}
+		if _, ok := sm.created[file]; ok {
+			continue
+		}
+		sm.original[file] = content
+	}
 	sm.AppendModifiedFiles(newSm.ModifiedFiles()...)
 	sm.AppendCreatedFiles(newSm.CreatedFiles()...)
 }
+
+// appendModifiedFileContent appends a modified file and keeps its current content
+// as the original one when the file is not already documented.
+func (sm *SourceModification) appendModifiedFileContent(modifiedFile string) error {
+	_, alreadyModified := sm.modified[modifiedFile]
+	_, alreadyCreated := sm.created[modifiedFile]
+	if alreadyModified || alreadyCreated {
+		return nil
+	}
+
+	content, err := os.ReadFile(modifiedFile)
+	if err != nil {
+		return err
+	}
+	sm.original[modifiedFile] = content
+	sm.modified[modifiedFile] = struct{}{}
+	return nil
+}
@@ -154,3 +154,17 @@ func IsRepository(path string) (bool, error) {
 	}
 	return true, nil
 }
+
+// HeadCommit returns the hash of the commit checked out in the git repository
+// that contains path.
+func HeadCommit(path string) (string, error) {
+	repo, err := git.PlainOpenWithOptions(path, &defaultOpenOpts)
+	if err != nil {
+		return "", fmt.Errorf("open git repo %s: %w", path, err)
+	}
+	head, err := repo.Head()
+	if err != nil {
+		return "", fmt.Errorf("git head %s: %w", path, err)
+	}
+	return head.Hash().String(), nil
+}
@@ -432,3 +432,26 @@ func TestIsRepository(t *testing.T) {
 		})
 	}
 }
+
+func TestHeadCommit(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	err := os.WriteFile(path.Join(dir, "foo"), []byte("hello"), 0o755)
+	require.NoError(t, err)
+	err = xgit.InitAndCommit(dir)
+	require.NoError(t, err)
+	repo, err := git.PlainOpen(dir)
+	require.NoError(t, err)
+	head, err := repo.Head()
+	require.NoError(t, err)
+
+	// Act
+	commit, err := xgit.HeadCommit(dir)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, head.Hash().String(), commit)
+
+	_, err = xgit.HeadCommit(t.TempDir())
+	require.Error(t, err)
+}
@@ -0,0 +1,436 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	chainconfig "github.com/ignite/cli/ignite/config/chain"
+	"github.com/ignite/cli/ignite/config/chain/base"
+	"github.com/ignite/cli/ignite/pkg/chaincmd"
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+)
+
+const (
+	// DefaultAccountsSeed is the seed used to derive the mnemonics of the
+	// accounts when they are deterministic and the config doesn't define a seed.
+	DefaultAccountsSeed = "ignite"
+
+	// devAccountsFile is the name of the file in the chain home that keeps
+	// the accounts created when the chain is initialized.
+	devAccountsFile = "dev_accounts.json"
+
+	typeBaseAccount            = "/cosmos.auth.v1beta1.BaseAccount"
+	typePeriodicVestingAccount = "/cosmos.vesting.v1beta1.PeriodicVestingAccount"
+)
+
+// genesisAccountEdits contains the changes to apply to the genesis accounts
+// that can't be made with the commands of the app.
+type genesisAccountEdits struct {
+	periodicVesting map[string]periodicVesting
+	moduleFunds     map[string]sdk.Coins
+}
+
+type periodicVesting struct {
+	startTime time.Time
+	periods   []vestingPeriod
+}
+
+type vestingPeriod struct {
+	length time.Duration
+	coins  sdk.Coins
+}
+
+// DevAccount is an account created when the chain is initialized.
+type DevAccount struct {
+	Name       string `json:"name"`
+	Address    string `json:"address"`
+	Mnemonic   string `json:"mnemonic,omitempty"`
+	PrivateKey string `json:"private_key,omitempty"`
+	CoinType   string `json:"coin_type,omitempty"`
+}
+
+// DevAccounts returns the accounts created when the chain was initialized.
+// The private keys are derived from the mnemonics of the accounts.
+func (c *Chain) DevAccounts() ([]DevAccount, error) {
+	path, err := c.devAccountsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, errors.New("no accounts found, initialize the chain first")
+	} else if err != nil {
+		return nil, err
+	}
+
+	var accounts []DevAccount
+	if err := json.Unmarshal(data, &accounts); err != nil {
+		return nil, err
+	}
+
+	for i, a := range accounts {
+		if a.Mnemonic == "" {
+			continue
+		}
+
+		coinType := uint64(sdk.CoinType)
+		if a.CoinType != "" {
+			if coinType, err = strconv.ParseUint(a.CoinType, 10, 32); err != nil {
+				return nil, fmt.Errorf("account %s: invalid coin type: %w", a.Name, err)
+			}
+		}
+
+		accounts[i].PrivateKey, err = cosmosaccount.PrivKeyHexFromMnemonic(a.Mnemonic, uint32(coinType))
+		if err != nil {
+			return nil, fmt.Errorf("account %s: %w", a.Name, err)
+		}
+	}
+
+	return accounts, nil
+}
+
+func (c *Chain) saveDevAccounts(accounts []DevAccount) error {
+	path, err := c.devAccountsPath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(accounts, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o600)
+}
+
+func (c *Chain) devAccountsPath() (string, error) {
+	home, err := c.Home()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, devAccountsFile), nil
+}
+
+// accountsSeed returns the seed used to derive the mnemonics of the accounts,
+// it's empty when the mnemonics are random.
+func (c *Chain) accountsSeed(cfg *chainconfig.Config) string {
+	switch {
+	case cfg.Seed != "":
+		return cfg.Seed
+	case c.options.deterministicAccounts:
+		return DefaultAccountsSeed
+	default:
+		return ""
+	}
+}
+
+// sortAccounts returns the accounts with the multisig accounts at the end
+// because they require the other accounts to be created first.
+func sortAccounts(accounts []base.Account) []base.Account {
+	sorted := make([]base.Account, 0, len(accounts))
+	for _, a := range accounts {
+		if a.Multisig == nil {
+			sorted = append(sorted, a)
+		}
+	}
+
+	for _, a := range accounts {
+		if a.Multisig != nil {
+			sorted = append(sorted, a)
+		}
+	}
+
+	return sorted
+}
+
+// addGenesisAccount adds an account of the config to the genesis.
+// The periodic vesting schedules are added to the edits to be applied later.
+func addGenesisAccount(
+	ctx context.Context,
+	commands chaincmdrunner.Runner,
+	account base.Account,
+	address string,
+	now time.Time,
+	edits *genesisAccountEdits,
+) error {
+	coins := strings.Join(account.Coins, ",")
+
+	v := account.Vesting
+	if v == nil {
+		return commands.AddGenesisAccount(ctx, address, coins)
+	}
+
+	if v.Type == base.VestingPeriodic {
+		vesting, err := newPeriodicVesting(*v, now)
+		if err != nil {
+			return fmt.Errorf("account %s: %w", account.Name, err)
+		}
+
+		edits.periodicVesting[address] = vesting
+
+		return commands.AddGenesisAccount(ctx, address, coins)
+	}
+
+	vestingCoins := coins
+	if len(v.Coins) > 0 {
+		vestingCoins = strings.Join(v.Coins, ",")
+	}
+
+	endTime, err := base.ParseVestingTime(v.EndTime, now)
+	if err != nil {
+		return fmt.Errorf("account %s: %w", account.Name, err)
+	}
+
+	var options []chaincmd.VestingOption
+	if v.Type == base.VestingContinuous {
+		startTime, err := base.ParseVestingTime(v.StartTime, now)
+		if err != nil {
+			return fmt.Errorf("account %s: %w", account.Name, err)
+		}
+
+		options = append(options, chaincmd.VestingWithStartTime(startTime.Unix()))
+	}
+
+	return commands.AddVestingAccount(ctx, address, coins, vestingCoins, endTime.Unix(), options...)
+}
+
+func newPeriodicVesting(v base.Vesting, now time.Time) (periodicVesting, error) {
+	startTime, err := base.ParseVestingTime(v.StartTime, now)
+	if err != nil {
+		return periodicVesting{}, err
+	}
+
+	vesting := periodicVesting{startTime: startTime}
+	for _, p := range v.Periods {
+		length, err := time.ParseDuration(p.Length)
+		if err != nil {
+			return periodicVesting{}, err
+		}
+
+		coins, err := sdk.ParseCoinsNormalized(strings.Join(p.Coins, ","))
+		if err != nil {
+			return periodicVesting{}, err
+		}
+
+		vesting.periods = append(vesting.periods, vestingPeriod{length: length, coins: coins})
+	}
+
+	return vesting, nil
+}
+
+// addModuleAccount adds the funds of a module account to the edits and returns its address.
+// The address is encoded with the address prefix of the app.
+func addModuleAccount(account base.Account, prefix string, edits *genesisAccountEdits) (string, error) {
+	address, err := moduleAccountAddress(account, prefix)
+	if err != nil {
+		return "", err
+	}
+
+	coins, err := sdk.ParseCoinsNormalized(strings.Join(account.Coins, ","))
+	if err != nil {
+		return "", fmt.Errorf("module account %s: %w", account.Name, err)
+	}
+
+	edits.moduleFunds[address] = edits.moduleFunds[address].Add(coins...)
+
+	return address, nil
+}
+
+// moduleAccountAddress returns the address of a module account encoded with the address prefix of the app.
+func moduleAccountAddress(account base.Account, prefix string) (string, error) {
+	address, err := cosmosutil.ModuleAddress(account.Module, prefix)
+	if err != nil {
+		return "", fmt.Errorf("module account %s: %w", account.Name, err)
+	}
+
+	return address, nil
+}
+
+// appAddressPrefix returns the address prefix of the app, which is the prefix
+// of the addresses of the accounts in the keyring of the app.
+func appAddressPrefix(ctx context.Context, commands chaincmdrunner.Runner) (string, error) {
+	accounts, err := commands.ListAccounts(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if len(accounts) == 0 {
+		return "", errors.New("the address prefix of the app can't be found without an account with a key created by Ignite")
+	}
+
+	return cosmosutil.GetAddressPrefix(accounts[0].Address)
+}
+
+// apply applies the edits to the genesis file.
+func (e genesisAccountEdits) apply(genesisPath string) error {
+	if len(e.periodicVesting) == 0 && len(e.moduleFunds) == 0 {
+		return nil
+	}
+
+	data, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return err
+	}
+
+	var genesis map[string]interface{}
+	if err := decodeJSON(data, &genesis); err != nil {
+		return err
+	}
+
+	if err := e.applyToGenesis(genesis); err != nil {
+		return err
+	}
+
+	if data, err = json.MarshalIndent(genesis, "", "  "); err != nil {
+		return err
+	}
+
+	return os.WriteFile(genesisPath, data, 0o644)
+}
+
+func (e genesisAccountEdits) applyToGenesis(genesis map[string]interface{}) error {
+	state, ok := genesis["app_state"].(map[string]interface{})
+	if !ok {
+		return errors.New("invalid genesis: app_state is not defined")
+	}
+
+	for _, account := range asSlice(jsonField(state, "auth", "accounts")) {
+		address := accountAddress(account)
+
+		vesting, ok := e.periodicVesting[address]
+		if !ok {
+			continue
+		}
+
+		a, _ := account.(map[string]interface{})
+		if a["@type"] != typeBaseAccount {
+			return fmt.Errorf("account %s is not a base account", address)
+		}
+
+		convertToPeriodicVesting(a, vesting)
+	}
+
+	supply, err := coinsFromJSON(asSlice(jsonField(state, "bank", "supply")))
+	if err != nil {
+		return err
+	}
+
+	addresses := make([]string, 0, len(e.moduleFunds))
+	for address := range e.moduleFunds {
+		addresses = append(addresses, address)
+	}
+
+	sort.Strings(addresses)
+
+	balances := asSlice(jsonField(state, "bank", "balances"))
+	for _, address := range addresses {
+		coins := e.moduleFunds[address]
+
+		// the module accounts are created by the modules, only the balances are added
+		balances = append(balances, map[string]interface{}{
+			"address": address,
+			"coins":   coinsToJSON(coins),
+		})
+
+		// the supply is computed by the app when it's empty
+		if len(supply) > 0 {
+			supply = supply.Add(coins...)
+		}
+	}
+
+	setFields(state, "bank", map[string]interface{}{
+		"balances": balances,
+		"supply":   coinsToJSON(supply),
+	})
+
+	return nil
+}
+
+// convertToPeriodicVesting converts a base account of the genesis to a periodic vesting account.
+func convertToPeriodicVesting(account map[string]interface{}, vesting periodicVesting) {
+	var (
+		original sdk.Coins
+		endTime  = vesting.startTime
+		periods  = make([]interface{}, len(vesting.periods))
+	)
+
+	for i, p := range vesting.periods {
+		original = original.Add(p.coins...)
+		endTime = endTime.Add(p.length)
+		periods[i] = map[string]interface{}{
+			"length": fmt.Sprintf("%d", int64(p.length.Seconds())),
+			"amount": coinsToJSON(p.coins),
+		}
+	}
+
+	baseAccount := make(map[string]interface{})
+	for k, v := range account {
+		if k != "@type" {
+			baseAccount[k] = v
+		}
+		delete(account, k)
+	}
+
+	account["@type"] = typePeriodicVestingAccount
+	account["base_vesting_account"] = map[string]interface{}{
+		"base_account":      baseAccount,
+		"original_vesting":  coinsToJSON(original),
+		"delegated_free":    []interface{}{},
+		"delegated_vesting": []interface{}{},
+		"end_time":          fmt.Sprintf("%d", endTime.Unix()),
+	}
+	account["start_time"] = fmt.Sprintf("%d", vesting.startTime.Unix())
+	account["vesting_periods"] = periods
+}
+
+func coinsFromJSON(s []interface{}) (sdk.Coins, error) {
+	var coins sdk.Coins
+	for _, c := range s {
+		denom, _ := jsonField(c, "denom").(string)
+		amount, _ := jsonField(c, "amount").(string)
+
+		n, ok := sdkmath.NewIntFromString(amount)
+		if !ok {
+			return nil, fmt.Errorf("invalid amount %q of denom %q", amount, denom)
+		}
+
+		coins = coins.Add(sdk.Coin{Denom: denom, Amount: n})
+	}
+
+	return coins, nil
+}
+
+func coinsToJSON(coins sdk.Coins) []interface{} {
+	s := make([]interface{}, len(coins))
+	for i, c := range coins {
+		s[i] = map[string]interface{}{
+			"denom":  c.Denom,
+			"amount": c.Amount.String(),
+		}
+	}
+
+	return s
+}
+
+// newGenesisAccountEdits creates empty genesis account edits.
+func newGenesisAccountEdits() *genesisAccountEdits {
+	return &genesisAccountEdits{
+		periodicVesting: make(map[string]periodicVesting),
+		moduleFunds:     make(map[string]sdk.Coins),
+	}
+}
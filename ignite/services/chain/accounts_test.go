@@ -0,0 +1,173 @@
+package chain
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	chainconfig "github.com/ignite/cli/ignite/config/chain"
+	"github.com/ignite/cli/ignite/config/chain/base"
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+)
+
+func TestSortAccounts(t *testing.T) {
+	accounts := []base.Account{
+		{Name: "treasury", Multisig: &base.Multisig{Threshold: 1, Accounts: []string{"alice"}}},
+		{Name: "alice"},
+		{Name: "rewards", Module: "rewards"},
+	}
+
+	require.Equal(t, []base.Account{accounts[1], accounts[2], accounts[0]}, sortAccounts(accounts))
+}
+
+func TestNewPeriodicVesting(t *testing.T) {
+	// Arrange
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	v := base.Vesting{
+		Type:      base.VestingPeriodic,
+		StartTime: "24h",
+		Periods: []base.VestingPeriod{
+			{Length: "1h", Coins: []string{"10token", "5stake"}},
+			{Length: "2h", Coins: []string{"20token"}},
+		},
+	}
+
+	// Act
+	vesting, err := newPeriodicVesting(v, now)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, periodicVesting{
+		startTime: now.Add(24 * time.Hour),
+		periods: []vestingPeriod{
+			{length: time.Hour, coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 5), sdk.NewInt64Coin("token", 10))},
+			{length: 2 * time.Hour, coins: sdk.NewCoins(sdk.NewInt64Coin("token", 20))},
+		},
+	}, vesting)
+}
+
+func TestGenesisAccountEditsApply(t *testing.T) {
+	// Arrange
+	var genesis map[string]interface{}
+	require.NoError(t, decodeJSON([]byte(`{
+  "app_state": {
+    "auth": {
+      "accounts": [
+        {
+          "@type": "/cosmos.auth.v1beta1.BaseAccount",
+          "address": "cosmos1alice",
+          "pub_key": null,
+          "account_number": "0",
+          "sequence": "0"
+        },
+        {
+          "@type": "/cosmos.auth.v1beta1.BaseAccount",
+          "address": "cosmos1team",
+          "pub_key": null,
+          "account_number": "0",
+          "sequence": "0"
+        }
+      ]
+    },
+    "bank": {
+      "balances": [
+        {"address": "cosmos1alice", "coins": [{"denom": "stake", "amount": "100"}]},
+        {"address": "cosmos1team", "coins": [{"denom": "token", "amount": "30"}]}
+      ],
+      "supply": [
+        {"denom": "stake", "amount": "100"},
+        {"denom": "token", "amount": "30"}
+      ]
+    }
+  }
+}`), &genesis))
+
+	start := time.Unix(1672531200, 0)
+	edits := newGenesisAccountEdits()
+	edits.periodicVesting["cosmos1team"] = periodicVesting{
+		startTime: start,
+		periods: []vestingPeriod{
+			{length: time.Hour, coins: sdk.NewCoins(sdk.NewInt64Coin("token", 10))},
+			{length: 2 * time.Hour, coins: sdk.NewCoins(sdk.NewInt64Coin("token", 20))},
+		},
+	}
+	edits.moduleFunds["cosmos1rewards"] = sdk.NewCoins(sdk.NewInt64Coin("atom", 50), sdk.NewInt64Coin("stake", 10))
+
+	// Act
+	err := edits.applyToGenesis(genesis)
+
+	// Assert
+	require.NoError(t, err)
+
+	coin := func(denom, amount string) interface{} {
+		return map[string]interface{}{"denom": denom, "amount": amount}
+	}
+
+	require.Equal(t, map[string]interface{}{
+		"@type": "/cosmos.vesting.v1beta1.PeriodicVestingAccount",
+		"base_vesting_account": map[string]interface{}{
+			"base_account": map[string]interface{}{
+				"address":        "cosmos1team",
+				"pub_key":        nil,
+				"account_number": "0",
+				"sequence":       "0",
+			},
+			"original_vesting":  []interface{}{coin("token", "30")},
+			"delegated_free":    []interface{}{},
+			"delegated_vesting": []interface{}{},
+			"end_time":          "1672542000",
+		},
+		"start_time": "1672531200",
+		"vesting_periods": []interface{}{
+			map[string]interface{}{"length": "3600", "amount": []interface{}{coin("token", "10")}},
+			map[string]interface{}{"length": "7200", "amount": []interface{}{coin("token", "20")}},
+		},
+	}, jsonField(genesis, "app_state", "auth", "accounts").([]interface{})[1])
+
+	require.Equal(t, map[string]interface{}{
+		"address": "cosmos1rewards",
+		"coins":   []interface{}{coin("atom", "50"), coin("stake", "10")},
+	}, jsonField(genesis, "app_state", "bank", "balances").([]interface{})[2])
+
+	require.Equal(t,
+		[]interface{}{coin("atom", "50"), coin("stake", "110"), coin("token", "30")},
+		jsonField(genesis, "app_state", "bank", "supply"),
+	)
+}
+
+func TestAccountsSeed(t *testing.T) {
+	c := &Chain{}
+	require.Empty(t, c.accountsSeed(&chainconfig.Config{}))
+	require.Equal(t, "custom", c.accountsSeed(&chainconfig.Config{Config: base.Config{Seed: "custom"}}))
+
+	c.options.deterministicAccounts = true
+	require.Equal(t, DefaultAccountsSeed, c.accountsSeed(&chainconfig.Config{}))
+	require.Equal(t, "custom", c.accountsSeed(&chainconfig.Config{Config: base.Config{Seed: "custom"}}))
+}
+
+func TestDevAccounts(t *testing.T) {
+	// Arrange
+	c := &Chain{options: chainOptions{homePath: t.TempDir()}}
+	mnemonic, err := cosmosaccount.MnemonicFromSeed(DefaultAccountsSeed, "alice")
+	require.NoError(t, err)
+
+	_, err = c.DevAccounts()
+	require.EqualError(t, err, "no accounts found, initialize the chain first")
+
+	// Act
+	err = c.saveDevAccounts([]DevAccount{
+		{Name: "alice", Address: "cosmos1alice", Mnemonic: mnemonic},
+		{Name: "bob", Address: "cosmos1bob"},
+	})
+	require.NoError(t, err)
+
+	accounts, err := c.DevAccounts()
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	require.Len(t, accounts[0].PrivateKey, 64)
+	require.Empty(t, accounts[1].PrivateKey)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/go-git/go-git/v5"
 
@@ -23,14 +24,6 @@ import (
 	igniteversion "github.com/ignite/cli/ignite/version"
 )
 
-var appBackendSourceWatchPaths = []string{
-	"app",
-	"cmd",
-	"x",
-	"proto",
-	"third_party",
-}
-
 type version struct {
 	tag  string
 	hash string
@@ -46,9 +39,13 @@ type Chain struct {
 	Version cosmosver.Version
 
 	sourceVersion  version
+	serveMu        *sync.Mutex // guards serveCancel
 	serveCancel    context.CancelFunc
 	serveRefresher chan struct{}
+	generateQueue  *generateQueue
 	served         bool
+	control        *serveControl
+	lifecycle      LifecycleHandler
 
 	ev          events.Bus
 	logOutputer uilog.Outputer
@@ -76,6 +73,9 @@ type chainOptions struct {
 	// printGeneratedPaths prints the output paths of the generated code
 	printGeneratedPaths bool
 
+	// deterministicAccounts derives the mnemonics of the accounts from a seed
+	deterministicAccounts bool
+
 	// path of a custom config file
 	ConfigFile string
 }
@@ -149,6 +149,16 @@ func PrintGeneratedPaths() Option {
 	}
 }
 
+// DeterministicAccounts derives the mnemonics of the accounts created when the
+// chain is initialized from the seed of the config, or from the default seed
+// when the config doesn't define one, so the addresses don't change when the
+// chain is reset.
+func DeterministicAccounts() Option {
+	return func(c *Chain) {
+		c.options.deterministicAccounts = true
+	}
+}
+
 // New initializes a new Chain with options that its source lives at path.
 func New(path string, options ...Option) (*Chain, error) {
 	app, err := NewAppAt(path)
@@ -159,6 +169,8 @@ func New(path string, options ...Option) (*Chain, error) {
 	c := &Chain{
 		app:            app,
 		serveRefresher: make(chan struct{}, 1),
+		generateQueue:  newGenerateQueue(),
+		serveMu:        &sync.Mutex{},
 	}
 
 	// Apply the options
@@ -264,6 +276,11 @@ func (c *Chain) Name() string {
 	return c.app.N()
 }
 
+// AppPath returns the path of the app source code.
+func (c *Chain) AppPath() string {
+	return c.app.Path
+}
+
 // Binary returns the name of app's default (appd) binary.
 func (c *Chain) Binary() (string, error) {
 	conf, err := c.Config()
@@ -428,12 +445,30 @@ func (c *Chain) KeyringBackend() (chaincmd.KeyringBackend, error) {
 
 // Commands returns the runner execute commands on the chain's binary.
 func (c *Chain) Commands(ctx context.Context) (chaincmdrunner.Runner, error) {
-	id, err := c.ID()
+	home, err := c.Home()
 	if err != nil {
 		return chaincmdrunner.Runner{}, err
 	}
 
-	home, err := c.Home()
+	cfg, err := c.Config()
+	if err != nil {
+		return chaincmdrunner.Runner{}, err
+	}
+
+	servers := chainconfigv1.DefaultServers()
+	if len(cfg.Validators) > 0 {
+		validator, _ := chainconfig.FirstValidator(cfg)
+		servers, err = validator.GetServers()
+		if err != nil {
+			return chaincmdrunner.Runner{}, err
+		}
+	}
+
+	return c.commands(ctx, home, servers)
+}
+
+func (c *Chain) commands(ctx context.Context, home string, servers chainconfigv1.Servers) (chaincmdrunner.Runner, error) {
+	id, err := c.ID()
 	if err != nil {
 		return chaincmdrunner.Runner{}, err
 	}
@@ -453,20 +488,6 @@ func (c *Chain) Commands(ctx context.Context) (chaincmdrunner.Runner, error) {
 		return chaincmdrunner.Runner{}, err
 	}
 
-	cfg, err := c.Config()
-	if err != nil {
-		return chaincmdrunner.Runner{}, err
-	}
-
-	servers := chainconfigv1.DefaultServers()
-	if len(cfg.Validators) > 0 {
-		validator, _ := chainconfig.FirstValidator(cfg)
-		servers, err = validator.GetServers()
-		if err != nil {
-			return chaincmdrunner.Runner{}, err
-		}
-	}
-
 	nodeAddr, err := xurl.TCP(servers.RPC.Address)
 	if err != nil {
 		return chaincmdrunner.Runner{}, err
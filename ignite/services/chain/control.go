@@ -0,0 +1,468 @@
+package chain
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	chainconfig "github.com/ignite/cli/ignite/config/chain"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/tendermintrpc"
+	"github.com/ignite/cli/ignite/pkg/xhttp"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+const (
+	// ServeStateStarting is the state of a served chain while it's built, initialized or started.
+	ServeStateStarting = "starting"
+
+	// ServeStateRunning is the state of a served chain when the nodes are running.
+	ServeStateRunning = "running"
+
+	// ServeStateFailed is the state of a served chain that failed to build or start,
+	// the chain is served again when the source code changes.
+	ServeStateFailed = "failed"
+
+	// controlEventsBufferSize is the number of events buffered for each events stream.
+	controlEventsBufferSize = 100
+
+	// controlTokenFile is the name of the file in the chain save directory
+	// with the token required to call the actions of the serve control API.
+	controlTokenFile = "control.token"
+)
+
+// ansiRe matches the ANSI escape sequences used to colorize the event messages.
+var ansiRe = regexp.MustCompile("\x1b\\[[0-9;]*[a-zA-Z]")
+
+// ServeStatus contains the status of a served chain.
+type ServeStatus struct {
+	// State is the state of the served chain.
+	State string `json:"state"`
+
+	// ChainID is the ID of the served chain.
+	ChainID string `json:"chain_id"`
+
+	// Height is the latest block height of the chain, it's zero when the chain is not running.
+	Height int64 `json:"height"`
+
+	// Watching is false when watching the source code changes is paused.
+	Watching bool `json:"watching"`
+
+	// LastBuildError is the error of the last failed build or start.
+	LastBuildError string `json:"last_build_error,omitempty"`
+}
+
+// ServeEvent is the JSON representation of an event sent while the chain is served.
+type ServeEvent struct {
+	Message  string    `json:"message"`
+	Icon     string    `json:"icon,omitempty"`
+	Progress string    `json:"progress,omitempty"`
+	Group    string    `json:"group,omitempty"`
+	Verbose  bool      `json:"verbose,omitempty"`
+	Time     time.Time `json:"time"`
+}
+
+// newServeEvent creates a serve event without the colors of the event message.
+func newServeEvent(e events.Event) ServeEvent {
+	var progress string
+	switch e.ProgressIndication {
+	case events.IndicationStart:
+		progress = "start"
+	case events.IndicationUpdate:
+		progress = "update"
+	case events.IndicationFinish:
+		progress = "finish"
+	}
+
+	return ServeEvent{
+		Message:  ansiRe.ReplaceAllString(e.Message, ""),
+		Icon:     e.Icon,
+		Progress: progress,
+		Group:    e.Group,
+		Verbose:  e.Verbose,
+		Time:     time.Now().UTC(),
+	}
+}
+
+// serveControl keeps the state of a served chain and the pending control actions.
+// All methods can be called with a nil value, in which case they have no effect.
+type serveControl struct {
+	// token authorizes the requests of the control actions, it's unique to each serve session.
+	token string
+
+	mu                 sync.Mutex
+	state              string
+	lastBuildErr       string
+	paused             bool
+	changedWhilePaused bool
+	reset              bool
+	rebuild            bool
+	subscribers        map[chan ServeEvent]struct{}
+}
+
+func newServeControl() *serveControl {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+
+	return &serveControl{
+		token:       hex.EncodeToString(b),
+		state:       ServeStateStarting,
+		subscribers: make(map[chan ServeEvent]struct{}),
+	}
+}
+
+func (s *serveControl) setState(state string, err error) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.state = state
+
+	switch {
+	case err != nil:
+		s.lastBuildErr = ansiRe.ReplaceAllString(err.Error(), "")
+	case state == ServeStateRunning:
+		s.lastBuildErr = ""
+	}
+}
+
+// request requests to reset the state or to rebuild the app the next time the chain is served.
+func (s *serveControl) request(reset, rebuild bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.reset = s.reset || reset
+	s.rebuild = s.rebuild || rebuild
+}
+
+// takeActions returns and clears the pending reset and rebuild actions.
+func (s *serveControl) takeActions() (reset, rebuild bool) {
+	if s == nil {
+		return false, false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reset, rebuild = s.reset, s.rebuild
+	s.reset, s.rebuild = false, false
+
+	return reset, rebuild
+}
+
+// sourceChanged returns true when the source code changes must be ignored
+// because watching is paused. The changes are remembered until it's resumed.
+func (s *serveControl) sourceChanged() bool {
+	if s == nil {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.paused {
+		s.changedWhilePaused = true
+	}
+
+	return s.paused
+}
+
+// setPaused pauses or resumes watching the source code.
+// It returns true when the source code changed while watching was paused.
+func (s *serveControl) setPaused(paused bool) (changed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.paused = paused
+
+	if !paused {
+		changed = s.changedWhilePaused
+		s.changedWhilePaused = false
+	}
+
+	return changed
+}
+
+func (s *serveControl) subscribe() chan ServeEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ch := make(chan ServeEvent, controlEventsBufferSize)
+	s.subscribers[ch] = struct{}{}
+
+	return ch
+}
+
+func (s *serveControl) unsubscribe(ch chan ServeEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.subscribers, ch)
+}
+
+// publish sends an event to the subscribers.
+// Events are dropped for the subscribers that don't read them fast enough
+// to avoid blocking the chain.
+func (s *serveControl) publish(e events.Event) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.subscribers) == 0 {
+		return
+	}
+
+	ev := newServeEvent(e)
+	for ch := range s.subscribers {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
+
+// runControlServer runs the HTTP server of the serve control API.
+// The token of the session is saved in the chain save directory, only the
+// programs that can read it can call the control actions.
+func (c *Chain) runControlServer(ctx context.Context, addr string) error {
+	savePath, err := c.chainSavePath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(savePath, 0o700); err != nil {
+		return err
+	}
+
+	tokenPath := filepath.Join(savePath, controlTokenFile)
+	if err := os.WriteFile(tokenPath, []byte(c.control.token), 0o600); err != nil {
+		return err
+	}
+	defer os.Remove(tokenPath)
+
+	c.ev.Send(fmt.Sprintf("Control API: http://%s, token saved in %s", addr, tokenPath), events.Icon(icons.Earth))
+
+	return xhttp.Serve(ctx, &http.Server{
+		Addr:    addr,
+		Handler: c.controlHandler(),
+	})
+}
+
+// controlHandler returns the handler of the serve control API.
+func (c *Chain) controlHandler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/status", c.handleControl(http.MethodGet, c.controlStatus))
+	mux.HandleFunc("/accounts", c.handleControl(http.MethodGet, c.controlAccounts))
+	mux.HandleFunc("/events", c.handleControl(http.MethodGet, c.controlEvents))
+	mux.HandleFunc("/rebuild", c.handleControl(http.MethodPost, c.controlAction(func() {
+		c.control.request(false, true)
+		c.refreshServe()
+	})))
+	mux.HandleFunc("/reset", c.handleControl(http.MethodPost, c.controlAction(func() {
+		c.control.request(true, false)
+		c.refreshServe()
+	})))
+	mux.HandleFunc("/restart", c.handleControl(http.MethodPost, c.controlAction(func() {
+		c.refreshServe()
+	})))
+	mux.HandleFunc("/pause", c.handleControl(http.MethodPost, c.controlAction(func() {
+		c.control.setPaused(true)
+	})))
+	mux.HandleFunc("/resume", c.handleControl(http.MethodPost, c.controlAction(func() {
+		if c.control.setPaused(false) {
+			c.refreshServe()
+		}
+	})))
+
+	return mux
+}
+
+// handleControl checks the requests of the control API before calling h.
+// Only the requests sent to a loopback host from a loopback origin are
+// accepted, to prevent web pages from calling the API, and the requests of
+// the actions must be authorized with the token of the session.
+func (c *Chain) handleControl(method string, h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			_ = xhttp.ResponseJSON(
+				w,
+				http.StatusMethodNotAllowed,
+				xhttp.NewErrorResponse(errors.New(http.StatusText(http.StatusMethodNotAllowed))),
+			)
+			return
+		}
+
+		if !isLoopbackHost(r.Host) {
+			_ = xhttp.ResponseJSON(w, http.StatusForbidden, xhttp.NewErrorResponse(errors.New("the host must be a loopback address")))
+			return
+		}
+
+		if origin := r.Header.Get("Origin"); origin != "" {
+			if u, err := url.Parse(origin); err != nil || !isLoopbackHost(u.Host) {
+				_ = xhttp.ResponseJSON(w, http.StatusForbidden, xhttp.NewErrorResponse(errors.New("cross-origin requests are not allowed")))
+				return
+			}
+		}
+
+		if method != http.MethodGet {
+			token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if subtle.ConstantTimeCompare([]byte(token), []byte(c.control.token)) != 1 {
+				_ = xhttp.ResponseJSON(w, http.StatusUnauthorized, xhttp.NewErrorResponse(errors.New("invalid control token")))
+				return
+			}
+		}
+
+		h(w, r)
+	}
+}
+
+// isLoopbackHost returns true when a host, with an optional port, is localhost or a loopback IP.
+func isLoopbackHost(hostport string) bool {
+	host := hostport
+	if h, _, err := net.SplitHostPort(hostport); err == nil {
+		host = h
+	}
+
+	host = strings.Trim(host, "[]")
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func (c *Chain) controlAction(action func()) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		action()
+
+		_ = xhttp.ResponseJSON(w, http.StatusAccepted, c.serveStatus(context.Background(), false))
+	}
+}
+
+func (c *Chain) controlStatus(w http.ResponseWriter, r *http.Request) {
+	_ = xhttp.ResponseJSON(w, http.StatusOK, c.serveStatus(r.Context(), true))
+}
+
+func (c *Chain) controlAccounts(w http.ResponseWriter, r *http.Request) {
+	type account struct {
+		Name    string `json:"name"`
+		Address string `json:"address"`
+	}
+
+	commands, err := c.Commands(r.Context())
+	if err != nil {
+		_ = xhttp.ResponseJSON(w, http.StatusInternalServerError, xhttp.NewErrorResponse(err))
+		return
+	}
+
+	accounts, err := commands.ListAccounts(r.Context())
+	if err != nil {
+		_ = xhttp.ResponseJSON(w, http.StatusInternalServerError, xhttp.NewErrorResponse(err))
+		return
+	}
+
+	res := make([]account, len(accounts))
+	for i, a := range accounts {
+		res[i] = account{Name: a.Name, Address: a.Address}
+	}
+
+	_ = xhttp.ResponseJSON(w, http.StatusOK, res)
+}
+
+// controlEvents streams the events of the served chain as newline delimited JSON.
+func (c *Chain) controlEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		_ = xhttp.ResponseJSON(w, http.StatusInternalServerError, xhttp.NewErrorResponse(errors.New("streaming is not supported")))
+		return
+	}
+
+	ch := c.control.subscribe()
+	defer c.control.unsubscribe(ch)
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	enc := json.NewEncoder(w)
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case e := <-ch:
+			if err := enc.Encode(e); err != nil {
+				return
+			}
+
+			flusher.Flush()
+		}
+	}
+}
+
+// serveStatus returns the status of the served chain.
+// The latest block height is only requested to the node when withHeight is true.
+func (c *Chain) serveStatus(ctx context.Context, withHeight bool) ServeStatus {
+	c.control.mu.Lock()
+	status := ServeStatus{
+		State:          c.control.state,
+		Watching:       !c.control.paused,
+		LastBuildError: c.control.lastBuildErr,
+	}
+	c.control.mu.Unlock()
+
+	status.ChainID, _ = c.ID()
+
+	if withHeight && status.State == ServeStateRunning {
+		status.Height, _ = c.latestBlockHeight(ctx)
+	}
+
+	return status
+}
+
+func (c *Chain) latestBlockHeight(ctx context.Context) (int64, error) {
+	cfg, err := c.Config()
+	if err != nil {
+		return 0, err
+	}
+
+	validator, err := chainconfig.FirstValidator(cfg)
+	if err != nil {
+		return 0, err
+	}
+
+	servers, err := validator.GetServers()
+	if err != nil {
+		return 0, err
+	}
+
+	rpcAddr, err := xurl.HTTP(servers.RPC.Address)
+	if err != nil {
+		return 0, err
+	}
+
+	return tendermintrpc.New(rpcAddr).GetLatestBlockHeight(ctx)
+}
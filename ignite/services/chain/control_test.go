@@ -0,0 +1,204 @@
+package chain
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+func newControlTestServer(t *testing.T) (*Chain, *httptest.Server) {
+	t.Helper()
+
+	c := &Chain{
+		options:        chainOptions{chainID: "test-1"},
+		serveRefresher: make(chan struct{}, 1),
+		serveMu:        &sync.Mutex{},
+		control:        newServeControl(),
+	}
+
+	ts := httptest.NewServer(c.controlHandler())
+	t.Cleanup(ts.Close)
+
+	return c, ts
+}
+
+// postControl calls a control action with the token of the session.
+func postControl(c *Chain, url string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.control.token)
+
+	return http.DefaultClient.Do(req)
+}
+
+func getServeStatus(t *testing.T, res *http.Response) ServeStatus {
+	t.Helper()
+
+	defer res.Body.Close()
+
+	var status ServeStatus
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&status))
+
+	return status
+}
+
+func TestControlStatus(t *testing.T) {
+	// Arrange
+	c, ts := newControlTestServer(t)
+	c.control.setState(ServeStateFailed, errors.New(colors.Error("build failed")))
+
+	// Act
+	res, err := http.Get(ts.URL + "/status")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, ServeStatus{
+		State:          ServeStateFailed,
+		ChainID:        "test-1",
+		Watching:       true,
+		LastBuildError: "build failed",
+	}, getServeStatus(t, res))
+}
+
+func TestControlActions(t *testing.T) {
+	c, ts := newControlTestServer(t)
+
+	t.Run("method not allowed", func(t *testing.T) {
+		res, err := http.Get(ts.URL + "/rebuild")
+		require.NoError(t, err)
+		res.Body.Close()
+
+		require.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		res, err := http.Post(ts.URL+"/reset", "", nil)
+		require.NoError(t, err)
+		res.Body.Close()
+
+		require.Equal(t, http.StatusUnauthorized, res.StatusCode)
+		require.Empty(t, c.serveRefresher)
+	})
+
+	t.Run("foreign origin", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, ts.URL+"/reset", nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+c.control.token)
+		req.Header.Set("Origin", "https://example.com")
+
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		res.Body.Close()
+
+		require.Equal(t, http.StatusForbidden, res.StatusCode)
+		require.Empty(t, c.serveRefresher)
+	})
+
+	t.Run("foreign host", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, ts.URL+"/status", nil)
+		require.NoError(t, err)
+		req.Host = "attacker.example.com"
+
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		res.Body.Close()
+
+		require.Equal(t, http.StatusForbidden, res.StatusCode)
+	})
+
+	t.Run("rebuild", func(t *testing.T) {
+		res, err := postControl(c, ts.URL+"/rebuild")
+		require.NoError(t, err)
+		res.Body.Close()
+
+		require.Equal(t, http.StatusAccepted, res.StatusCode)
+
+		<-c.serveRefresher
+		reset, rebuild := c.control.takeActions()
+		require.False(t, reset)
+		require.True(t, rebuild)
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		res, err := postControl(c, ts.URL+"/reset")
+		require.NoError(t, err)
+		res.Body.Close()
+
+		<-c.serveRefresher
+		reset, rebuild := c.control.takeActions()
+		require.True(t, reset)
+		require.False(t, rebuild)
+	})
+
+	t.Run("pause and resume", func(t *testing.T) {
+		res, err := postControl(c, ts.URL+"/pause")
+		require.NoError(t, err)
+		require.False(t, getServeStatus(t, res).Watching)
+
+		// changes are ignored while watching is paused
+		c.sourceChanged(newWatchRules(nil), []string{filepath.Join(c.app.Path, "x/blog/keeper.go")})
+		require.Empty(t, c.serveRefresher)
+
+		res, err = postControl(c, ts.URL+"/resume")
+		require.NoError(t, err)
+		require.True(t, getServeStatus(t, res).Watching)
+
+		// the changes made while paused are applied when resumed
+		<-c.serveRefresher
+	})
+
+	t.Run("pending restart", func(t *testing.T) {
+		// the actions don't block when a refresh is already pending
+		for i := 0; i < 2; i++ {
+			res, err := postControl(c, ts.URL+"/restart")
+			require.NoError(t, err)
+			res.Body.Close()
+		}
+
+		<-c.serveRefresher
+		require.Empty(t, c.serveRefresher)
+	})
+}
+
+func TestControlEvents(t *testing.T) {
+	// Arrange
+	c, ts := newControlTestServer(t)
+
+	bus := events.NewBus()
+	defer bus.Stop()
+	defer bus.Hook(c.control.publish)()
+
+	res, err := http.Get(ts.URL + "/events")
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	require.Equal(t, "application/x-ndjson", res.Header.Get("Content-Type"))
+
+	// Act
+	bus.Send(colors.Info("Building..."), events.ProgressStart(), events.Icon("*"))
+
+	// Assert
+	require.Equal(t, colors.Info("Building..."), (<-bus.Events()).Message)
+
+	var e ServeEvent
+	line, err := bufio.NewReader(res.Body).ReadBytes('\n')
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(line, &e))
+	require.Equal(t, "Building...", e.Message)
+	require.Equal(t, "start", e.Progress)
+	require.Equal(t, "*", e.Icon)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +12,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	chainconfig "github.com/ignite/cli/ignite/config/chain"
+	"github.com/ignite/cli/ignite/pkg/cache"
 	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
 	"github.com/ignite/cli/ignite/pkg/cosmosfaucet"
 	"github.com/ignite/cli/ignite/pkg/xurl"
@@ -26,6 +28,10 @@ var (
 
 var envAPIAddress = os.Getenv("API_ADDRESS")
 
+// faucetStoragePath is the path of the faucet storage file relative to the chain home.
+// the storage is kept in the home so the transfer limits are reset when the chain is reset.
+var faucetStoragePath = filepath.Join("faucet", "grants.db")
+
 // Faucet returns the faucet for the chain or an error if the faucet
 // configuration is wrong or not configured (not enabled) at all.
 func (c *Chain) Faucet(ctx context.Context) (cosmosfaucet.Faucet, error) {
@@ -77,10 +83,34 @@ func (c *Chain) Faucet(ctx context.Context) (cosmosfaucet.Faucet, error) {
 		return cosmosfaucet.Faucet{}, fmt.Errorf("invalid host api address format: %w", err)
 	}
 
+	home, err := c.Home()
+	if err != nil {
+		return cosmosfaucet.Faucet{}, err
+	}
+
+	storage, err := cache.NewStorage(filepath.Join(home, faucetStoragePath))
+	if err != nil {
+		return cosmosfaucet.Faucet{}, err
+	}
+
 	faucetOptions := []cosmosfaucet.Option{
 		cosmosfaucet.Account(*conf.Faucet.Name, "", ""),
 		cosmosfaucet.ChainID(id),
 		cosmosfaucet.OpenAPI(apiAddress),
+		cosmosfaucet.Storage(storage),
+	}
+
+	if conf.Faucet.ChallengeDifficulty > 0 {
+		pow := cosmosfaucet.NewProofOfWork(conf.Faucet.ChallengeDifficulty)
+		faucetOptions = append(faucetOptions, cosmosfaucet.Challenge(pow))
+	}
+
+	if conf.Faucet.ClientIPHeader != "" {
+		faucetOptions = append(faucetOptions, cosmosfaucet.ClientIPHeader(conf.Faucet.ClientIPHeader))
+	}
+
+	if conf.Faucet.ExemptLoopback {
+		faucetOptions = append(faucetOptions, cosmosfaucet.ExemptLoopback())
 	}
 
 	// parse coins to pass to the faucet as coins.
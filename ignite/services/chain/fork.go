@@ -0,0 +1,482 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosutil/genesis"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+const (
+	moduleBondedPool    = "bonded_tokens_pool"
+	moduleNotBondedPool = "not_bonded_tokens_pool"
+	moduleDistribution  = "distribution"
+)
+
+// Fork initializes the chain with the state of another chain.
+//
+// The source is the path or the URL of a genesis file exported from a node of
+// the chain with the `export` command of the app. URLs of JSON files or
+// tarballs are downloaded.
+//
+// The validator set of the forked state is replaced by the validators from
+// the config, and the config accounts are added to the state. The balances
+// of the config accounts that already exist in the forked state are increased.
+// The tokens delegated to the replaced validators are removed from the state.
+// The genesis migrations of the app are applied to the forked state.
+func (c *Chain) Fork(ctx context.Context, source string) error {
+	c.ev.Send(fmt.Sprintf("Fetching the chain state from %s...", source), events.ProgressUpdate())
+
+	data, err := fetchGenesis(ctx, source)
+	if err != nil {
+		return fmt.Errorf("failed to fetch the forked chain state: %w", err)
+	}
+
+	var forked map[string]interface{}
+	if err := decodeJSON(data, &forked); err != nil {
+		return fmt.Errorf("invalid forked genesis: %w", err)
+	}
+
+	// initialize the chain to create the genesis with the config accounts and validators
+	if err := c.Init(ctx, InitArgsAll); err != nil {
+		return err
+	}
+
+	// the forked state is saved like an exported state, so it's imported again
+	// when the chain is served after a source change
+	exportedGenesisPath, err := c.exportedGenesisPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(exportedGenesisPath), 0o700); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(exportedGenesisPath, data, 0o644); err != nil {
+		return err
+	}
+
+	// the forked state uses the state schema of the other chain, unlike the
+	// genesis of a new chain for which the migrations are marked as applied
+	if err := c.saveAppliedGenesisMigrations(nil); err != nil {
+		return err
+	}
+
+	if err := c.migrateGenesis(ctx, exportedGenesisPath); err != nil {
+		return err
+	}
+
+	c.ev.Send("Replacing the validators of the forked state...", events.ProgressUpdate())
+
+	if data, err = os.ReadFile(exportedGenesisPath); err != nil {
+		return err
+	}
+
+	if err := decodeJSON(data, &forked); err != nil {
+		return fmt.Errorf("invalid migrated genesis: %w", err)
+	}
+
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	if data, err = os.ReadFile(genesisPath); err != nil {
+		return err
+	}
+
+	var local map[string]interface{}
+	if err := decodeJSON(data, &local); err != nil {
+		return err
+	}
+
+	if err := forkGenesis(forked, local); err != nil {
+		return err
+	}
+
+	if data, err = json.MarshalIndent(forked, "", "  "); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(exportedGenesisPath, data, 0o644); err != nil {
+		return err
+	}
+
+	if err := c.importChainState(); err != nil {
+		return err
+	}
+
+	cfg, err := c.Config()
+	if err != nil {
+		return err
+	}
+
+	// the genesis values defined in the config have priority over the forked state
+	addresses, err := c.accountAddresses(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	if err := c.updateGenesisFromConfig(cfg, addresses); err != nil {
+		return err
+	}
+
+	return c.shareGenesis(cfg)
+}
+
+// fetchGenesis returns the content of a genesis file from a path or a URL.
+// The live state of a chain can't be retrieved from the RPC of a node, which
+// only serves the genesis the chain was started with.
+func fetchGenesis(ctx context.Context, source string) ([]byte, error) {
+	if !xurl.IsHTTP(source) {
+		return os.ReadFile(source)
+	}
+
+	u, err := url.Parse(source)
+	if err != nil {
+		return nil, err
+	}
+
+	if p := strings.ToLower(u.Path); !strings.HasSuffix(p, ".json") &&
+		!strings.HasSuffix(p, ".tar.gz") &&
+		!strings.HasSuffix(p, ".tgz") {
+		return nil, fmt.Errorf(
+			"%s is not the URL of a JSON file or a tarball, the state of a chain must be exported with the `export` command of the app on one of its nodes",
+			source,
+		)
+	}
+
+	tmpDir, err := os.MkdirTemp("", "genesis")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	g, err := genesis.FromURL(ctx, source, filepath.Join(tmpDir, "genesis.json"))
+	if err != nil {
+		return nil, err
+	}
+	defer g.Close()
+
+	return g.Bytes()
+}
+
+// forkGenesis replaces the validators of a forked genesis with the validators
+// of a local genesis, and adds the local accounts and balances to it.
+func forkGenesis(forked, local map[string]interface{}) error {
+	forkedState, ok := forked["app_state"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("invalid forked genesis: the app state is missing")
+	}
+
+	localState, ok := local["app_state"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("invalid genesis: the app state is missing")
+	}
+
+	if err := checkBondDenom(forkedState, localState); err != nil {
+		return err
+	}
+
+	forked["chain_id"] = local["chain_id"]
+	forked["genesis_time"] = local["genesis_time"]
+
+	// the validators are initialized from the gentxs of the local genesis
+	delete(forked, "validators")
+
+	setFields(forkedState, "staking", map[string]interface{}{
+		"validators":            []interface{}{},
+		"delegations":           []interface{}{},
+		"unbonding_delegations": []interface{}{},
+		"redelegations":         []interface{}{},
+		"last_validator_powers": []interface{}{},
+		"last_total_power":      "0",
+	})
+
+	setFields(forkedState, "slashing", map[string]interface{}{
+		"signing_infos": []interface{}{},
+		"missed_blocks": []interface{}{},
+	})
+
+	setFields(forkedState, "distribution", map[string]interface{}{
+		"outstanding_rewards":               []interface{}{},
+		"validator_accumulated_commissions": []interface{}{},
+		"validator_historical_rewards":      []interface{}{},
+		"validator_current_rewards":         []interface{}{},
+		"delegator_starting_infos":          []interface{}{},
+		"validator_slash_events":            []interface{}{},
+		"previous_proposer":                 "",
+	})
+
+	forkedState["genutil"] = map[string]interface{}{
+		"gen_txs": jsonField(localState, "genutil", "gen_txs"),
+	}
+
+	if err := forkBalances(forkedState, localState); err != nil {
+		return err
+	}
+
+	return forkAccounts(forkedState, localState)
+}
+
+// checkBondDenom checks that the validators are bonded with the bond denom of the forked state.
+func checkBondDenom(forkedState, localState map[string]interface{}) error {
+	bondDenom, _ := jsonField(forkedState, "staking", "params", "bond_denom").(string)
+	if bondDenom == "" {
+		return nil
+	}
+
+	gentxs, _ := jsonField(localState, "genutil", "gen_txs").([]interface{})
+	for _, tx := range gentxs {
+		msgs, _ := jsonField(tx, "body", "messages").([]interface{})
+		for _, msg := range msgs {
+			denom, _ := jsonField(msg, "value", "denom").(string)
+			if denom != "" && denom != bondDenom {
+				return fmt.Errorf(
+					"the validators must be bonded with the %q bond denom of the forked state instead of %q, change the bonded amount of the validators in the config",
+					bondDenom,
+					denom,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+// forkBalances removes the balances of the staking pools, because the forked
+// delegations are removed, and adjusts the distribution module balance to the
+// community pool. The local balances are added to the forked ones.
+func forkBalances(forkedState, localState map[string]interface{}) error {
+	bank, ok := forkedState["bank"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	moduleAddrs := moduleAccountAddresses(forkedState)
+
+	var (
+		addrs    []string
+		balances = make(map[string]map[string]interface{})
+	)
+
+	// the distribution module balance must match the community pool after removing the validator rewards
+	if addr := moduleAddrs[moduleDistribution]; addr != "" {
+		pool := jsonField(forkedState, "distribution", "fee_pool", "community_pool")
+		balances[addr] = map[string]interface{}{
+			"address": addr,
+			"coins":   truncateDecCoins(asSlice(pool)),
+		}
+		addrs = append(addrs, addr)
+	}
+
+	for _, b := range asSlice(bank["balances"]) {
+		balance, ok := b.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		addr, _ := balance["address"].(string)
+		if _, ok := balances[addr]; ok ||
+			addr == moduleAddrs[moduleBondedPool] ||
+			addr == moduleAddrs[moduleNotBondedPool] {
+			continue
+		}
+
+		balances[addr] = balance
+		addrs = append(addrs, addr)
+	}
+
+	for _, b := range asSlice(jsonField(localState, "bank", "balances")) {
+		balance, ok := b.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		addr, _ := balance["address"].(string)
+		existing, ok := balances[addr]
+		if !ok {
+			balances[addr] = balance
+			addrs = append(addrs, addr)
+			continue
+		}
+
+		coins, err := addCoins(asSlice(existing["coins"]), asSlice(balance["coins"]))
+		if err != nil {
+			return fmt.Errorf("invalid balance of account %s: %w", addr, err)
+		}
+
+		existing["coins"] = coins
+	}
+
+	merged := make([]interface{}, len(addrs))
+	for i, addr := range addrs {
+		merged[i] = balances[addr]
+	}
+
+	bank["balances"] = merged
+
+	// an empty supply is calculated from the balances when the chain starts
+	bank["supply"] = []interface{}{}
+
+	return nil
+}
+
+// forkAccounts adds the local accounts that don't exist in the forked state.
+func forkAccounts(forkedState, localState map[string]interface{}) error {
+	auth, ok := forkedState["auth"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	accounts := asSlice(auth["accounts"])
+
+	exists := make(map[string]bool)
+	for _, a := range accounts {
+		exists[accountAddress(a)] = true
+	}
+
+	for _, a := range asSlice(jsonField(localState, "auth", "accounts")) {
+		if !exists[accountAddress(a)] {
+			accounts = append(accounts, a)
+		}
+	}
+
+	auth["accounts"] = accounts
+
+	return nil
+}
+
+// moduleAccountAddresses returns the addresses of the module accounts indexed by module name.
+func moduleAccountAddresses(state map[string]interface{}) map[string]string {
+	addrs := make(map[string]string)
+	for _, a := range asSlice(jsonField(state, "auth", "accounts")) {
+		name, _ := jsonField(a, "name").(string)
+		addr, _ := jsonField(a, "base_account", "address").(string)
+		if name != "" && addr != "" {
+			addrs[name] = addr
+		}
+	}
+
+	return addrs
+}
+
+// accountAddress returns the address of a genesis account of any type.
+func accountAddress(account interface{}) string {
+	paths := [][]string{
+		{"address"},
+		{"base_account", "address"},
+		{"base_vesting_account", "base_account", "address"},
+	}
+
+	for _, p := range paths {
+		if addr, ok := jsonField(account, p...).(string); ok {
+			return addr
+		}
+	}
+
+	return ""
+}
+
+// truncateDecCoins converts decimal coins to coins by truncating the decimal part of the amounts.
+func truncateDecCoins(decCoins []interface{}) []interface{} {
+	coins := []interface{}{}
+	for _, c := range decCoins {
+		denom, _ := jsonField(c, "denom").(string)
+		amount, _ := jsonField(c, "amount").(string)
+		amount, _, _ = strings.Cut(amount, ".")
+
+		if n, ok := new(big.Int).SetString(amount, 10); ok && n.Sign() > 0 {
+			coins = append(coins, map[string]interface{}{
+				"denom":  denom,
+				"amount": n.String(),
+			})
+		}
+	}
+
+	return coins
+}
+
+// addCoins adds two lists of coins keeping the order of the denoms.
+func addCoins(a, b []interface{}) ([]interface{}, error) {
+	var (
+		denoms  []string
+		amounts = make(map[string]*big.Int)
+	)
+
+	for _, c := range append(append([]interface{}{}, a...), b...) {
+		denom, _ := jsonField(c, "denom").(string)
+		amount, _ := jsonField(c, "amount").(string)
+
+		n, ok := new(big.Int).SetString(amount, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid amount %q of denom %q", amount, denom)
+		}
+
+		if sum, ok := amounts[denom]; ok {
+			sum.Add(sum, n)
+			continue
+		}
+
+		denoms = append(denoms, denom)
+		amounts[denom] = n
+	}
+
+	coins := make([]interface{}, len(denoms))
+	for i, d := range denoms {
+		coins[i] = map[string]interface{}{
+			"denom":  d,
+			"amount": amounts[d].String(),
+		}
+	}
+
+	return coins, nil
+}
+
+// setFields sets the fields of a module state when the module exists in the app state.
+func setFields(state map[string]interface{}, module string, fields map[string]interface{}) {
+	m, ok := state[module].(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	for k, v := range fields {
+		m[k] = v
+	}
+}
+
+// jsonField returns the value of a nested object field or nil when the field doesn't exist.
+func jsonField(v interface{}, path ...string) interface{} {
+	for _, p := range path {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		v = obj[p]
+	}
+
+	return v
+}
+
+func decodeJSON(data []byte, v interface{}) error {
+	// numbers are decoded as strings to keep the precision of big integers
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	return d.Decode(v)
+}
+
+func asSlice(v interface{}) []interface{} {
+	s, _ := v.([]interface{})
+	return s
+}
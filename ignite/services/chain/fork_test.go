@@ -0,0 +1,198 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	forkedGenesis = `{
+  "chain_id": "mainnet-1",
+  "genesis_time": "2021-01-01T00:00:00Z",
+  "initial_height": "1001",
+  "validators": [{"address": "val", "power": "10"}],
+  "app_state": {
+    "auth": {
+      "accounts": [
+        {"@type": "/cosmos.auth.v1beta1.BaseAccount", "address": "cosmos1alice", "sequence": "7"},
+        {"@type": "/cosmos.auth.v1beta1.ModuleAccount", "base_account": {"address": "cosmos1bonded"}, "name": "bonded_tokens_pool"},
+        {"@type": "/cosmos.auth.v1beta1.ModuleAccount", "base_account": {"address": "cosmos1notbonded"}, "name": "not_bonded_tokens_pool"},
+        {"@type": "/cosmos.auth.v1beta1.ModuleAccount", "base_account": {"address": "cosmos1distr"}, "name": "distribution"}
+      ]
+    },
+    "bank": {
+      "balances": [
+        {"address": "cosmos1alice", "coins": [{"denom": "uatom", "amount": "100000000000000000000000"}]},
+        {"address": "cosmos1bonded", "coins": [{"denom": "uatom", "amount": "500"}]},
+        {"address": "cosmos1notbonded", "coins": [{"denom": "uatom", "amount": "20"}]},
+        {"address": "cosmos1distr", "coins": [{"denom": "uatom", "amount": "75"}]}
+      ],
+      "supply": [{"denom": "uatom", "amount": "100000000000000000000595"}]
+    },
+    "distribution": {
+      "fee_pool": {"community_pool": [{"denom": "uatom", "amount": "42.750000000000000000"}]},
+      "outstanding_rewards": [{"validator_address": "cosmosvaloper1val"}],
+      "previous_proposer": "cosmosvalcons1val"
+    },
+    "genutil": {"gen_txs": [{"body": {}}]},
+    "slashing": {"signing_infos": [{"address": "cosmosvalcons1val"}], "missed_blocks": []},
+    "staking": {
+      "params": {"bond_denom": "uatom"},
+      "validators": [{"operator_address": "cosmosvaloper1val"}],
+      "delegations": [{"delegator_address": "cosmos1alice"}],
+      "last_total_power": "10",
+      "exported": true
+    }
+  }
+}`
+
+	localGenesis = `{
+  "chain_id": "fork-1",
+  "genesis_time": "2023-01-01T00:00:00Z",
+  "app_state": {
+    "auth": {
+      "accounts": [
+        {"@type": "/cosmos.auth.v1beta1.BaseAccount", "address": "cosmos1alice", "sequence": "0"},
+        {"@type": "/cosmos.auth.v1beta1.BaseAccount", "address": "cosmos1bob", "sequence": "0"}
+      ]
+    },
+    "bank": {
+      "balances": [
+        {"address": "cosmos1alice", "coins": [{"denom": "token", "amount": "5"}, {"denom": "uatom", "amount": "10"}]},
+        {"address": "cosmos1bob", "coins": [{"denom": "uatom", "amount": "200"}]}
+      ]
+    },
+    "genutil": {
+      "gen_txs": [
+        {"body": {"messages": [{"@type": "/cosmos.staking.v1beta1.MsgCreateValidator", "value": {"denom": "uatom", "amount": "100"}}]}}
+      ]
+    }
+  }
+}`
+)
+
+func TestForkGenesis(t *testing.T) {
+	// Arrange
+	var forked, local map[string]interface{}
+	require.NoError(t, decodeJSON([]byte(forkedGenesis), &forked))
+	require.NoError(t, decodeJSON([]byte(localGenesis), &local))
+
+	// Act
+	err := forkGenesis(forked, local)
+
+	// Assert
+	require.NoError(t, err)
+
+	data, err := json.Marshal(forked)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+  "chain_id": "fork-1",
+  "genesis_time": "2023-01-01T00:00:00Z",
+  "initial_height": "1001",
+  "app_state": {
+    "auth": {
+      "accounts": [
+        {"@type": "/cosmos.auth.v1beta1.BaseAccount", "address": "cosmos1alice", "sequence": "7"},
+        {"@type": "/cosmos.auth.v1beta1.ModuleAccount", "base_account": {"address": "cosmos1bonded"}, "name": "bonded_tokens_pool"},
+        {"@type": "/cosmos.auth.v1beta1.ModuleAccount", "base_account": {"address": "cosmos1notbonded"}, "name": "not_bonded_tokens_pool"},
+        {"@type": "/cosmos.auth.v1beta1.ModuleAccount", "base_account": {"address": "cosmos1distr"}, "name": "distribution"},
+        {"@type": "/cosmos.auth.v1beta1.BaseAccount", "address": "cosmos1bob", "sequence": "0"}
+      ]
+    },
+    "bank": {
+      "balances": [
+        {"address": "cosmos1distr", "coins": [{"denom": "uatom", "amount": "42"}]},
+        {"address": "cosmos1alice", "coins": [{"denom": "uatom", "amount": "100000000000000000000010"}, {"denom": "token", "amount": "5"}]},
+        {"address": "cosmos1bob", "coins": [{"denom": "uatom", "amount": "200"}]}
+      ],
+      "supply": []
+    },
+    "distribution": {
+      "fee_pool": {"community_pool": [{"denom": "uatom", "amount": "42.750000000000000000"}]},
+      "outstanding_rewards": [],
+      "validator_accumulated_commissions": [],
+      "validator_historical_rewards": [],
+      "validator_current_rewards": [],
+      "delegator_starting_infos": [],
+      "validator_slash_events": [],
+      "previous_proposer": ""
+    },
+    "genutil": {
+      "gen_txs": [
+        {"body": {"messages": [{"@type": "/cosmos.staking.v1beta1.MsgCreateValidator", "value": {"denom": "uatom", "amount": "100"}}]}}
+      ]
+    },
+    "slashing": {"signing_infos": [], "missed_blocks": []},
+    "staking": {
+      "params": {"bond_denom": "uatom"},
+      "validators": [],
+      "delegations": [],
+      "unbonding_delegations": [],
+      "redelegations": [],
+      "last_validator_powers": [],
+      "last_total_power": "0",
+      "exported": true
+    }
+  }
+}`, string(data))
+}
+
+func TestForkGenesisWithInvalidBondDenom(t *testing.T) {
+	// Arrange
+	var forked, local map[string]interface{}
+	require.NoError(t, decodeJSON([]byte(forkedGenesis), &forked))
+	require.NoError(t, decodeJSON([]byte(localGenesis), &local))
+
+	gentx := `{"body": {"messages": [{"value": {"denom": "stake", "amount": "100"}}]}}`
+	var tx interface{}
+	require.NoError(t, decodeJSON([]byte(gentx), &tx))
+	local["app_state"].(map[string]interface{})["genutil"] = map[string]interface{}{
+		"gen_txs": []interface{}{tx},
+	}
+
+	// Act
+	err := forkGenesis(forked, local)
+
+	// Assert
+	require.ErrorContains(t, err, `"uatom" bond denom of the forked state instead of "stake"`)
+}
+
+func TestFetchGenesis(t *testing.T) {
+	genesis := []byte(`{"chain_id":"mainnet-1","app_state":{}}`)
+
+	t.Run("file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "genesis.json")
+		require.NoError(t, os.WriteFile(path, genesis, 0o644))
+
+		data, err := fetchGenesis(context.Background(), path)
+
+		require.NoError(t, err)
+		require.Equal(t, genesis, data)
+	})
+
+	t.Run("url", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "/exported.json", r.URL.Path)
+			_, _ = w.Write(genesis)
+		}))
+		defer ts.Close()
+
+		data, err := fetchGenesis(context.Background(), ts.URL+"/exported.json")
+
+		require.NoError(t, err)
+		require.JSONEq(t, string(genesis), string(data))
+	})
+
+	t.Run("rpc", func(t *testing.T) {
+		_, err := fetchGenesis(context.Background(), "https://rpc.example.com:443")
+
+		require.ErrorContains(t, err, "the state of a chain must be exported with the `export` command of the app")
+	})
+}
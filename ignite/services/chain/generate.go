@@ -98,7 +98,28 @@ func (c *Chain) generateFromConfig(ctx context.Context, cacheStorage cache.Stora
 		return err
 	}
 
-	// Additional code generation targets
+	// Generate proto based code for Go and optionally for any optional targets
+	return c.Generate(ctx, cacheStorage, GenerateGo(), clientTargets(conf, generateClients)...)
+}
+
+// generateClients makes code generation from proto files for the clients defined in the config.
+func (c *Chain) generateClients(ctx context.Context, cacheStorage cache.Storage) error {
+	conf, err := c.Config()
+	if err != nil {
+		return err
+	}
+
+	targets := clientTargets(conf, true)
+	if len(targets) == 0 {
+		return nil
+	}
+
+	return c.Generate(ctx, cacheStorage, targets[0], targets[1:]...)
+}
+
+// clientTargets returns the code generation targets for the clients defined in the config.
+// The OpenAPI target is always returned when it's configured.
+func clientTargets(conf *chainconfig.Config, generateClients bool) []GenerateTarget {
 	var targets []GenerateTarget
 
 	if generateClients {
@@ -124,8 +145,7 @@ func (c *Chain) generateFromConfig(ctx context.Context, cacheStorage cache.Stora
 		targets = append(targets, GenerateOpenAPI())
 	}
 
-	// Generate proto based code for Go and optionally for any optional targets
-	return c.Generate(ctx, cacheStorage, GenerateGo(), targets...)
+	return targets
 }
 
 // Generate makes code generation from proto files for given target and additionalTargets.
@@ -0,0 +1,352 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	chainconfig "github.com/ignite/cli/ignite/config/chain"
+)
+
+// maxGenesisKeysSuggested is the max number of valid keys listed when a genesis key of the config is not valid.
+const maxGenesisKeysSuggested = 10
+
+// errGenesisAccountNotInitialized is returned when a genesis template uses an account that is not initialized yet.
+var errGenesisAccountNotInitialized = errors.New("account is not initialized")
+
+// genesisTemplate renders the templated string values of the genesis defined in the config.
+//
+// Templates use the Go template syntax and the following functions:
+//
+//   - account "name": the address of an account defined in the config.
+//   - now: the current time in RFC 3339 format.
+//   - fromNow "duration": the current time plus a duration in RFC 3339 format.
+//   - unixFromNow "duration": the current time plus a duration as a Unix timestamp in seconds.
+//   - duration "duration": the duration in seconds with the "s" suffix used for the module params.
+//
+// Durations use the Go format, for example "72h" or "-30m".
+type genesisTemplate struct {
+	now      time.Time
+	accounts map[string]string
+}
+
+// newGenesisTemplate creates a genesis template renderer.
+// The accounts map contains the addresses of the accounts by name.
+func newGenesisTemplate(accounts map[string]string) genesisTemplate {
+	return genesisTemplate{
+		now:      time.Now().UTC(),
+		accounts: accounts,
+	}
+}
+
+// render returns a copy of the genesis where the templated string values are rendered.
+// The values that use accounts that are not initialized are omitted and pending is true.
+func (t genesisTemplate) render(genesis map[string]interface{}) (rendered map[string]interface{}, pending bool, err error) {
+	v, _, err := t.renderValue(genesis, "", &pending)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return v.(map[string]interface{}), pending, nil
+}
+
+// renderValue renders a genesis value, ok is false when the value must be omitted.
+func (t genesisTemplate) renderValue(v interface{}, path string, pending *bool) (_ interface{}, ok bool, err error) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, value := range v {
+			r, ok, err := t.renderValue(value, joinGenesisPath(path, k), pending)
+			if err != nil {
+				return nil, false, err
+			}
+
+			if ok {
+				m[k] = r
+			}
+		}
+
+		return m, true, nil
+	case []interface{}:
+		var (
+			s           = make([]interface{}, 0, len(v))
+			itemPending bool
+		)
+		for i, value := range v {
+			r, _, err := t.renderValue(value, fmt.Sprintf("%s[%d]", path, i), &itemPending)
+			if err != nil {
+				return nil, false, err
+			}
+
+			s = append(s, r)
+		}
+
+		// lists are replaced when they are merged so the list is omitted
+		// until all the values can be rendered
+		if itemPending {
+			*pending = true
+			return nil, false, nil
+		}
+
+		return s, true, nil
+	case string:
+		if !strings.Contains(v, "{{") {
+			return v, true, nil
+		}
+
+		r, err := t.renderString(v)
+		if errors.Is(err, errGenesisAccountNotInitialized) {
+			*pending = true
+			return nil, false, nil
+		}
+
+		if err != nil {
+			return nil, false, &chainconfig.ValidationError{
+				Message: fmt.Sprintf("invalid template in genesis key '%s': %s", path, err),
+			}
+		}
+
+		return r, true, nil
+	default:
+		return v, true, nil
+	}
+}
+
+func (t genesisTemplate) renderString(s string) (string, error) {
+	tpl, err := template.New("").Option("missingkey=error").Funcs(template.FuncMap{
+		"account": t.account,
+		"now": func() string {
+			return t.now.Format(time.RFC3339)
+		},
+		"fromNow":     t.fromNow,
+		"unixFromNow": t.unixFromNow,
+		"duration":    formatDuration,
+	}).Parse(s)
+	if err != nil {
+		return "", err
+	}
+
+	var b bytes.Buffer
+	if err := tpl.Execute(&b, nil); err != nil {
+		// unwrap the account error to be able to render the value later
+		if errors.Is(err, errGenesisAccountNotInitialized) {
+			return "", errGenesisAccountNotInitialized
+		}
+
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+func (t genesisTemplate) account(name string) (string, error) {
+	if t.accounts == nil {
+		return "", errGenesisAccountNotInitialized
+	}
+
+	addr, ok := t.accounts[name]
+	if !ok {
+		return "", fmt.Errorf("account %q is not defined in the config", name)
+	}
+
+	return addr, nil
+}
+
+func (t genesisTemplate) fromNow(d string) (string, error) {
+	tm, err := t.timeFromNow(d)
+	if err != nil {
+		return "", err
+	}
+
+	return tm.Format(time.RFC3339), nil
+}
+
+func (t genesisTemplate) unixFromNow(d string) (string, error) {
+	tm, err := t.timeFromNow(d)
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatInt(tm.Unix(), 10), nil
+}
+
+func (t genesisTemplate) timeFromNow(d string) (time.Time, error) {
+	duration, err := time.ParseDuration(d)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return t.now.Add(duration), nil
+}
+
+func formatDuration(d string) (string, error) {
+	duration, err := time.ParseDuration(d)
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatFloat(duration.Seconds(), 'f', -1, 64) + "s", nil
+}
+
+// validateGenesisKeys checks that the keys of the genesis defined in the config
+// exist in the default genesis of the app.
+//
+// Only the keys of objects are checked, the values of lists and the values
+// that are null in the default genesis can have any structure.
+func validateGenesisKeys(genesis, defaultGenesis map[string]interface{}) error {
+	var errs []string
+
+	walkGenesisKeys(genesis, defaultGenesis, "", func(path string, keys []string) {
+		msg := fmt.Sprintf("genesis key '%s' is not defined by the app", path)
+		if len(keys) > 0 && len(keys) <= maxGenesisKeysSuggested {
+			msg += fmt.Sprintf(", expected one of: %s", strings.Join(keys, ", "))
+		}
+
+		errs = append(errs, msg)
+	})
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	sort.Strings(errs)
+
+	return &chainconfig.ValidationError{Message: strings.Join(errs, "\n")}
+}
+
+func walkGenesisKeys(genesis, defaultGenesis map[string]interface{}, path string, invalid func(path string, keys []string)) {
+	for k, v := range genesis {
+		keyPath := joinGenesisPath(path, k)
+
+		defaultValue, ok := defaultGenesis[k]
+		if !ok {
+			keys := make([]string, 0, len(defaultGenesis))
+			for dk := range defaultGenesis {
+				keys = append(keys, dk)
+			}
+
+			sort.Strings(keys)
+			invalid(keyPath, keys)
+
+			continue
+		}
+
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if dm, ok := defaultValue.(map[string]interface{}); ok {
+			walkGenesisKeys(m, dm, keyPath, invalid)
+		}
+	}
+}
+
+func joinGenesisPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+// validateGenesisConfig checks the keys of the genesis defined in the config.
+// It must be called right after the chain is initialized to compare the keys
+// with the default genesis created by the app.
+func (c *Chain) validateGenesisConfig(cfg *chainconfig.Config) error {
+	if len(cfg.Genesis) == 0 {
+		return nil
+	}
+
+	path, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var defaultGenesis map[string]interface{}
+	if err := decodeJSON(data, &defaultGenesis); err != nil {
+		return err
+	}
+
+	return validateGenesisKeys(cfg.Genesis, defaultGenesis)
+}
+
+// updateGenesisFromConfig renders the genesis defined in the config and merges it into the genesis of the chain.
+// The values that use account addresses are not merged when accounts is nil.
+func (c *Chain) updateGenesisFromConfig(cfg *chainconfig.Config, accounts map[string]string) error {
+	if cfg.Genesis == nil {
+		return nil
+	}
+
+	genesis, _, err := newGenesisTemplate(accounts).render(cfg.Genesis)
+	if err != nil {
+		return err
+	}
+
+	return c.UpdateGenesisFile(genesis)
+}
+
+// accountAddresses returns the addresses of the accounts defined in the config by name.
+func (c *Chain) accountAddresses(ctx context.Context, cfg *chainconfig.Config) (map[string]string, error) {
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make(map[string]string)
+	for _, account := range cfg.Accounts {
+		switch {
+		case account.Module != "":
+			continue
+		case account.Address != "":
+			addresses[account.Name] = account.Address
+		default:
+			a, err := commands.ShowAccount(ctx, account.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			addresses[account.Name] = a.Address
+		}
+	}
+
+	var prefix string
+	for _, account := range cfg.Accounts {
+		if account.Module == "" {
+			continue
+		}
+
+		if prefix == "" {
+			if prefix, err = appAddressPrefix(ctx, commands); err != nil {
+				return nil, err
+			}
+		}
+
+		address, err := moduleAccountAddress(account, prefix)
+		if err != nil {
+			return nil, err
+		}
+
+		addresses[account.Name] = address
+	}
+
+	return addresses, nil
+}
+
+// genesisUsesAccounts checks if the genesis defined in the config uses account addresses.
+func genesisUsesAccounts(genesis map[string]interface{}) bool {
+	_, pending, _ := newGenesisTemplate(nil).render(genesis)
+	return pending
+}
@@ -0,0 +1,192 @@
+package chain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	chainconfig "github.com/ignite/cli/ignite/config/chain"
+)
+
+func TestGenesisTemplateRender(t *testing.T) {
+	now := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	genesis := map[string]interface{}{
+		"genesis_time": "{{ now }}",
+		"app_state": map[string]interface{}{
+			"gov": map[string]interface{}{
+				"voting_params": map[string]interface{}{
+					"voting_period": `{{ duration "10m" }}`,
+				},
+			},
+			"auth": map[string]interface{}{
+				"accounts": []interface{}{
+					map[string]interface{}{
+						"address":  `{{ account "alice" }}`,
+						"end_time": `{{ unixFromNow "24h" }}`,
+					},
+				},
+			},
+			"upgrade": map[string]interface{}{
+				"time":      `{{ fromNow "-1h" }}`,
+				"authority": `{{ account "bob" }}`,
+			},
+		},
+	}
+
+	t.Run("with accounts", func(t *testing.T) {
+		// Arrange
+		tpl := genesisTemplate{
+			now:      now,
+			accounts: map[string]string{"alice": "cosmos1alice", "bob": "cosmos1bob"},
+		}
+
+		// Act
+		rendered, pending, err := tpl.render(genesis)
+
+		// Assert
+		require.NoError(t, err)
+		require.False(t, pending)
+		require.Equal(t, map[string]interface{}{
+			"genesis_time": "2023-01-02T03:04:05Z",
+			"app_state": map[string]interface{}{
+				"gov": map[string]interface{}{
+					"voting_params": map[string]interface{}{
+						"voting_period": "600s",
+					},
+				},
+				"auth": map[string]interface{}{
+					"accounts": []interface{}{
+						map[string]interface{}{
+							"address":  "cosmos1alice",
+							"end_time": "1672715045",
+						},
+					},
+				},
+				"upgrade": map[string]interface{}{
+					"time":      "2023-01-02T02:04:05Z",
+					"authority": "cosmos1bob",
+				},
+			},
+		}, rendered)
+	})
+
+	t.Run("without accounts", func(t *testing.T) {
+		// Arrange
+		tpl := genesisTemplate{now: now}
+
+		// Act
+		rendered, pending, err := tpl.render(genesis)
+
+		// Assert
+		require.NoError(t, err)
+		require.True(t, pending)
+		require.Equal(t, map[string]interface{}{
+			"genesis_time": "2023-01-02T03:04:05Z",
+			"app_state": map[string]interface{}{
+				"gov": map[string]interface{}{
+					"voting_params": map[string]interface{}{
+						"voting_period": "600s",
+					},
+				},
+				"auth": map[string]interface{}{},
+				"upgrade": map[string]interface{}{
+					"time": "2023-01-02T02:04:05Z",
+				},
+			},
+		}, rendered)
+	})
+}
+
+func TestGenesisTemplateRenderWithInvalidTemplate(t *testing.T) {
+	tests := []struct {
+		name, value, err string
+	}{
+		{
+			name:  "unknown account",
+			value: `{{ account "carol" }}`,
+			err:   `account "carol" is not defined in the config`,
+		},
+		{
+			name:  "invalid duration",
+			value: `{{ fromNow "tomorrow" }}`,
+			err:   `invalid duration "tomorrow"`,
+		},
+		{
+			name:  "unknown function",
+			value: `{{ yesterday }}`,
+			err:   `function "yesterday" not defined`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tpl := genesisTemplate{accounts: map[string]string{"alice": "cosmos1alice"}}
+
+			_, _, err := tpl.render(map[string]interface{}{"key": tt.value})
+
+			var validationErr *chainconfig.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			require.ErrorContains(t, err, "invalid template in genesis key 'key'")
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestValidateGenesisKeys(t *testing.T) {
+	defaultGenesis := map[string]interface{}{
+		"chain_id": "test-1",
+		"app_state": map[string]interface{}{
+			"staking": map[string]interface{}{
+				"params": map[string]interface{}{
+					"bond_denom":     "stake",
+					"max_validators": 100,
+				},
+				"delegations": []interface{}{},
+			},
+			"custom": nil,
+		},
+	}
+
+	t.Run("valid keys", func(t *testing.T) {
+		err := validateGenesisKeys(map[string]interface{}{
+			"chain_id": "test-2",
+			"app_state": map[string]interface{}{
+				"staking": map[string]interface{}{
+					"params": map[string]interface{}{
+						"bond_denom": "token",
+					},
+					"delegations": []interface{}{
+						map[string]interface{}{"shares": "1"},
+					},
+				},
+				"custom": map[string]interface{}{
+					"any": "value",
+				},
+			},
+		}, defaultGenesis)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid keys", func(t *testing.T) {
+		err := validateGenesisKeys(map[string]interface{}{
+			"chainid": "test-2",
+			"app_state": map[string]interface{}{
+				"staking": map[string]interface{}{
+					"params": map[string]interface{}{
+						"bond_denomm": "token",
+					},
+				},
+			},
+		}, defaultGenesis)
+
+		var validationErr *chainconfig.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		require.Equal(t,
+			"genesis key 'app_state.staking.params.bond_denomm' is not defined by the app, expected one of: bond_denom, max_validators\n"+
+				"genesis key 'chainid' is not defined by the app, expected one of: app_state, chain_id",
+			validationErr.Message,
+		)
+	})
+}
@@ -0,0 +1,86 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+// indexerRetryDelay is the time to wait before indexing again when the indexer fails.
+const indexerRetryDelay = 3 * time.Second
+
+// runIndexer indexes the transactions of the chain served at the RPC address in the data backend.
+// The indexer keeps following the new blocks until the context is done. Errors don't stop the
+// indexer because the node is restarted when the source code changes, instead it tries to index
+// again after a delay.
+func (c *Chain) runIndexer(ctx context.Context, db adapter.Adapter, rpcAddr string) {
+	for {
+		err := c.index(ctx, db, rpcAddr)
+		if ctx.Err() != nil {
+			return
+		}
+
+		c.ev.SendError(fmt.Errorf("transaction indexer failed: %w", err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(indexerRetryDelay):
+		}
+	}
+}
+
+func (c *Chain) index(ctx context.Context, db adapter.Adapter, rpcAddr string) error {
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+
+	// Wait until the node accepts RPC requests
+	var client cosmosclient.Client
+	err = backoff.Retry(func() (err error) {
+		client, err = cosmosclient.New(
+			ctx,
+			cosmosclient.WithNodeAddress(rpcAddr),
+			cosmosclient.WithHome(home),
+			cosmosclient.WithInterfaceRegistry(cosmostxcollector.NewInterfaceRegistry()),
+		)
+		return err
+	}, backoff.WithContext(backoff.NewConstantBackOff(time.Second), ctx))
+	if err != nil {
+		return err
+	}
+
+	fromHeight, err := cosmostxcollector.ResumeHeight(ctx, db, 1)
+	if err != nil {
+		return err
+	}
+
+	latestHeight, err := client.LatestBlockHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The indexed blocks belong to a previous state when the chain was reset
+	if latestHeight+1 < fromHeight {
+		c.ev.Send(
+			fmt.Sprintf(
+				"Transaction indexer: the %s database contains blocks up to height %d, new blocks are indexed after it",
+				db.GetType(),
+				fromHeight-1,
+			),
+			events.Icon(icons.Bullet),
+		)
+	}
+
+	collector := cosmostxcollector.New(db, client)
+	return collector.Follow(ctx, fromHeight, cosmostxcollector.DefaultFollowInterval)
+}
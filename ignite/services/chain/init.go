@@ -4,7 +4,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
-	"strings"
+	"time"
 
 	"github.com/imdario/mergo"
 
@@ -12,6 +12,7 @@ import (
 	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
 	"github.com/ignite/cli/ignite/pkg/cliui/view/accountview"
 	"github.com/ignite/cli/ignite/pkg/confile"
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite/cli/ignite/pkg/events"
 )
 
@@ -90,12 +91,14 @@ func (c *Chain) InitChain(ctx context.Context, initConfiguration, initGenesis bo
 		return err
 	}
 
-	var conf *chainconfig.Config
-	if initConfiguration || initGenesis {
-		conf, err = c.Config()
-		if err != nil {
-			return err
-		}
+	// the state of the new chain doesn't require the existing genesis migrations
+	if err := c.markGenesisMigrationsApplied(); err != nil {
+		return err
+	}
+
+	conf, err := c.Config()
+	if err != nil {
+		return err
 	}
 
 	// ovewrite app config files with the values defined in Ignite's config file
@@ -106,18 +109,25 @@ func (c *Chain) InitChain(ctx context.Context, initConfiguration, initGenesis bo
 	}
 
 	if initGenesis {
+		// check the genesis keys defined in the config against the genesis created by the app
+		if err := c.validateGenesisConfig(conf); err != nil {
+			return err
+		}
+
 		// make sure that chain id given during chain.New() has the most priority.
 		if conf.Genesis != nil {
 			conf.Genesis["chain_id"] = chainID
 		}
 
-		// update genesis file with the genesis values defined in the config
-		if err := c.UpdateGenesisFile(conf.Genesis); err != nil {
+		// update genesis file with the genesis values defined in the config,
+		// the values that use account addresses are updated when the accounts are initialized.
+		if err := c.updateGenesisFromConfig(conf, nil); err != nil {
 			return err
 		}
 	}
 
-	return nil
+	// init the nodes of the rest of validators
+	return c.initValidatorNodes(ctx, conf, initConfiguration)
 }
 
 // InitAccounts initializes the chain accounts and creates validator gentxs.
@@ -131,22 +141,71 @@ func (c *Chain) InitAccounts(ctx context.Context, cfg *chainconfig.Config) error
 
 	var accounts accountview.Accounts
 
+	// mnemonics keeps the mnemonics of the accounts created in the keyring
+	// to be able to import the validator accounts into the other validator nodes
+	mnemonics := make(map[string]string)
+
+	// addresses keeps the account addresses to render the genesis values that use them
+	addresses := make(map[string]string)
+
+	// edits keeps the genesis changes that can't be made with the app commands
+	edits := newGenesisAccountEdits()
+	now := time.Now()
+
+	// devAccounts keeps the accounts to be able to export them
+	var devAccounts []DevAccount
+
+	// the mnemonics are derived from a seed to keep the same addresses when the chain is reset
+	seed := c.accountsSeed(cfg)
+
 	// add accounts from config into genesis
-	for _, account := range cfg.Accounts {
+	for _, account := range sortAccounts(cfg.Accounts) {
+		// module accounts are added once the address prefix is known
+		if account.Module != "" {
+			continue
+		}
+
 		var generatedAccount chaincmdrunner.Account
 		accountAddress := account.Address
 
 		// If the account doesn't provide an address, we create one
-		if accountAddress == "" {
-			generatedAccount, err = commands.AddAccount(ctx, account.Name, account.Mnemonic, account.CoinType)
+		switch {
+		case account.Multisig != nil:
+			generatedAccount, err = commands.AddMultisigAccount(
+				ctx,
+				account.Name,
+				account.Multisig.Threshold,
+				account.Multisig.Accounts,
+			)
+			if err != nil {
+				return err
+			}
+			accountAddress = generatedAccount.Address
+		case accountAddress == "":
+			mnemonic := account.Mnemonic
+			if mnemonic == "" && seed != "" {
+				if mnemonic, err = cosmosaccount.MnemonicFromSeed(seed, account.Name); err != nil {
+					return err
+				}
+			}
+
+			generatedAccount, err = commands.AddAccount(ctx, account.Name, mnemonic, account.CoinType)
 			if err != nil {
 				return err
 			}
 			accountAddress = generatedAccount.Address
+			mnemonics[account.Name] = generatedAccount.Mnemonic
 		}
 
-		coins := strings.Join(account.Coins, ",")
-		if err := commands.AddGenesisAccount(ctx, accountAddress, coins); err != nil {
+		addresses[account.Name] = accountAddress
+		devAccounts = append(devAccounts, DevAccount{
+			Name:     account.Name,
+			Address:  accountAddress,
+			Mnemonic: generatedAccount.Mnemonic,
+			CoinType: account.CoinType,
+		})
+
+		if err := addGenesisAccount(ctx, commands, account, accountAddress, now, edits); err != nil {
 			return err
 		}
 
@@ -161,14 +220,69 @@ func (c *Chain) InitAccounts(ctx context.Context, cfg *chainconfig.Config) error
 		}
 	}
 
+	var prefix string
+	for _, account := range cfg.Accounts {
+		if account.Module == "" {
+			continue
+		}
+
+		if prefix == "" {
+			if prefix, err = appAddressPrefix(ctx, commands); err != nil {
+				return err
+			}
+		}
+
+		accountAddress, err := addModuleAccount(account, prefix, edits)
+		if err != nil {
+			return err
+		}
+
+		addresses[account.Name] = accountAddress
+		accounts = accounts.Append(accountview.NewAccount(account.Name, accountAddress))
+		devAccounts = append(devAccounts, DevAccount{Name: account.Name, Address: accountAddress})
+	}
+
+	if err := c.saveDevAccounts(devAccounts); err != nil {
+		return err
+	}
+
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	if err := edits.apply(genesisPath); err != nil {
+		return err
+	}
+
 	c.ev.SendView(accounts, events.ProgressFinish())
 
+	// update the genesis values that use account addresses before the gentxs are created
+	if genesisUsesAccounts(cfg.Genesis) {
+		if err := c.updateGenesisFromConfig(cfg, addresses); err != nil {
+			return err
+		}
+	}
+
 	// 0 length validator set when using network config
-	if len(cfg.Validators) != 0 {
-		_, err = c.IssueGentx(ctx, createValidatorFromConfig(cfg))
+	if len(cfg.Validators) == 0 {
+		return nil
+	}
+
+	// the gentxs of the rest of validators are collected together with the first one
+	if err := c.issueValidatorGentxs(ctx, cfg, mnemonics); err != nil {
+		return err
+	}
+
+	if _, err := c.IssueGentx(ctx, createValidatorFromConfig(cfg.Validators[0])); err != nil {
+		return err
+	}
+
+	if err := c.shareGenesis(cfg); err != nil {
+		return err
 	}
 
-	return err
+	return c.connectValidators(ctx, cfg)
 }
 
 // IssueGentx generates a gentx from the validator information in chain config and imports it in the chain genesis.
@@ -253,9 +367,7 @@ type Account struct {
 	Coins    string
 }
 
-func createValidatorFromConfig(conf *chainconfig.Config) (validator Validator) {
-	// Currently, we support the config file with one valid validator.
-	validatorFromConfig := conf.Validators[0]
+func createValidatorFromConfig(validatorFromConfig chainconfig.Validator) (validator Validator) {
 	validator.Name = validatorFromConfig.Name
 	validator.StakingAmount = validatorFromConfig.Bonded
 
@@ -0,0 +1,194 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff"
+
+	"github.com/ignite/cli/ignite/pkg/ctxticker"
+	"github.com/ignite/cli/ignite/pkg/tendermintrpc"
+)
+
+// blockPollInterval is the interval between the requests of the latest block height
+// done to send the new block lifecycle events.
+const blockPollInterval = time.Second
+
+// LifecycleEventType is the type of a lifecycle event of a served chain.
+type LifecycleEventType string
+
+const (
+	// LifecycleBuildStarted is sent when the app starts to be built.
+	LifecycleBuildStarted LifecycleEventType = "build-started"
+
+	// LifecycleBuildFailed is sent when the app fails to build.
+	LifecycleBuildFailed LifecycleEventType = "build-failed"
+
+	// LifecycleBuildSucceeded is sent when the app binary is built.
+	LifecycleBuildSucceeded LifecycleEventType = "build-succeeded"
+
+	// LifecycleStateReset is sent when the state of the chain is reset.
+	LifecycleStateReset LifecycleEventType = "state-reset"
+
+	// LifecycleChainInitialized is sent when the chain is initialized with a new
+	// state, including when the state is restored from a snapshot or forked.
+	LifecycleChainInitialized LifecycleEventType = "chain-initialized"
+
+	// LifecycleNodeStarted is sent when the node accepts RPC requests and the
+	// first block is committed, every time the chain is restarted.
+	LifecycleNodeStarted LifecycleEventType = "node-started"
+
+	// LifecycleNewBlock is sent for each block committed by the node.
+	LifecycleNewBlock LifecycleEventType = "new-block"
+)
+
+// LifecycleEventTypes lists all the lifecycle event types.
+var LifecycleEventTypes = []LifecycleEventType{
+	LifecycleBuildStarted,
+	LifecycleBuildFailed,
+	LifecycleBuildSucceeded,
+	LifecycleStateReset,
+	LifecycleChainInitialized,
+	LifecycleNodeStarted,
+	LifecycleNewBlock,
+}
+
+// LifecycleEvent is an event of the lifecycle of a served chain.
+// Only the payload that matches the type of the event is set.
+type LifecycleEvent struct {
+	// Type is the type of the event.
+	Type LifecycleEventType
+
+	// ChainID is the ID of the served chain.
+	ChainID string
+
+	// BuildFailed is the payload of LifecycleBuildFailed events.
+	BuildFailed *BuildFailedPayload
+
+	// BuildSucceeded is the payload of LifecycleBuildSucceeded events.
+	BuildSucceeded *BuildSucceededPayload
+
+	// ChainInitialized is the payload of LifecycleChainInitialized events.
+	ChainInitialized *ChainInitializedPayload
+
+	// NodeStarted is the payload of LifecycleNodeStarted events.
+	NodeStarted *NodeStartedPayload
+
+	// NewBlock is the payload of LifecycleNewBlock events.
+	NewBlock *NewBlockPayload
+}
+
+// BuildFailedPayload is the payload of LifecycleBuildFailed events.
+type BuildFailedPayload struct {
+	// Error is the error message of the build.
+	Error string
+}
+
+// BuildSucceededPayload is the payload of LifecycleBuildSucceeded events.
+type BuildSucceededPayload struct {
+	// Binary is the name of the app binary.
+	Binary string
+}
+
+// ChainInitializedPayload is the payload of LifecycleChainInitialized events.
+type ChainInitializedPayload struct {
+	// Home is the home directory of the node.
+	Home string
+}
+
+// NodeStartedPayload is the payload of LifecycleNodeStarted events.
+type NodeStartedPayload struct {
+	// RPCAddress is the address of the Tendermint RPC of the node.
+	RPCAddress string
+
+	// APIAddress is the address of the Cosmos SDK API of the node.
+	APIAddress string
+
+	// Home is the home directory of the node.
+	Home string
+
+	// Height is the latest block height when the node is started.
+	Height int64
+}
+
+// NewBlockPayload is the payload of LifecycleNewBlock events.
+type NewBlockPayload struct {
+	// Height is the height of the new block.
+	Height int64
+}
+
+// LifecycleHandler handles the lifecycle events of a served chain.
+// Errors returned by the handler are reported but don't stop the chain.
+type LifecycleHandler func(LifecycleEvent) error
+
+// ServeLifecycle sets a handler for the lifecycle events of the served chain.
+func ServeLifecycle(h LifecycleHandler) ServeOption {
+	return func(c *serveOptions) {
+		c.lifecycle = h
+	}
+}
+
+// sendLifecycleEvent sends an event to the lifecycle handler, when there's one.
+func (c *Chain) sendLifecycleEvent(e LifecycleEvent) {
+	if c.lifecycle == nil {
+		return
+	}
+
+	e.ChainID, _ = c.ID()
+
+	if err := c.lifecycle(e); err != nil {
+		c.ev.SendError(fmt.Errorf("%s lifecycle event handler failed: %w", e.Type, err))
+	}
+}
+
+// watchBlocks waits until the node commits its first block to send the node
+// started lifecycle event, then it sends a new block event for each block
+// committed until the context is done.
+func (c *Chain) watchBlocks(ctx context.Context, rpcAddr, apiAddr string) {
+	var (
+		client = tendermintrpc.New(rpcAddr)
+		height int64
+	)
+
+	// Wait until the node accepts RPC requests and commits the first block
+	err := backoff.Retry(func() (err error) {
+		height, err = client.GetLatestBlockHeight(ctx)
+		if err == nil && height == 0 {
+			err = errors.New("waiting for the first block")
+		}
+		return err
+	}, backoff.WithContext(backoff.NewConstantBackOff(blockPollInterval), ctx))
+	if err != nil {
+		return
+	}
+
+	home, _ := c.Home()
+	c.sendLifecycleEvent(LifecycleEvent{
+		Type: LifecycleNodeStarted,
+		NodeStarted: &NodeStartedPayload{
+			RPCAddress: rpcAddr,
+			APIAddress: apiAddr,
+			Home:       home,
+			Height:     height,
+		},
+	})
+
+	_ = ctxticker.Do(ctx, blockPollInterval, func() error {
+		latest, err := client.GetLatestBlockHeight(ctx)
+		if err != nil {
+			// the node might be restarting, the next request will tell
+			return nil
+		}
+
+		for ; height < latest; height++ {
+			c.sendLifecycleEvent(LifecycleEvent{
+				Type:     LifecycleNewBlock,
+				NewBlock: &NewBlockPayload{Height: height + 1},
+			})
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,97 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+func TestWatchBlocks(t *testing.T) {
+	// Arrange
+	var height atomic.Int64
+	height.Store(1)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"result":{"sync_info":{"latest_block_height":"%d"}}}`, height.Load())
+	}))
+	defer ts.Close()
+
+	received := make(chan LifecycleEvent, 10)
+	c := &Chain{
+		options: chainOptions{chainID: "test-1", homePath: "/home/test"},
+		lifecycle: func(e LifecycleEvent) error {
+			received <- e
+			return nil
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Act
+	go c.watchBlocks(ctx, ts.URL, "http://localhost:1317")
+
+	// Assert
+	require.Equal(t, LifecycleEvent{
+		Type:    LifecycleNodeStarted,
+		ChainID: "test-1",
+		NodeStarted: &NodeStartedPayload{
+			RPCAddress: ts.URL,
+			APIAddress: "http://localhost:1317",
+			Home:       "/home/test",
+			Height:     1,
+		},
+	}, receiveLifecycleEvent(t, received))
+
+	height.Store(3)
+
+	for _, h := range []int64{2, 3} {
+		require.Equal(t, LifecycleEvent{
+			Type:     LifecycleNewBlock,
+			ChainID:  "test-1",
+			NewBlock: &NewBlockPayload{Height: h},
+		}, receiveLifecycleEvent(t, received))
+	}
+}
+
+func TestSendLifecycleEventError(t *testing.T) {
+	// Arrange
+	bus := events.NewBus()
+	defer bus.Stop()
+
+	c := &Chain{
+		options: chainOptions{chainID: "test-1"},
+		ev:      bus,
+		lifecycle: func(LifecycleEvent) error {
+			return errors.New("deployment failed")
+		},
+	}
+
+	// Act
+	c.sendLifecycleEvent(LifecycleEvent{Type: LifecycleBuildStarted})
+
+	// Assert
+	e := <-bus.Events()
+	require.Contains(t, e.Message, "build-started lifecycle event handler failed: deployment failed")
+}
+
+func receiveLifecycleEvent(t *testing.T, ch chan LifecycleEvent) LifecycleEvent {
+	t.Helper()
+
+	select {
+	case e := <-ch:
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatal("lifecycle event not received")
+		return LifecycleEvent{}
+	}
+}
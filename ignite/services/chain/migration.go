@@ -0,0 +1,176 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/genesismigration"
+)
+
+const (
+	// genesisMigrationsFile is the name of the file in the chain home config
+	// directory with the IDs of the genesis migrations applied to the state.
+	genesisMigrationsFile = "genesis_migrations.json"
+
+	// genesisMigrationsDiffFile is the name of the file with the diff of the last applied genesis migrations.
+	genesisMigrationsDiffFile = "genesis_migrations.diff"
+)
+
+// GenesisMigrations returns the genesis migrations of the app.
+func (c *Chain) GenesisMigrations() ([]genesismigration.Migration, error) {
+	return genesismigration.Discover(filepath.Join(c.app.Path, genesismigration.DefaultDir))
+}
+
+// migrateGenesis applies the pending genesis migrations to a genesis file.
+// Migrations are pending when they were added after the chain was initialized
+// or after the last time the migrations were applied.
+func (c *Chain) migrateGenesis(ctx context.Context, genesisPath string) error {
+	migrations, err := c.GenesisMigrations()
+	if err != nil {
+		return err
+	}
+
+	applied, err := c.appliedGenesisMigrations()
+	if err != nil {
+		return err
+	}
+
+	var pending []genesismigration.Migration
+	for _, m := range migrations {
+		if _, ok := applied[m.ID]; !ok {
+			pending = append(pending, m)
+		}
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	c.ev.Send("Applying genesis migrations...", events.ProgressUpdate())
+
+	results, err := genesismigration.Migrate(ctx, genesisPath, c.app.Path, pending)
+	if err != nil {
+		return err
+	}
+
+	var diff strings.Builder
+	for _, r := range results {
+		added, removed := countDiffLines(r.Diff)
+		c.ev.Send(
+			fmt.Sprintf("Genesis migration %s applied (+%d -%d lines)", r.Migration.ID, added, removed),
+			events.Icon(icons.OK),
+		)
+
+		diff.WriteString(r.Diff)
+	}
+
+	if diff.Len() > 0 {
+		savePath, err := c.chainSavePath()
+		if err != nil {
+			return err
+		}
+
+		if err := os.MkdirAll(savePath, 0o700); err != nil {
+			return err
+		}
+
+		diffPath := filepath.Join(savePath, genesisMigrationsDiffFile)
+		if err := os.WriteFile(diffPath, []byte(diff.String()), 0o644); err != nil {
+			return err
+		}
+
+		c.ev.Send(
+			fmt.Sprintf("Genesis migrations diff saved in %s", diffPath),
+			events.Icon(icons.CD),
+		)
+	}
+
+	return c.saveAppliedGenesisMigrations(migrations)
+}
+
+// markGenesisMigrationsApplied marks all the app genesis migrations as applied.
+// It must be called when the chain is initialized because the genesis
+// of a new chain already uses the latest state schema of the modules.
+func (c *Chain) markGenesisMigrationsApplied() error {
+	migrations, err := c.GenesisMigrations()
+	if err != nil {
+		return err
+	}
+
+	return c.saveAppliedGenesisMigrations(migrations)
+}
+
+func (c *Chain) genesisMigrationsPath() (string, error) {
+	home, err := c.Home()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, "config", genesisMigrationsFile), nil
+}
+
+func (c *Chain) appliedGenesisMigrations() (map[string]struct{}, error) {
+	path, err := c.genesisMigrationsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make(map[string]struct{})
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return applied, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return nil, fmt.Errorf("invalid genesis migrations file %s: %w", path, err)
+	}
+
+	for _, id := range ids {
+		applied[id] = struct{}{}
+	}
+
+	return applied, nil
+}
+
+func (c *Chain) saveAppliedGenesisMigrations(migrations []genesismigration.Migration) error {
+	path, err := c.genesisMigrationsPath()
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, len(migrations))
+	for i, m := range migrations {
+		ids[i] = m.ID
+	}
+
+	data, err := json.MarshalIndent(ids, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
+
+func countDiffLines(diff string) (added, removed int) {
+	for _, l := range strings.Split(diff, "\n") {
+		switch {
+		case strings.HasPrefix(l, "+++"), strings.HasPrefix(l, "---"):
+		case strings.HasPrefix(l, "+"):
+			added++
+		case strings.HasPrefix(l, "-"):
+			removed++
+		}
+	}
+
+	return added, removed
+}
@@ -0,0 +1,91 @@
+package chain
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+func TestMigrateGenesis(t *testing.T) {
+	// Arrange
+	t.Setenv("IGNT_CONFIG_DIR", t.TempDir())
+
+	var (
+		ctx         = context.Background()
+		appPath     = t.TempDir()
+		home        = t.TempDir()
+		genesisPath = filepath.Join(t.TempDir(), "genesis.json")
+	)
+
+	c := &Chain{
+		app:     App{Path: appPath},
+		options: chainOptions{homePath: home, chainID: "test-1"},
+		ev:      events.NewBus(),
+	}
+
+	writeFile := func(path, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	// The migrations that exist when the chain is initialized are not applied
+	writeFile(filepath.Join(appPath, "migrations", "blog", "001_init.json"), `[
+		{"op": "set", "path": ".initialized", "value": true}
+	]`)
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0o755))
+	require.NoError(t, c.markGenesisMigrationsApplied())
+
+	writeFile(filepath.Join(appPath, "migrations", "blog", "002_rename.json"), `[
+		{"op": "rename", "path": ".posts[].title", "to": "name"}
+	]`)
+	writeFile(genesisPath, `{"app_state":{"blog":{"posts":[{"title":"a"}]}}}`)
+
+	// Act
+	err := c.migrateGenesis(ctx, genesisPath)
+
+	// Assert
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(genesisPath)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"app_state":{"blog":{"posts":[{"name":"a"}]}}}`, string(data))
+
+	applied, err := c.appliedGenesisMigrations()
+	require.NoError(t, err)
+	require.Contains(t, applied, "blog/001_init.json")
+	require.Contains(t, applied, "blog/002_rename.json")
+
+	savePath, err := c.chainSavePath()
+	require.NoError(t, err)
+	require.FileExists(t, filepath.Join(savePath, genesisMigrationsDiffFile))
+
+	// Migrations are only applied once
+	writeFile(genesisPath, `{"app_state":{"blog":{"posts":[{"title":"b"}]}}}`)
+	require.NoError(t, c.migrateGenesis(ctx, genesisPath))
+
+	data, err = os.ReadFile(genesisPath)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"app_state":{"blog":{"posts":[{"title":"b"}]}}}`, string(data))
+}
+
+func TestCountDiffLines(t *testing.T) {
+	diff := `--- a/blog
++++ b/blog
+@@ -1,3 +1,3 @@
+ {
+-  "title": "a"
++  "name": "a",
++  "count": 1
+ }
+`
+
+	added, removed := countDiffLines(diff)
+
+	require.Equal(t, 2, added)
+	require.Equal(t, 1, removed)
+}
@@ -43,6 +43,11 @@ func (c Chain) Start(ctx context.Context, runner chaincmdrunner.Runner, cfg *cha
 		return err
 	}
 
+	return c.startNode(ctx, runner, validator)
+}
+
+// startNode starts the node of a validator using its configured servers.
+func (c Chain) startNode(ctx context.Context, runner chaincmdrunner.Runner, validator chainconfig.Validator) error {
 	servers, err := validator.GetServers()
 	if err != nil {
 		return err
@@ -55,21 +60,26 @@ func (c Chain) Start(ctx context.Context, runner chaincmdrunner.Runner, cfg *cha
 
 // Configure sets the runtime configurations files for a chain (app.toml, client.toml, config.toml).
 func (c Chain) Configure(homePath string, cfg *chainconfig.Config) error {
-	if err := c.appTOML(homePath, cfg); err != nil {
-		return err
-	}
-	if err := c.clientTOML(homePath, cfg); err != nil {
+	validator, err := chainconfig.FirstValidator(cfg)
+	if err != nil {
 		return err
 	}
-	return c.configTOML(homePath, cfg)
+
+	return c.configure(homePath, validator)
 }
 
-func (c Chain) appTOML(homePath string, cfg *chainconfig.Config) error {
-	validator, err := chainconfig.FirstValidator(cfg)
-	if err != nil {
+// configure sets the runtime configurations files for a validator node.
+func (c Chain) configure(homePath string, validator chainconfig.Validator) error {
+	if err := c.appTOML(homePath, validator); err != nil {
+		return err
+	}
+	if err := c.clientTOML(homePath, validator); err != nil {
 		return err
 	}
+	return c.configTOML(homePath, validator)
+}
 
+func (c Chain) appTOML(homePath string, validator chainconfig.Validator) error {
 	// TODO find a better way in order to not delete comments in the toml.yml
 	path := filepath.Join(homePath, "config/app.toml")
 	appConfig, err := toml.LoadFile(path)
@@ -115,12 +125,7 @@ func (c Chain) appTOML(homePath string, cfg *chainconfig.Config) error {
 	return err
 }
 
-func (c Chain) configTOML(homePath string, cfg *chainconfig.Config) error {
-	validator, err := chainconfig.FirstValidator(cfg)
-	if err != nil {
-		return err
-	}
-
+func (c Chain) configTOML(homePath string, validator chainconfig.Validator) error {
 	// TODO find a better way in order to not delete comments in the toml.yml
 	path := filepath.Join(homePath, "config/config.toml")
 	tmConfig, err := toml.LoadFile(path)
@@ -166,12 +171,7 @@ func (c Chain) configTOML(homePath string, cfg *chainconfig.Config) error {
 	return err
 }
 
-func (c Chain) clientTOML(homePath string, cfg *chainconfig.Config) error {
-	validator, err := chainconfig.FirstValidator(cfg)
-	if err != nil {
-		return err
-	}
-
+func (c Chain) clientTOML(homePath string, validator chainconfig.Validator) error {
 	path := filepath.Join(homePath, "config/client.toml")
 	tmConfig, err := toml.LoadFile(path)
 	if os.IsNotExist(err) {
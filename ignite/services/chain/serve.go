@@ -16,6 +16,7 @@ import (
 
 	"github.com/ignite/cli/ignite/config"
 	chainconfig "github.com/ignite/cli/ignite/config/chain"
+	"github.com/ignite/cli/ignite/config/chain/base"
 	"github.com/ignite/cli/ignite/pkg/cache"
 	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
 	"github.com/ignite/cli/ignite/pkg/cliui/colors"
@@ -23,6 +24,7 @@ import (
 	"github.com/ignite/cli/ignite/pkg/cliui/view/accountview"
 	"github.com/ignite/cli/ignite/pkg/cliui/view/errorview"
 	"github.com/ignite/cli/ignite/pkg/cosmosfaucet"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
 	"github.com/ignite/cli/ignite/pkg/dirchange"
 	"github.com/ignite/cli/ignite/pkg/events"
 	"github.com/ignite/cli/ignite/pkg/localfs"
@@ -49,13 +51,11 @@ const (
 	configChecksumKey = "config_checksum"
 
 	// serveDirchangeCacheNamespace is the name of the cache namespace for detecting changes in directories.
+	// The namespace is suffixed with the config path to use different checksums for each served chain.
 	serveDirchangeCacheNamespace = "serve.dirchange"
 )
 
 var (
-	// ignoredExts holds a list of ignored files from watching.
-	ignoredExts = []string{"pb.go", "pb.gw.go"}
-
 	// starportSavePath is the place where chain exported genesis are saved.
 	starportSavePath = xfilepath.Join(
 		config.DirPath,
@@ -70,6 +70,11 @@ type serveOptions struct {
 	quitOnFail      bool
 	generateClients bool
 	buildTags       []string
+	indexDB         adapter.Adapter
+	snapshot        string
+	fork            string
+	controlAddr     string
+	lifecycle       LifecycleHandler
 }
 
 func newServeOption() serveOptions {
@@ -117,6 +122,37 @@ func ServeSkipProto() ServeOption {
 	}
 }
 
+// ServeIndex indexes the transactions of the served chain in a data backend.
+// The database schema must be initialized before the chain is served.
+func ServeIndex(db adapter.Adapter) ServeOption {
+	return func(c *serveOptions) {
+		c.indexDB = db
+	}
+}
+
+// ServeFromSnapshot restores a saved state of the chain once when the chain is served.
+func ServeFromSnapshot(name string) ServeOption {
+	return func(c *serveOptions) {
+		c.snapshot = name
+	}
+}
+
+// ServeForkFrom initializes the chain once with the state of another chain when the chain is served.
+// The source is the path or URL of a genesis file exported with the app on a node of the chain,
+// the RPC address of a node is not supported because it only serves the initial genesis of the chain.
+func ServeForkFrom(source string) ServeOption {
+	return func(c *serveOptions) {
+		c.fork = source
+	}
+}
+
+// ServeControl serves an HTTP API to control and observe the served chain.
+func ServeControl(addr string) ServeOption {
+	return func(c *serveOptions) {
+		c.controlAddr = addr
+	}
+}
+
 // BuildTags set the build tags for the go build.
 func BuildTags(buildTags ...string) ServeOption {
 	return func(c *serveOptions) {
@@ -133,6 +169,12 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 		apply(&serveOptions)
 	}
 
+	if serveOptions.snapshot != "" && serveOptions.fork != "" {
+		return errors.New("the chain can't be served from a snapshot and a forked state at the same time")
+	}
+
+	c.lifecycle = serveOptions.lifecycle
+
 	// initial checks and setup.
 	if err := c.setup(); err != nil {
 		return err
@@ -150,6 +192,20 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 	// start serving components.
 	g, ctx := errgroup.WithContext(ctx)
 
+	if serveOptions.controlAddr != "" {
+		c.control = newServeControl()
+
+		// publish the chain events to the control API subscribers
+		defer c.ev.Hook(c.control.publish)()
+
+		g.Go(func() error {
+			if err := c.runControlServer(ctx, serveOptions.controlAddr); err != nil {
+				return fmt.Errorf("failed to serve the control API: %w", err)
+			}
+			return nil
+		})
+	}
+
 	// blockchain node routine
 	g.Go(func() error {
 		c.refreshServe()
@@ -170,16 +226,19 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 				}
 
 				var (
-					serveCtx      context.Context
 					buildErr      *CannotBuildAppError
 					startErr      *CannotStartAppError
 					validationErr *chainconfig.ValidationError
 				)
 
-				serveCtx, c.serveCancel = context.WithCancel(ctx)
+				serveCtx, serveCancel := context.WithCancel(ctx)
+				c.setServeCancel(serveCancel)
+
+				// determine if the chain should reset the state or rebuild the app
+				reset, rebuild := c.control.takeActions()
+				shouldReset := serveOptions.forceReset || serveOptions.resetOnce || reset
 
-				// determine if the chain should reset the state
-				shouldReset := serveOptions.forceReset || serveOptions.resetOnce
+				c.control.setState(ServeStateStarting, nil)
 
 				// serve the app.
 				err = c.serve(
@@ -187,10 +246,16 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 					cacheStorage,
 					serveOptions.buildTags,
 					shouldReset,
+					rebuild,
 					serveOptions.skipProto,
 					serveOptions.generateClients,
+					serveOptions.indexDB,
+					serveOptions.snapshot,
+					serveOptions.fork,
 				)
 				serveOptions.resetOnce = false
+				serveOptions.snapshot = ""
+				serveOptions.fork = ""
 
 				switch {
 				case err == nil:
@@ -220,6 +285,8 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 						)
 					}
 				case errors.As(err, &validationErr):
+					c.control.setState(ServeStateFailed, err)
+
 					if serveOptions.quitOnFail {
 						return err
 					}
@@ -229,6 +296,8 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 
 					c.ev.SendView(errorview.NewError(err), events.ProgressFinish(), events.Group(events.GroupError))
 				case errors.As(err, &buildErr):
+					c.control.setState(ServeStateFailed, err)
+
 					if serveOptions.quitOnFail {
 						return err
 					}
@@ -245,7 +314,8 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 						info := colors.Info(
 							"Blockchain failed to start.\n",
 							"If the new code is no longer compatible with the saved\n",
-							"state, you can reset the database by launching:",
+							"state, add a genesis migration to the \"migrations\" directory\n",
+							"or reset the database by launching:",
 						)
 						command := colors.SprintFunc(colors.White)("ignite chain serve --reset-once")
 
@@ -285,29 +355,67 @@ func (c *Chain) checkSystem() error {
 	return nil
 }
 
+// setServeCancel sets the function that stops serving the chain when the
+// chain must be served again.
+func (c *Chain) setServeCancel(cancel context.CancelFunc) {
+	c.serveMu.Lock()
+	defer c.serveMu.Unlock()
+
+	c.serveCancel = cancel
+}
+
+// refreshServe stops serving the chain and requests to serve it again.
+// It can be called from any goroutine.
 func (c *Chain) refreshServe() {
+	c.serveMu.Lock()
 	if c.serveCancel != nil {
 		c.serveCancel()
 	}
-	// send event changes detected
-	c.serveRefresher <- struct{}{}
+	c.serveMu.Unlock()
+
+	// send event changes detected, unless a refresh is already pending
+	select {
+	case c.serveRefresher <- struct{}{}:
+	default:
+	}
 }
 
 func (c *Chain) watchAppBackend(ctx context.Context) error {
-	watchPaths := appBackendSourceWatchPaths
-	if c.ConfigPath() != "" {
-		watchPaths = append(watchPaths, c.ConfigPath())
-	}
+	for {
+		// the default rules are used when the config can't be read,
+		// the config error is reported when the chain is served.
+		conf, _ := c.Config()
+		rules := newWatchRules(conf)
 
-	return localfs.Watch(
-		ctx,
-		watchPaths,
-		localfs.WatcherWorkdir(c.app.Path),
-		localfs.WatcherOnChange(c.refreshServe),
-		localfs.WatcherIgnoreHidden(),
-		localfs.WatcherIgnoreFolders(),
-		localfs.WatcherIgnoreExt(ignoredExts...),
-	)
+		watchPaths := rules.roots()
+		if c.ConfigPath() != "" {
+			watchPaths = append(watchPaths, c.ConfigPath())
+		}
+
+		// the rules are read again from the config when it changes
+		watchCtx, cancel := context.WithCancel(ctx)
+		err := localfs.Watch(
+			watchCtx,
+			watchPaths,
+			localfs.WatcherWorkdir(c.app.Path),
+			localfs.WatcherOnChangePaths(func(paths []string) {
+				if c.sourceChanged(rules, paths) {
+					cancel()
+				}
+			}),
+			localfs.WatcherIgnoreHidden(),
+			localfs.WatcherIgnoreFolders(),
+			localfs.WatcherIgnoreGlobs(rules.exclude...),
+		)
+		cancel()
+
+		if err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
 }
 
 // serve performs the operations to serve the blockchain: build, init and start.
@@ -317,7 +425,9 @@ func (c *Chain) serve(
 	ctx context.Context,
 	cacheStorage cache.Storage,
 	buildTags []string,
-	forceReset, skipProto, generateClients bool,
+	forceReset, forceBuild, skipProto, generateClients bool,
+	indexDB adapter.Adapter,
+	snapshot, fork string,
 ) error {
 	conf, err := c.Config()
 	if err != nil {
@@ -330,9 +440,9 @@ func (c *Chain) serve(
 	}
 
 	// isInit determines if the app is initialized
-	var isInit bool
+	var isInit, resetState bool
 
-	dirCache := cache.New[[]byte](cacheStorage, serveDirchangeCacheNamespace)
+	dirCache := cache.New[[]byte](cacheStorage, serveDirchangeCacheNamespace+":"+c.ConfigPath())
 
 	// determine if the app must reset the state
 	// if the state must be reset, then we consider the chain as being not initialized
@@ -353,12 +463,19 @@ func (c *Chain) serve(
 			// if forceReset is set, we consider the app as being not initialized
 			c.ev.Send("Resetting the app state...", events.ProgressUpdate())
 			isInit = false
+			resetState = true
 		}
 	}
 
 	// check if source has been modified since last serve
-	// if the state must not be reset but the source has changed, we rebuild the chain and import the exported state
-	sourceModified, err := dirchange.HasDirChecksumChanged(dirCache, sourceChecksumKey, c.app.Path, appBackendSourceWatchPaths...)
+	// if the state must not be reset but the source has changed, we rebuild the chain and import the exported state.
+	// only the files that require to rebuild the app when they change are considered.
+	sourceFiles, err := watchedFiles(c.app.Path, newWatchRules(conf), base.WatchActionRebuild)
+	if err != nil {
+		return err
+	}
+
+	sourceModified, err := dirchange.HasDirChecksumChanged(dirCache, sourceChecksumKey, c.app.Path, sourceFiles...)
 	if err != nil {
 		return err
 	}
@@ -397,39 +514,85 @@ func (c *Chain) serve(
 	}
 
 	// build phase
-	if !isInit || appModified {
+	if !isInit || appModified || forceBuild || snapshot != "" || fork != "" {
 		// build the blockchain app
+		c.sendLifecycleEvent(LifecycleEvent{Type: LifecycleBuildStarted})
+
 		if err := c.build(ctx, cacheStorage, buildTags, "", skipProto, generateClients, true); err != nil {
+			if !errors.Is(err, context.Canceled) {
+				c.sendLifecycleEvent(LifecycleEvent{
+					Type:        LifecycleBuildFailed,
+					BuildFailed: &BuildFailedPayload{Error: ansiRe.ReplaceAllString(err.Error(), "")},
+				})
+			}
 			return err
 		}
+
+		c.sendLifecycleEvent(LifecycleEvent{
+			Type:           LifecycleBuildSucceeded,
+			BuildSucceeded: &BuildSucceededPayload{Binary: binaryName},
+		})
 	}
 
 	// init phase
-	initApp := !isInit || (appModified && !exportGenesisExists)
+	initApp := snapshot == "" && fork == "" && (!isInit || (appModified && !exportGenesisExists))
 
 	//nolint:gocritic
-	if initApp {
+	if snapshot != "" {
+		// restore the saved state instead of initializing or importing the state
+		c.ev.Send(fmt.Sprintf("Restoring the %q snapshot...", snapshot), events.ProgressUpdate())
+
+		if _, err := c.RestoreSnapshot(ctx, snapshot); err != nil {
+			return &CannotBuildAppError{err}
+		}
+	} else if fork != "" {
+		// initialize the chain with the state of another chain
+		if err := c.Fork(ctx, fork); err != nil {
+			return &CannotBuildAppError{err}
+		}
+	} else if initApp {
 		c.ev.Send("Initializing the app...", events.ProgressUpdate())
 
 		if err := c.Init(ctx, InitArgsAll); err != nil {
 			return err
 		}
+
+		if resetState {
+			c.sendLifecycleEvent(LifecycleEvent{Type: LifecycleStateReset})
+		}
 	} else if appModified {
 		// if the chain is already initialized but the source has been modified
 		// we reset the chain database and import the genesis state
 		c.ev.Send("Existent genesis detected, restoring the database...", events.ProgressUpdate())
 
-		if err := commands.UnsafeReset(ctx); err != nil {
+		if err := c.resetValidatorNodes(ctx, conf); err != nil {
 			return err
 		}
 
+		// migrate the exported state to the new state schema of the modules
+		if err := c.migrateGenesis(ctx, exportedGenesisPath); err != nil {
+			return &CannotBuildAppError{err}
+		}
+
 		if err := c.importChainState(); err != nil {
 			return err
 		}
+
+		if err := c.shareGenesis(conf); err != nil {
+			return err
+		}
 	} else {
 		c.ev.Send("Restarting existing app...", events.ProgressUpdate())
 	}
 
+	if snapshot != "" || fork != "" || initApp {
+		home, _ := c.Home()
+		c.sendLifecycleEvent(LifecycleEvent{
+			Type:             LifecycleChainInitialized,
+			ChainInitialized: &ChainInitializedPayload{Home: home},
+		})
+	}
+
 	// save checksums
 	if c.ConfigPath() != "" {
 		if err := dirchange.SaveDirChecksum(dirCache, configChecksumKey, c.app.Path, c.ConfigPath()); err != nil {
@@ -437,8 +600,10 @@ func (c *Chain) serve(
 		}
 	}
 
-	if err := dirchange.SaveDirChecksum(dirCache, sourceChecksumKey, c.app.Path, appBackendSourceWatchPaths...); err != nil {
-		return err
+	if len(sourceFiles) > 0 {
+		if err := dirchange.SaveDirChecksum(dirCache, sourceChecksumKey, c.app.Path, sourceFiles...); err != nil {
+			return err
+		}
 	}
 
 	if err := dirchange.SaveDirChecksum(dirCache, binaryChecksumKey, "", binaryPath); err != nil {
@@ -447,7 +612,7 @@ func (c *Chain) serve(
 
 	// Display existing accounts if they were not initialized.
 	// Note that chain init displays accounts when the app is initialized.
-	if !initApp {
+	if !initApp && fork == "" {
 		accounts, err := commands.ListAccounts(ctx)
 		if err != nil {
 			return err
@@ -462,21 +627,47 @@ func (c *Chain) serve(
 	}
 
 	// start the blockchain
-	return c.start(ctx, conf)
+	return c.start(ctx, cacheStorage, conf, indexDB)
 }
 
-func (c *Chain) start(ctx context.Context, cfg *chainconfig.Config) error {
+func (c *Chain) start(ctx context.Context, cacheStorage cache.Storage, cfg *chainconfig.Config, indexDB adapter.Adapter) error {
 	commands, err := c.Commands(ctx)
 	if err != nil {
 		return err
 	}
 
-	g, ctx := errgroup.WithContext(ctx)
+	// resolve the commands of the rest of validators before starting any node
+	// so that an error doesn't leave nodes running outside the error group.
+	validatorCommands := make([]chaincmdrunner.Runner, 0, len(cfg.Validators))
+	for i := 1; i < len(cfg.Validators); i++ {
+		runner, err := c.ValidatorCommands(ctx, cfg, i)
+		if err != nil {
+			return err
+		}
 
-	// start the blockchain.
-	g.Go(func() error { return c.Start(ctx, commands, cfg) })
+		validatorCommands = append(validatorCommands, runner)
+	}
+
+	validator, err := chainconfig.FirstValidator(cfg)
+	if err != nil {
+		return err
+	}
+
+	servers, err := validator.GetServers()
+	if err != nil {
+		return err
+	}
+
+	validatorRPCAddrs := make([]string, 0, len(cfg.Validators))
+	for _, v := range cfg.Validators[1:] {
+		servers, err := v.GetServers()
+		if err != nil {
+			return err
+		}
+
+		validatorRPCAddrs = append(validatorRPCAddrs, servers.RPC.Address)
+	}
 
-	// start the faucet if enabled.
 	faucet, err := c.Faucet(ctx)
 	isFaucetEnabled := !errors.Is(err, ErrFaucetIsNotEnabled)
 
@@ -487,7 +678,21 @@ func (c *Chain) start(ctx context.Context, cfg *chainconfig.Config) error {
 		if err != nil {
 			return err
 		}
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+
+	// start the blockchain.
+	g.Go(func() error { return c.Start(ctx, commands, cfg) })
+
+	// start the nodes of the rest of validators.
+	for i, runner := range validatorCommands {
+		runner, v := runner, cfg.Validators[i+1]
+		g.Go(func() error { return c.startNode(ctx, runner, v) })
+	}
 
+	// start the faucet if enabled.
+	if isFaucetEnabled {
 		g.Go(func() (err error) {
 			if err := c.runFaucetServer(ctx, faucet); err != nil {
 				return &CannotBuildAppError{err}
@@ -498,16 +703,7 @@ func (c *Chain) start(ctx context.Context, cfg *chainconfig.Config) error {
 
 	// set the app as being served
 	c.served = true
-
-	validator, err := chainconfig.FirstValidator(cfg)
-	if err != nil {
-		return err
-	}
-
-	servers, err := validator.GetServers()
-	if err != nil {
-		return err
-	}
+	c.control.setState(ServeStateRunning, nil)
 
 	// note: address format errors are handled by the
 	// error group, so they can be safely ignored here
@@ -515,6 +711,14 @@ func (c *Chain) start(ctx context.Context, cfg *chainconfig.Config) error {
 	rpcAddr, _ := xurl.HTTP(servers.RPC.Address)
 	apiAddr, _ := xurl.HTTP(servers.API.Address)
 
+	// send the node lifecycle events if there's a handler for them.
+	if c.lifecycle != nil {
+		g.Go(func() error {
+			c.watchBlocks(ctx, rpcAddr, apiAddr)
+			return nil
+		})
+	}
+
 	c.ev.Send(
 		fmt.Sprintf("Tendermint node: %s", rpcAddr),
 		events.Icon(icons.Earth),
@@ -525,6 +729,28 @@ func (c *Chain) start(ctx context.Context, cfg *chainconfig.Config) error {
 		events.Icon(icons.Earth),
 	)
 
+	// start indexing the transactions if a data backend is configured.
+	if indexDB != nil {
+		g.Go(func() error {
+			c.runIndexer(ctx, indexDB, rpcAddr)
+			return nil
+		})
+
+		c.ev.Send(
+			fmt.Sprintf("Transaction indexer: %s", indexDB.GetType()),
+			events.Icon(icons.Earth),
+		)
+	}
+
+	for i, addr := range validatorRPCAddrs {
+		rpcAddr, _ := xurl.HTTP(addr)
+
+		c.ev.Send(
+			fmt.Sprintf("Tendermint node (%s): %s", cfg.Validators[i+1].Name, rpcAddr),
+			events.Icon(icons.Earth),
+		)
+	}
+
 	if isFaucetEnabled {
 		faucetAddr, _ := xurl.HTTP(chainconfig.FaucetHost(cfg))
 
@@ -548,7 +774,19 @@ func (c *Chain) start(ctx context.Context, cfg *chainconfig.Config) error {
 		events.Group(EvtGroupPath),
 	)
 
-	return g.Wait()
+	// run the code generation requested by the source code changes while the
+	// chain is running, the code is generated when the app is built otherwise.
+	done := make(chan error, 1)
+	go func() { done <- g.Wait() }()
+
+	for {
+		select {
+		case err := <-done:
+			return err
+		case <-c.generateQueue.ready:
+			c.generate(ctx, cacheStorage, c.generateQueue.take())
+		}
+	}
 }
 
 func (c *Chain) runFaucetServer(ctx context.Context, faucet cosmosfaucet.Faucet) error {
@@ -563,6 +801,22 @@ func (c *Chain) runFaucetServer(ctx context.Context, faucet cosmosfaucet.Faucet)
 	})
 }
 
+// resetValidatorNodes resets the blockchain database of every validator node.
+func (c *Chain) resetValidatorNodes(ctx context.Context, cfg *chainconfig.Config) error {
+	for i := range cfg.Validators {
+		commands, err := c.ValidatorCommands(ctx, cfg, i)
+		if err != nil {
+			return err
+		}
+
+		if err := commands.UnsafeReset(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // saveChainState runs the export command of the chain and store the exported genesis in the chain saved config.
 func (c *Chain) saveChainState(ctx context.Context, commands chaincmdrunner.Runner) error {
 	genesisPath, err := c.exportedGenesisPath()
@@ -0,0 +1,416 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/otiai10/copy"
+	"github.com/pkg/errors"
+
+	chainconfig "github.com/ignite/cli/ignite/config/chain"
+)
+
+const (
+	// snapshotsDir is the name of the directory where the snapshots of a chain are saved.
+	snapshotsDir = "snapshots"
+
+	// snapshotInfoFile is the name of the file with the snapshot information.
+	snapshotInfoFile = "snapshot.json"
+
+	// snapshotGenesisFile is the name of the exported genesis file of a snapshot.
+	snapshotGenesisFile = "genesis.json"
+
+	// snapshotHomesDir is the name of the snapshot directory with a copy of the validator homes.
+	snapshotHomesDir = "homes"
+
+	// keyringDirPrefix is the prefix of the keyring directories within a chain home.
+	keyringDirPrefix = "keyring-"
+)
+
+var (
+	// ErrSnapshotNotFound is returned when a snapshot doesn't exist.
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+
+	// ErrSnapshotExists is returned when a snapshot is saved with the name of an existing one.
+	ErrSnapshotExists = errors.New("snapshot already exists")
+
+	snapshotNameRe = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+)
+
+// Snapshot contains the information of a saved chain state.
+type Snapshot struct {
+	// Name of the snapshot.
+	Name string `json:"name"`
+
+	// ChainID is the ID of the chain the state belongs to.
+	ChainID string `json:"chain_id"`
+
+	// Height is the height of the last block included in the state.
+	Height int64 `json:"height"`
+
+	// Validators contains the names of the validators with a saved home.
+	Validators []string `json:"validators"`
+
+	// CreatedAt is the time when the snapshot was saved.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SaveSnapshot saves the current state of the chain with a name.
+// The snapshot contains the exported genesis and a copy of the config files
+// and keyrings of every validator home, so the state can be restored later
+// even when the chain was reset. The chain must not be running.
+func (c *Chain) SaveSnapshot(ctx context.Context, name string) (Snapshot, error) {
+	path, err := c.snapshotPath(name)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		return Snapshot{}, errors.Wrap(ErrSnapshotExists, name)
+	} else if !os.IsNotExist(err) {
+		return Snapshot{}, err
+	}
+
+	cfg, err := c.Config()
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	chainID, err := c.ID()
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	// save the snapshot in a temporary directory first to avoid partial snapshots.
+	// the directory name is not a valid snapshot name so it can't clash with other snapshots.
+	tmpPath := filepath.Join(filepath.Dir(path), ".tmp-"+name)
+	if err := os.RemoveAll(tmpPath); err != nil {
+		return Snapshot{}, err
+	}
+	defer os.RemoveAll(tmpPath)
+
+	if err := os.MkdirAll(tmpPath, 0o700); err != nil {
+		return Snapshot{}, err
+	}
+
+	genesisPath := filepath.Join(tmpPath, snapshotGenesisFile)
+	if err := commands.Export(ctx, genesisPath); err != nil {
+		return Snapshot{}, fmt.Errorf("failed to export the chain state, make sure the chain is not running: %w", err)
+	}
+
+	height, err := exportedGenesisHeight(genesisPath)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	s := Snapshot{
+		Name:      name,
+		ChainID:   chainID,
+		Height:    height,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	for i, v := range cfg.Validators {
+		home, err := c.ValidatorHome(cfg, i)
+		if err != nil {
+			return Snapshot{}, err
+		}
+
+		if err := copyHomeFiles(home, filepath.Join(tmpPath, snapshotHomesDir, v.Name)); err != nil {
+			return Snapshot{}, err
+		}
+
+		s.Validators = append(s.Validators, v.Name)
+	}
+
+	if err := writeSnapshotInfo(tmpPath, s); err != nil {
+		return Snapshot{}, err
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return Snapshot{}, err
+	}
+
+	return s, nil
+}
+
+// Snapshots returns the saved snapshots of the chain sorted by creation time.
+func (c *Chain) Snapshots() ([]Snapshot, error) {
+	dir, err := c.snapshotsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var snapshots []Snapshot
+	for _, e := range entries {
+		if !e.IsDir() || !snapshotNameRe.MatchString(e.Name()) {
+			continue
+		}
+
+		s, err := readSnapshotInfo(filepath.Join(dir, e.Name()))
+		if err != nil {
+			// ignore directories that are not snapshots
+			if os.IsNotExist(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		snapshots = append(snapshots, s)
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].CreatedAt.Before(snapshots[j].CreatedAt)
+	})
+
+	return snapshots, nil
+}
+
+// RestoreSnapshot restores a saved state of the chain.
+// The config files and keyrings of the validator homes are replaced by the
+// ones in the snapshot, the validator databases are reset and the exported
+// genesis is used as the genesis of the chain after applying the genesis
+// migrations added since the snapshot was saved. The chain must not be running.
+func (c *Chain) RestoreSnapshot(ctx context.Context, name string) (Snapshot, error) {
+	path, err := c.snapshotPath(name)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	s, err := readSnapshotInfo(path)
+	if os.IsNotExist(err) {
+		return Snapshot{}, errors.Wrap(ErrSnapshotNotFound, name)
+	} else if err != nil {
+		return Snapshot{}, err
+	}
+
+	cfg, err := c.Config()
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	if err := checkSnapshotValidators(cfg, s); err != nil {
+		return Snapshot{}, err
+	}
+
+	for i, v := range cfg.Validators {
+		home, err := c.ValidatorHome(cfg, i)
+		if err != nil {
+			return Snapshot{}, err
+		}
+
+		if err := restoreHomeFiles(filepath.Join(path, snapshotHomesDir, v.Name), home); err != nil {
+			return Snapshot{}, err
+		}
+	}
+
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	if err := copy.Copy(filepath.Join(path, snapshotGenesisFile), genesisPath); err != nil {
+		return Snapshot{}, err
+	}
+
+	// migrate the saved state to the current state schema of the modules
+	if err := c.migrateGenesis(ctx, genesisPath); err != nil {
+		return Snapshot{}, err
+	}
+
+	if err := c.shareGenesis(cfg); err != nil {
+		return Snapshot{}, err
+	}
+
+	// the state of the validator signers must be reset too, otherwise
+	// the nodes would refuse to sign blocks with lower heights
+	if err := c.resetValidatorNodes(ctx, cfg); err != nil {
+		return Snapshot{}, err
+	}
+
+	// keep the restored state when the chain is served after a source change
+	exportedGenesisPath, err := c.exportedGenesisPath()
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	if err := copy.Copy(genesisPath, exportedGenesisPath); err != nil {
+		return Snapshot{}, err
+	}
+
+	return s, nil
+}
+
+// snapshotsPath returns the path of the directory where the chain snapshots are saved.
+func (c *Chain) snapshotsPath() (string, error) {
+	savePath, err := c.chainSavePath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(savePath, snapshotsDir), nil
+}
+
+// snapshotPath returns the path of a snapshot.
+func (c *Chain) snapshotPath(name string) (string, error) {
+	if !snapshotNameRe.MatchString(name) {
+		return "", fmt.Errorf(
+			"invalid snapshot name %q: it must start with a letter or digit and contain only letters, digits, '.', '_' or '-'",
+			name,
+		)
+	}
+
+	dir, err := c.snapshotsPath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, name), nil
+}
+
+// checkSnapshotValidators checks that the snapshot has a saved home for every configured validator.
+func checkSnapshotValidators(cfg *chainconfig.Config, s Snapshot) error {
+	names := make(map[string]struct{}, len(s.Validators))
+	for _, n := range s.Validators {
+		names[n] = struct{}{}
+	}
+
+	for _, v := range cfg.Validators {
+		if _, ok := names[v.Name]; !ok {
+			return fmt.Errorf(
+				"snapshot %q doesn't contain the state of validator %q, the saved validators are: %s",
+				s.Name,
+				v.Name,
+				strings.Join(s.Validators, ", "),
+			)
+		}
+	}
+
+	return nil
+}
+
+// copyHomeFiles copies the config directory and the keyrings of a chain home.
+func copyHomeFiles(home, dst string) error {
+	if err := copy.Copy(filepath.Join(home, "config"), filepath.Join(dst, "config")); err != nil {
+		return err
+	}
+
+	keyrings, err := filepath.Glob(filepath.Join(home, keyringDirPrefix+"*"))
+	if err != nil {
+		return err
+	}
+
+	for _, k := range keyrings {
+		if err := copy.Copy(k, filepath.Join(dst, filepath.Base(k))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restoreHomeFiles replaces the config directory and the keyrings of a chain home.
+func restoreHomeFiles(src, home string) error {
+	entries, err := os.ReadDir(src)
+	if err != nil {
+		return err
+	}
+
+	// remove the keyrings of the home so keys created after the snapshot are removed too
+	keyrings, err := filepath.Glob(filepath.Join(home, keyringDirPrefix+"*"))
+	if err != nil {
+		return err
+	}
+
+	for _, k := range append(keyrings, filepath.Join(home, "config")) {
+		if err := os.RemoveAll(k); err != nil {
+			return err
+		}
+	}
+
+	for _, e := range entries {
+		if err := copy.Copy(filepath.Join(src, e.Name()), filepath.Join(home, e.Name())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeSnapshotInfo(path string, s Snapshot) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(path, snapshotInfoFile), data, 0o600)
+}
+
+func readSnapshotInfo(path string) (Snapshot, error) {
+	data, err := os.ReadFile(filepath.Join(path, snapshotInfoFile))
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	var s Snapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return Snapshot{}, fmt.Errorf("invalid snapshot %s: %w", filepath.Base(path), err)
+	}
+
+	return s, nil
+}
+
+// exportedGenesisHeight returns the height of the state of an exported genesis,
+// which is the genesis initial height minus one.
+func exportedGenesisHeight(path string) (int64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	var genesis struct {
+		InitialHeight json.RawMessage `json:"initial_height"`
+	}
+
+	if err := json.Unmarshal(data, &genesis); err != nil {
+		return 0, fmt.Errorf("invalid exported genesis: %w", err)
+	}
+
+	// the height can be encoded as a number or as a string
+	v := strings.Trim(string(genesis.InitialHeight), `"`)
+	if v == "" {
+		return 0, nil
+	}
+
+	height, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid exported genesis initial height: %w", err)
+	}
+
+	if height > 0 {
+		height--
+	}
+
+	return height, nil
+}
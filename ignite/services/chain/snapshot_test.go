@@ -0,0 +1,143 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	chainconfig "github.com/ignite/cli/ignite/config/chain"
+)
+
+func TestExportedGenesisHeight(t *testing.T) {
+	cases := []struct {
+		name    string
+		genesis string
+		want    int64
+		wantErr bool
+	}{
+		{
+			name:    "string height",
+			genesis: `{"initial_height":"43"}`,
+			want:    42,
+		},
+		{
+			name:    "number height",
+			genesis: `{"initial_height":43}`,
+			want:    42,
+		},
+		{
+			name:    "missing height",
+			genesis: `{}`,
+			want:    0,
+		},
+		{
+			name:    "invalid height",
+			genesis: `{"initial_height":"foo"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "genesis.json")
+			require.NoError(t, os.WriteFile(path, []byte(tt.genesis), 0o600))
+
+			height, err := exportedGenesisHeight(path)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, height)
+		})
+	}
+}
+
+func TestCopyAndRestoreHomeFiles(t *testing.T) {
+	var (
+		home     = t.TempDir()
+		snapshot = filepath.Join(t.TempDir(), "alice")
+	)
+
+	writeFile := func(path, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+
+	writeFile(filepath.Join(home, "config", "app.toml"), "saved")
+	writeFile(filepath.Join(home, "keyring-test", "alice.info"), "alice")
+	writeFile(filepath.Join(home, "data", "application.db"), "data")
+
+	require.NoError(t, copyHomeFiles(home, snapshot))
+
+	// the data directory is not part of the snapshot
+	require.NoFileExists(t, filepath.Join(snapshot, "data", "application.db"))
+
+	// change the home after the snapshot is saved
+	writeFile(filepath.Join(home, "config", "app.toml"), "changed")
+	writeFile(filepath.Join(home, "config", "extra.toml"), "extra")
+	writeFile(filepath.Join(home, "keyring-test", "bob.info"), "bob")
+
+	require.NoError(t, restoreHomeFiles(snapshot, home))
+
+	got, err := os.ReadFile(filepath.Join(home, "config", "app.toml"))
+	require.NoError(t, err)
+	require.Equal(t, "saved", string(got))
+	require.FileExists(t, filepath.Join(home, "keyring-test", "alice.info"))
+	require.NoFileExists(t, filepath.Join(home, "config", "extra.toml"))
+	require.NoFileExists(t, filepath.Join(home, "keyring-test", "bob.info"))
+	require.FileExists(t, filepath.Join(home, "data", "application.db"))
+}
+
+func TestSnapshots(t *testing.T) {
+	t.Setenv("IGNT_CONFIG_DIR", t.TempDir())
+
+	c := &Chain{options: chainOptions{chainID: "test-1"}}
+
+	for _, name := range []string{"../other", ".hidden", ""} {
+		_, err := c.snapshotPath(name)
+		require.Error(t, err, name)
+	}
+
+	snapshots, err := c.Snapshots()
+	require.NoError(t, err)
+	require.Empty(t, snapshots)
+
+	// save the snapshot info files in reverse creation order
+	now := time.Now().UTC()
+	for i, name := range []string{"second", "first"} {
+		path, err := c.snapshotPath(name)
+		require.NoError(t, err)
+		require.NoError(t, os.MkdirAll(path, 0o700))
+
+		s := Snapshot{
+			Name:      name,
+			ChainID:   "test-1",
+			CreatedAt: now.Add(-time.Duration(i) * time.Hour),
+		}
+		require.NoError(t, writeSnapshotInfo(path, s))
+	}
+
+	snapshots, err = c.Snapshots()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	require.Equal(t, "first", snapshots[0].Name)
+	require.Equal(t, "second", snapshots[1].Name)
+}
+
+func TestCheckSnapshotValidators(t *testing.T) {
+	cfg := &chainconfig.Config{
+		Validators: []chainconfig.Validator{
+			{Name: "alice"},
+			{Name: "bob"},
+		},
+	}
+
+	require.NoError(t, checkSnapshotValidators(cfg, Snapshot{Validators: []string{"alice", "bob"}}))
+	require.Error(t, checkSnapshotValidators(cfg, Snapshot{Validators: []string{"alice"}}))
+}
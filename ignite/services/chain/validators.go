@@ -0,0 +1,316 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/otiai10/copy"
+	"github.com/pelletier/go-toml"
+
+	chainconfig "github.com/ignite/cli/ignite/config/chain"
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+)
+
+// validatorsDir is the name of the directory inside the chain home where
+// the homes of the additional validators are created by default.
+const validatorsDir = "validators"
+
+// ValidatorHome returns the home directory of the node that runs the validator
+// defined at the given index of the validators list in the config.
+// The first validator always uses the chain home while the other validators
+// use their configured home or a directory inside the chain home.
+func (c *Chain) ValidatorHome(cfg *chainconfig.Config, index int) (string, error) {
+	if index < 0 || index >= len(cfg.Validators) {
+		return "", fmt.Errorf("validator index %d is out of range", index)
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return "", err
+	}
+
+	if index == 0 {
+		return home, nil
+	}
+
+	validator := cfg.Validators[index]
+	if validator.Home != "" {
+		return os.ExpandEnv(validator.Home), nil
+	}
+
+	return filepath.Join(home, validatorsDir, validator.Name), nil
+}
+
+// ValidatorCommands returns the runner to execute commands on the chain's binary
+// using the home and servers of the validator defined at the given index.
+func (c *Chain) ValidatorCommands(ctx context.Context, cfg *chainconfig.Config, index int) (chaincmdrunner.Runner, error) {
+	home, err := c.ValidatorHome(cfg, index)
+	if err != nil {
+		return chaincmdrunner.Runner{}, err
+	}
+
+	servers, err := cfg.Validators[index].GetServers()
+	if err != nil {
+		return chaincmdrunner.Runner{}, err
+	}
+
+	return c.commands(ctx, home, servers)
+}
+
+// initValidatorNodes initializes the nodes of the validators that follow the
+// first one. The first validator node is initialized in the chain home.
+func (c *Chain) initValidatorNodes(ctx context.Context, cfg *chainconfig.Config, initConfiguration bool) error {
+	for i := 1; i < len(cfg.Validators); i++ {
+		home, err := c.ValidatorHome(cfg, i)
+		if err != nil {
+			return err
+		}
+
+		// cleanup persistent data from previous `serve`.
+		if err := c.removeValidatorHome(home); err != nil {
+			return err
+		}
+
+		commands, err := c.ValidatorCommands(ctx, cfg, i)
+		if err != nil {
+			return err
+		}
+
+		validator := cfg.Validators[i]
+		if err := commands.Init(ctx, validator.Name); err != nil {
+			return err
+		}
+
+		if initConfiguration {
+			if err := c.configure(home, validator); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// removeValidatorHome removes the home of a validator node to initialize it again.
+// Homes outside the home of the chain are never removed because they can contain
+// the data of other nodes, they must be empty or not exist to be initialized.
+func (c *Chain) removeValidatorHome(home string) error {
+	chainHome, err := c.Home()
+	if err != nil {
+		return err
+	}
+
+	rel, err := filepath.Rel(chainHome, home)
+	if err == nil && rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return os.RemoveAll(home)
+	}
+
+	entries, err := os.ReadDir(home)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(entries) > 0 {
+		return fmt.Errorf(
+			"the validator home %s is outside of the chain home %s and it is not empty, remove it to initialize the validator again",
+			home,
+			chainHome,
+		)
+	}
+
+	return nil
+}
+
+// issueValidatorGentxs generates a gentx for each one of the validators that follow
+// the first one and copies them to the gentx directory of the chain home so
+// they are collected together with the gentx of the first validator.
+// Mnemonics contains the mnemonic of each account by name, which is used to
+// import the validator accounts into the keyring of the validator nodes.
+func (c *Chain) issueValidatorGentxs(ctx context.Context, cfg *chainconfig.Config, mnemonics map[string]string) error {
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	gentxsPath, err := c.GentxsPath()
+	if err != nil {
+		return err
+	}
+
+	for i := 1; i < len(cfg.Validators); i++ {
+		validator := cfg.Validators[i]
+		mnemonic, ok := mnemonics[validator.Name]
+		if !ok {
+			return fmt.Errorf(
+				"validator %s must be one of the accounts and the account must have a mnemonic or no address",
+				validator.Name,
+			)
+		}
+
+		home, err := c.ValidatorHome(cfg, i)
+		if err != nil {
+			return err
+		}
+
+		commands, err := c.ValidatorCommands(ctx, cfg, i)
+		if err != nil {
+			return err
+		}
+
+		if _, err := commands.AddAccount(ctx, validator.Name, mnemonic, accountCoinType(cfg, validator.Name)); err != nil {
+			return err
+		}
+
+		// the genesis must contain the validator account balance to be able to generate the gentx
+		if err := copy.Copy(genesisPath, filepath.Join(home, "config/genesis.json")); err != nil {
+			return err
+		}
+
+		gentxPath, err := c.Gentx(ctx, commands, createValidatorFromConfig(validator))
+		if err != nil {
+			return err
+		}
+
+		if err := copy.Copy(gentxPath, filepath.Join(gentxsPath, filepath.Base(gentxPath))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// shareGenesis copies the genesis of the chain home to the homes of the validators
+// that follow the first one, so all validator nodes start from the same state.
+func (c *Chain) shareGenesis(cfg *chainconfig.Config) error {
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	for i := 1; i < len(cfg.Validators); i++ {
+		home, err := c.ValidatorHome(cfg, i)
+		if err != nil {
+			return err
+		}
+
+		if err := copy.Copy(genesisPath, filepath.Join(home, "config/genesis.json")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// connectValidators configures each validator node to use the
+// other validator nodes as persistent peers.
+func (c *Chain) connectValidators(ctx context.Context, cfg *chainconfig.Config) error {
+	if len(cfg.Validators) < 2 {
+		return nil
+	}
+
+	peers := make([]string, len(cfg.Validators))
+	for i, validator := range cfg.Validators {
+		commands, err := c.ValidatorCommands(ctx, cfg, i)
+		if err != nil {
+			return err
+		}
+
+		nodeID, err := commands.ShowNodeID(ctx)
+		if err != nil {
+			return err
+		}
+
+		servers, err := validator.GetServers()
+		if err != nil {
+			return err
+		}
+
+		addr, err := peerAddress(servers.P2P.Address)
+		if err != nil {
+			return fmt.Errorf("invalid p2p address format %s: %w", servers.P2P.Address, err)
+		}
+
+		peers[i] = fmt.Sprintf("%s@%s", nodeID, addr)
+	}
+
+	for i := range cfg.Validators {
+		home, err := c.ValidatorHome(cfg, i)
+		if err != nil {
+			return err
+		}
+
+		var persistentPeers []string
+		for j, p := range peers {
+			if i != j {
+				persistentPeers = append(persistentPeers, p)
+			}
+		}
+
+		if err := setPersistentPeers(home, persistentPeers); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setPersistentPeers(homePath string, peers []string) error {
+	path := filepath.Join(homePath, "config/config.toml")
+	tmConfig, err := toml.LoadFile(path)
+	if err != nil {
+		return err
+	}
+
+	// All the validator nodes run in the same host
+	tmConfig.Set("p2p.persistent_peers", strings.Join(peers, ","))
+	tmConfig.Set("p2p.allow_duplicate_ip", true)
+	tmConfig.Set("p2p.addr_book_strict", false)
+
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = tmConfig.WriteTo(file)
+	return err
+}
+
+// peerAddress returns the host and port to use to dial a node listening at a P2P address.
+// Addresses that listen on all interfaces are dialed using the localhost address.
+func peerAddress(p2pAddr string) (string, error) {
+	addr, err := xurl.TCP(p2pAddr)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(addr)
+	if err != nil {
+		return "", err
+	}
+
+	host := u.Hostname()
+	if host == "" || host == "0.0.0.0" {
+		host = "127.0.0.1"
+	}
+
+	return net.JoinHostPort(host, u.Port()), nil
+}
+
+func accountCoinType(cfg *chainconfig.Config, name string) string {
+	for _, a := range cfg.Accounts {
+		if a.Name == name {
+			return a.CoinType
+		}
+	}
+	return ""
+}
@@ -0,0 +1,93 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainconfig "github.com/ignite/cli/ignite/config/chain"
+)
+
+func TestValidatorHome(t *testing.T) {
+	home := t.TempDir()
+	customHome := filepath.Join(t.TempDir(), "carol")
+	c := &Chain{options: chainOptions{homePath: home}}
+	cfg := &chainconfig.Config{
+		Validators: []chainconfig.Validator{
+			{Name: "alice", Bonded: "100stake"},
+			{Name: "bob", Bonded: "100stake"},
+			{Name: "carol", Bonded: "100stake", Home: customHome},
+		},
+	}
+
+	got, err := c.ValidatorHome(cfg, 0)
+	require.NoError(t, err)
+	require.Equal(t, home, got)
+
+	got, err = c.ValidatorHome(cfg, 1)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(home, validatorsDir, "bob"), got)
+
+	got, err = c.ValidatorHome(cfg, 2)
+	require.NoError(t, err)
+	require.Equal(t, customHome, got)
+
+	_, err = c.ValidatorHome(cfg, 3)
+	require.Error(t, err)
+}
+
+func TestRemoveValidatorHome(t *testing.T) {
+	home := t.TempDir()
+	c := &Chain{options: chainOptions{homePath: home}}
+
+	t.Run("home inside the chain home", func(t *testing.T) {
+		validatorHome := filepath.Join(home, validatorsDir, "bob")
+		require.NoError(t, os.MkdirAll(filepath.Join(validatorHome, "config"), 0o755))
+
+		require.NoError(t, c.removeValidatorHome(validatorHome))
+		require.NoDirExists(t, validatorHome)
+	})
+
+	t.Run("empty home outside the chain home", func(t *testing.T) {
+		validatorHome := t.TempDir()
+
+		require.NoError(t, c.removeValidatorHome(validatorHome))
+		require.NoError(t, c.removeValidatorHome(filepath.Join(validatorHome, "missing")))
+	})
+
+	t.Run("home outside the chain home", func(t *testing.T) {
+		validatorHome := t.TempDir()
+		require.NoError(t, os.Mkdir(filepath.Join(validatorHome, "config"), 0o755))
+
+		require.Error(t, c.removeValidatorHome(validatorHome))
+		require.DirExists(t, filepath.Join(validatorHome, "config"))
+	})
+
+	t.Run("chain home", func(t *testing.T) {
+		require.NoError(t, os.Mkdir(filepath.Join(home, "config"), 0o755))
+
+		require.Error(t, c.removeValidatorHome(home))
+		require.DirExists(t, filepath.Join(home, "config"))
+	})
+}
+
+func TestPeerAddress(t *testing.T) {
+	tests := []struct {
+		addr string
+		want string
+	}{
+		{addr: "0.0.0.0:26656", want: "127.0.0.1:26656"},
+		{addr: "tcp://0.0.0.0:26666", want: "127.0.0.1:26666"},
+		{addr: ":26676", want: "127.0.0.1:26676"},
+		{addr: "192.168.1.10:26656", want: "192.168.1.10:26656"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			got, err := peerAddress(tt.addr)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
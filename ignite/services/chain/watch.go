@@ -0,0 +1,341 @@
+package chain
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	chainconfig "github.com/ignite/cli/ignite/config/chain"
+	"github.com/ignite/cli/ignite/config/chain/base"
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/localfs"
+)
+
+// defaultWatchRules are the rules applied to the changed files that don't match the rules of the config.
+var defaultWatchRules = []base.WatchRule{
+	{
+		Paths:   []string{"app/**", "cmd/**", "x/**", "proto/**", "third_party/**"},
+		Exclude: []string{"**/*.pb.go", "**/*.pb.gw.go"},
+		Action:  base.WatchActionRebuild,
+	},
+}
+
+// defaultWatchExcludes are the paths that are never watched, whatever the rules.
+// The paths of the generated clients are excluded too.
+var defaultWatchExcludes = []string{"**/node_modules"}
+
+// watchRules are the rules to apply when the app files change.
+type watchRules struct {
+	// rules are checked in order, the first rule matching a path is applied.
+	rules []base.WatchRule
+
+	// exclude holds the glob patterns of the files and directories that are
+	// never watched, the files of excluded directories are excluded too.
+	exclude []string
+}
+
+// newWatchRules returns the rules to apply when the app files change.
+// The rules of the config are checked before the default ones.
+func newWatchRules(cfg *chainconfig.Config) watchRules {
+	var w watchRules
+	if cfg != nil {
+		w.rules = append(w.rules, cfg.Serve.Watch...)
+	} else {
+		cfg = &chainconfig.Config{}
+	}
+
+	w.rules = append(w.rules, defaultWatchRules...)
+	w.exclude = append(w.exclude, defaultWatchExcludes...)
+
+	openAPIPath := cfg.Client.OpenAPI.Path
+	if openAPIPath == "" {
+		openAPIPath = chainconfig.DefaultOpenAPIPath
+	}
+
+	// the generated clients must not trigger new actions when they are generated
+	for _, p := range []string{
+		chainconfig.TSClientPath(*cfg),
+		chainconfig.VuexPath(cfg),
+		chainconfig.ComposablesPath(cfg),
+		chainconfig.HooksPath(cfg),
+		openAPIPath,
+	} {
+		if !filepath.IsAbs(p) {
+			w.exclude = append(w.exclude, filepath.ToSlash(filepath.Clean(p)))
+		}
+	}
+
+	return w
+}
+
+// action returns the action of the first rule that matches a path relative to the app directory.
+// The changes of paths that don't match any rule are ignored.
+func (w watchRules) action(path string) (string, error) {
+	path = filepath.ToSlash(path)
+
+	excluded, err := w.excluded(path)
+	if err != nil || excluded {
+		return base.WatchActionIgnore, err
+	}
+
+	for _, r := range w.rules {
+		ok, err := matchAnyGlob(r.Paths, path)
+		if err != nil {
+			return "", err
+		}
+
+		if !ok {
+			continue
+		}
+
+		excluded, err := matchAnyGlob(r.Exclude, path)
+		if err != nil {
+			return "", err
+		}
+
+		if !excluded {
+			return r.Action, nil
+		}
+	}
+
+	return base.WatchActionIgnore, nil
+}
+
+// excluded returns true when a slash separated path relative to the app
+// directory is never watched.
+func (w watchRules) excluded(path string) (bool, error) {
+	for _, p := range w.exclude {
+		ok, err := matchAnyGlob([]string{p, p + "/**"}, path)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+
+	return false, nil
+}
+
+// roots returns the directories and files to watch for changes, relative to the app directory.
+// A rule with a path starting with "**" watches the whole app directory,
+// except the excluded paths.
+func (w watchRules) roots() []string {
+	var (
+		roots []string
+		seen  = make(map[string]bool)
+	)
+
+	for _, r := range w.rules {
+		if r.Action == base.WatchActionIgnore {
+			continue
+		}
+
+		for _, p := range r.Paths {
+			root := localfs.GlobRoot(p)
+			if !seen[root] {
+				seen[root] = true
+				roots = append(roots, root)
+			}
+		}
+	}
+
+	return roots
+}
+
+// watchedFiles returns the sorted paths of the app files with a watch action, relative to the app directory.
+// Hidden and excluded files and directories are ignored like when watching the changes.
+func watchedFiles(appPath string, rules watchRules, action string) ([]string, error) {
+	seen := make(map[string]bool)
+
+	for _, root := range rules.roots() {
+		err := filepath.Walk(filepath.Join(appPath, root), func(path string, info os.FileInfo, err error) error {
+			if os.IsNotExist(err) {
+				return nil
+			} else if err != nil {
+				return err
+			}
+
+			name, err := filepath.Rel(appPath, path)
+			if err != nil {
+				return err
+			}
+
+			if name != "." && strings.HasPrefix(info.Name(), ".") {
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+
+				return nil
+			}
+
+			if info.IsDir() {
+				if excluded, err := rules.excluded(filepath.ToSlash(name)); err != nil {
+					return err
+				} else if excluded {
+					return filepath.SkipDir
+				}
+
+				return nil
+			}
+
+			if seen[name] {
+				return nil
+			}
+
+			a, err := rules.action(name)
+			if err != nil {
+				return err
+			}
+
+			if a == action {
+				seen[name] = true
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	files := make([]string, 0, len(seen))
+	for name := range seen {
+		files = append(files, name)
+	}
+
+	sort.Strings(files)
+
+	return files, nil
+}
+
+// sourceChanged applies the watch actions of the changed files.
+// It returns true when the config file changed, in which case the rules
+// must be read again from the config.
+// The changes are ignored while watching the source code is paused.
+func (c *Chain) sourceChanged(rules watchRules, paths []string) (configChanged bool) {
+	var (
+		actions    = make(map[string]bool)
+		configPath = c.ConfigPath()
+	)
+
+	for _, p := range paths {
+		// changes in the config file are handled when the chain is served again
+		if p == configPath {
+			actions[base.WatchActionRebuild] = true
+			configChanged = true
+			continue
+		}
+
+		name, err := filepath.Rel(c.app.Path, p)
+		if err != nil {
+			c.ev.SendError(err)
+			return configChanged
+		}
+
+		action, err := rules.action(name)
+		if err != nil {
+			c.ev.SendError(err)
+			return configChanged
+		}
+
+		actions[action] = true
+	}
+
+	delete(actions, base.WatchActionIgnore)
+
+	if len(actions) == 0 || c.control.sourceChanged() {
+		return configChanged
+	}
+
+	// the app is built and the clients are generated when the chain is served again
+	if actions[base.WatchActionRebuild] || actions[base.WatchActionRestart] {
+		c.refreshServe()
+		return configChanged
+	}
+
+	// the code is generated by the serve loop to not generate it while the app is built
+	for _, a := range []string{base.WatchActionProto, base.WatchActionClients} {
+		if actions[a] {
+			c.generateQueue.push(a)
+		}
+	}
+
+	return configChanged
+}
+
+// generate runs the code generation actions requested by the source code changes.
+func (c *Chain) generate(ctx context.Context, cacheStorage cache.Storage, actions map[string]bool) {
+	if actions[base.WatchActionProto] {
+		if err := c.Generate(ctx, cacheStorage, GenerateGo()); err != nil {
+			c.ev.SendError(err, events.ProgressFinish())
+			return
+		}
+
+		c.ev.Send("Go code generated from the proto files", events.Icon(icons.OK), events.ProgressFinish())
+	}
+
+	if actions[base.WatchActionClients] {
+		if err := c.generateClients(ctx, cacheStorage); err != nil {
+			c.ev.SendError(err, events.ProgressFinish())
+			return
+		}
+
+		c.ev.Send("Clients generated", events.Icon(icons.OK), events.ProgressFinish())
+	}
+}
+
+// generateQueue holds the code generation actions requested by the source
+// code changes until the serve loop runs them.
+type generateQueue struct {
+	mu      sync.Mutex
+	actions map[string]bool
+
+	// ready receives a value when actions are pending.
+	ready chan struct{}
+}
+
+func newGenerateQueue() *generateQueue {
+	return &generateQueue{
+		actions: make(map[string]bool),
+		ready:   make(chan struct{}, 1),
+	}
+}
+
+// push adds an action to the queue, the action is run once even when it's
+// pushed multiple times before the serve loop runs it.
+func (q *generateQueue) push(action string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.actions[action] = true
+
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+// take returns and clears the pending actions.
+func (q *generateQueue) take() map[string]bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	actions := q.actions
+	q.actions = make(map[string]bool)
+
+	return actions
+}
+
+func matchAnyGlob(patterns []string, path string) (bool, error) {
+	for _, p := range patterns {
+		ok, err := localfs.MatchGlob(p, path)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,125 @@
+package chain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainconfig "github.com/ignite/cli/ignite/config/chain"
+	"github.com/ignite/cli/ignite/config/chain/base"
+)
+
+func TestWatchAction(t *testing.T) {
+	cfg := &chainconfig.Config{}
+	cfg.Serve.Watch = []base.WatchRule{
+		{
+			Paths:  []string{"proto/**/*.proto"},
+			Action: base.WatchActionProto,
+		},
+		{
+			Paths:   []string{"x/**/client/**"},
+			Exclude: []string{"**/*_test.go"},
+			Action:  base.WatchActionRestart,
+		},
+		{
+			Paths:  []string{"docs/**"},
+			Action: base.WatchActionIgnore,
+		},
+		{
+			Paths:  []string{"**/*.ts"},
+			Action: base.WatchActionClients,
+		},
+	}
+	rules := newWatchRules(cfg)
+
+	tests := []struct {
+		path, want string
+	}{
+		{path: "proto/blog/v1/post.proto", want: base.WatchActionProto},
+		{path: "proto/buf.yaml", want: base.WatchActionRebuild},
+		{path: "x/blog/client/cli/tx.go", want: base.WatchActionRestart},
+		{path: "x/blog/client/cli/tx_test.go", want: base.WatchActionRebuild},
+		{path: "x/blog/keeper/keeper.go", want: base.WatchActionRebuild},
+		{path: "x/blog/types/tx.pb.go", want: base.WatchActionIgnore},
+		{path: "docs/static/openapi.yml", want: base.WatchActionIgnore},
+		{path: "README.md", want: base.WatchActionIgnore},
+		{path: "web/src/main.ts", want: base.WatchActionClients},
+		{path: "web/node_modules/lib/index.ts", want: base.WatchActionIgnore},
+		{path: "ts-client/index.ts", want: base.WatchActionIgnore},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			action, err := rules.action(tt.path)
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, action)
+		})
+	}
+}
+
+func TestWatchRoots(t *testing.T) {
+	cfg := &chainconfig.Config{}
+	cfg.Serve.Watch = []base.WatchRule{
+		{Paths: []string{"proto/**/*.proto"}, Action: base.WatchActionProto},
+		{Paths: []string{"docs/**"}, Action: base.WatchActionIgnore},
+		{Paths: []string{"*.md"}, Action: base.WatchActionRestart},
+	}
+
+	require.Equal(t, []string{"proto", ".", "app", "cmd", "x", "third_party"}, newWatchRules(cfg).roots())
+}
+
+func TestWatchedFiles(t *testing.T) {
+	// Arrange
+	appPath := t.TempDir()
+	files := []string{
+		"app/app.go",
+		"x/blog/keeper/keeper.go",
+		"x/blog/types/tx.pb.go",
+		"x/blog/.hidden/file.go",
+		"proto/blog/v1/post.proto",
+		"docs/static/openapi.yml",
+		"web/node_modules/lib/index.ts",
+		"web/src/main.ts",
+	}
+	for _, f := range files {
+		path := filepath.Join(appPath, f)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, nil, 0o644))
+	}
+
+	cfg := &chainconfig.Config{}
+	cfg.Serve.Watch = []base.WatchRule{
+		{Paths: []string{"proto/**/*.proto"}, Action: base.WatchActionProto},
+		{Paths: []string{"**/*.ts"}, Action: base.WatchActionRestart},
+	}
+	rules := newWatchRules(cfg)
+
+	// Act
+	rebuildFiles, err := watchedFiles(appPath, rules, base.WatchActionRebuild)
+	restartFiles, err2 := watchedFiles(appPath, rules, base.WatchActionRestart)
+
+	// Assert
+	require.NoError(t, err)
+	require.NoError(t, err2)
+	require.Equal(t, []string{"app/app.go", "x/blog/keeper/keeper.go"}, rebuildFiles)
+	require.Equal(t, []string{"web/src/main.ts"}, restartFiles)
+}
+
+func TestGenerateQueue(t *testing.T) {
+	q := newGenerateQueue()
+
+	q.push(base.WatchActionProto)
+	q.push(base.WatchActionClients)
+	q.push(base.WatchActionProto)
+
+	require.Len(t, q.ready, 1)
+	<-q.ready
+	require.Equal(t, map[string]bool{
+		base.WatchActionProto:   true,
+		base.WatchActionClients: true,
+	}, q.take())
+	require.Empty(t, q.take())
+}
@@ -0,0 +1,465 @@
+// Package ibcdev serves several chains for development and relays IBC packets between them.
+package ibcdev
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"golang.org/x/sync/errgroup"
+
+	chainconfig "github.com/ignite/cli/ignite/config/chain"
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmosutil"
+	"github.com/ignite/cli/ignite/pkg/ctxticker"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/relayer"
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+	"github.com/ignite/cli/ignite/pkg/xurl"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+const (
+	// defaultGasPrice is the gas price amount used by the relayer, the denom is the staking denom of the chain.
+	defaultGasPrice = "0.025"
+
+	readyCheckInterval = time.Second
+	relinkDelay        = time.Second * 5
+	restartDelay       = time.Second * 5
+)
+
+// Dev is an IBC development environment that serves chains and relays packets between them.
+type Dev struct {
+	chains  []*chain.Chain
+	ev      events.Bus
+	port    string
+	version string
+	ordered bool
+
+	// relink is signaled when the chains must be linked again.
+	relink chan struct{}
+
+	// heights are the latest block heights of the chains by chain ID.
+	heights   map[string]int64
+	heightsMu sync.Mutex
+}
+
+// Option configures the IBC development environment.
+type Option func(*Dev)
+
+// CollectEvents sets the event bus of the relayer.
+func CollectEvents(ev events.Bus) Option {
+	return func(d *Dev) {
+		d.ev = ev
+	}
+}
+
+// Channel sets the port and the version of the channels created between the chains.
+// A transfer channel is created by default.
+func Channel(port, version string) Option {
+	return func(d *Dev) {
+		d.port = port
+		d.version = version
+	}
+}
+
+// Ordered creates ordered channels between the chains.
+func Ordered() Option {
+	return func(d *Dev) {
+		d.ordered = true
+	}
+}
+
+// New creates a new IBC development environment for the chains.
+// At least two chains are required and each one must use its own chain ID and RPC address.
+func New(chains []*chain.Chain, options ...Option) (*Dev, error) {
+	if len(chains) < 2 {
+		return nil, errors.New("at least two chains are required")
+	}
+
+	d := &Dev{
+		chains:  chains,
+		port:    relayer.TransferPort,
+		version: relayer.TransferVersion,
+		relink:  make(chan struct{}, 1),
+		heights: make(map[string]int64),
+	}
+	for _, apply := range options {
+		apply(d)
+	}
+
+	var (
+		configPaths  = make(map[string]string)
+		chainIDs     = make(map[string]string)
+		rpcAddresses = make(map[string]string)
+	)
+	for _, c := range chains {
+		configPath := c.ConfigPath()
+		if name, ok := configPaths[configPath]; ok {
+			return nil, fmt.Errorf("chains %q and %q are served with the same config %s", name, c.Name(), configPath)
+		}
+
+		configPaths[configPath] = c.Name()
+
+		id, err := c.ID()
+		if err != nil {
+			return nil, err
+		}
+
+		if name, ok := chainIDs[id]; ok {
+			return nil, fmt.Errorf("chains %q and %q use the same chain ID %s", name, c.Name(), id)
+		}
+
+		chainIDs[id] = c.Name()
+
+		addr, err := c.RPCPublicAddress()
+		if err != nil {
+			return nil, err
+		}
+
+		if name, ok := rpcAddresses[addr]; ok {
+			return nil, fmt.Errorf(
+				"chains %q and %q use the same RPC address %s, change the validator ports in the config of one of them",
+				name,
+				c.Name(),
+				addr,
+			)
+		}
+
+		rpcAddresses[addr] = c.Name()
+	}
+
+	return d, nil
+}
+
+// Run serves the chains and relays packets between them until ctx is canceled.
+// A channel is created between each pair of chains once they produce blocks,
+// the chains are linked again when their state is reset.
+func (d *Dev) Run(ctx context.Context, cacheStorage cache.Storage, options ...chain.ServeOption) error {
+	g, ctx := errgroup.WithContext(ctx)
+
+	options = append(options[:len(options):len(options)], chain.ServeLifecycle(d.handleLifecycle))
+
+	for _, c := range d.chains {
+		c := c
+		g.Go(func() error {
+			return c.Serve(ctx, cacheStorage, options...)
+		})
+	}
+
+	g.Go(func() error {
+		return d.relay(ctx)
+	})
+
+	return g.Wait()
+}
+
+// handleLifecycle requests to link the chains again when the state of a chain is reset.
+// The state is also reset when a node restarts from a lower height, which happens
+// when the state of the chain is imported in a new genesis.
+func (d *Dev) handleLifecycle(e chain.LifecycleEvent) error {
+	d.heightsMu.Lock()
+	defer d.heightsMu.Unlock()
+
+	switch e.Type {
+	case chain.LifecycleStateReset, chain.LifecycleChainInitialized:
+		d.requestRelink()
+	case chain.LifecycleNodeStarted:
+		if e.NodeStarted.Height < d.heights[e.ChainID] {
+			d.requestRelink()
+		}
+		d.heights[e.ChainID] = e.NodeStarted.Height
+	case chain.LifecycleNewBlock:
+		d.heights[e.ChainID] = e.NewBlock.Height
+	}
+
+	return nil
+}
+
+func (d *Dev) requestRelink() {
+	select {
+	case d.relink <- struct{}{}:
+	default:
+	}
+}
+
+func (d *Dev) relay(ctx context.Context) error {
+	for {
+		r, conf, err := d.link(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if err != nil {
+			// the chains are usually reset or rebuilt, wait for them to be linked again
+			d.ev.SendError(fmt.Errorf("failed to link the chains: %w", err))
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(relinkDelay):
+			}
+
+			continue
+		}
+
+		if err := d.start(ctx, r, conf); err != nil {
+			return err
+		}
+	}
+}
+
+// link waits until the chains produce blocks and creates a channel between each pair of chains.
+func (d *Dev) link(ctx context.Context) (relayer.Relayer, relayerconf.Config, error) {
+	d.ev.Send("Waiting for the chains to produce blocks...", events.ProgressStart())
+
+	ca, err := cosmosaccount.NewInMemory()
+	if err != nil {
+		return relayer.Relayer{}, relayerconf.Config{}, err
+	}
+
+	chains := make([]relayerconf.Chain, len(d.chains))
+	for i, c := range d.chains {
+		if chains[i], err = d.prepareChain(ctx, ca, c); err != nil {
+			return relayer.Relayer{}, relayerconf.Config{}, err
+		}
+	}
+
+	// the chains are linked with their current state
+	select {
+	case <-d.relink:
+	default:
+	}
+
+	conf := newRelayerConfig(chains, d.port, d.version, d.ordered)
+	r := relayer.New(ca)
+
+	for _, p := range conf.Paths {
+		d.ev.Send(fmt.Sprintf("Linking %s and %s...", p.Src.ChainID, p.Dst.ChainID), events.ProgressUpdate())
+
+		if conf, err = r.Link(ctx, conf, p.ID); err != nil {
+			return relayer.Relayer{}, relayerconf.Config{}, fmt.Errorf("link %s: %w", p.ID, err)
+		}
+	}
+
+	for _, p := range conf.Paths {
+		p, _ = conf.PathByID(p.ID)
+		d.ev.Send(
+			fmt.Sprintf(
+				"Linked %s (%s) and %s (%s)",
+				p.Src.ChainID,
+				p.Src.ChannelID,
+				p.Dst.ChainID,
+				p.Dst.ChannelID,
+			),
+			events.Icon(icons.OK),
+			events.ProgressFinish(),
+		)
+	}
+
+	return r, conf, nil
+}
+
+// start relays the packets of the linked paths until ctx is canceled or the chains
+// must be linked again. The relaying of a path is restarted when it fails, because
+// the errors are usually transient while a chain is rebuilt or restarted.
+func (d *Dev) start(ctx context.Context, r relayer.Relayer, conf relayerconf.Config) error {
+	d.ev.Send("Relaying packets between the chains", events.Icon(icons.OK), events.ProgressFinish())
+
+	var (
+		wg             sync.WaitGroup
+		startCtx, stop = context.WithCancel(ctx)
+	)
+
+	for _, p := range conf.Paths {
+		id := p.ID
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.startPath(startCtx, r, conf, id)
+		}()
+	}
+
+	defer wg.Wait()
+	defer stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-d.relink:
+		d.ev.Send("The state of a chain was reset, the chains are linked again")
+		return nil
+	}
+}
+
+// startPath relays the packets of a path until ctx is canceled.
+func (d *Dev) startPath(ctx context.Context, r relayer.Relayer, conf relayerconf.Config, pathID string) {
+	for {
+		err := r.Start(ctx, conf, pathID, nil)
+		if ctx.Err() != nil {
+			return
+		}
+
+		d.ev.SendError(fmt.Errorf("relayer of %s stopped, restarting: %w", pathID, err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(restartDelay):
+		}
+	}
+}
+
+// prepareChain waits until the chain produces blocks and imports the relayer account
+// of the chain into ca. The first development account with a mnemonic is used.
+func (d *Dev) prepareChain(ctx context.Context, ca cosmosaccount.Registry, c *chain.Chain) (relayerconf.Chain, error) {
+	rpcAddress, err := c.RPCPublicAddress()
+	if err != nil {
+		return relayerconf.Chain{}, err
+	}
+
+	if rpcAddress, err = xurl.HTTP(rpcAddress); err != nil {
+		return relayerconf.Chain{}, err
+	}
+
+	if err := waitForBlocks(ctx, rpcAddress); err != nil {
+		return relayerconf.Chain{}, err
+	}
+
+	chainID, err := c.ID()
+	if err != nil {
+		return relayerconf.Chain{}, err
+	}
+
+	cfg, err := c.Config()
+	if err != nil {
+		return relayerconf.Chain{}, err
+	}
+
+	denom, err := stakingDenom(cfg)
+	if err != nil {
+		return relayerconf.Chain{}, err
+	}
+
+	accounts, err := c.DevAccounts()
+	if err != nil {
+		return relayerconf.Chain{}, err
+	}
+
+	account, err := relayerAccount(accounts)
+	if err != nil {
+		return relayerconf.Chain{}, fmt.Errorf("chain %s: %w", chainID, err)
+	}
+
+	prefix, err := cosmosutil.GetAddressPrefix(account.Address)
+	if err != nil {
+		return relayerconf.Chain{}, err
+	}
+
+	// the account is named after the chain to use a single registry for all the chains
+	if _, err := ca.Import(chainID, account.Mnemonic, ""); err != nil {
+		return relayerconf.Chain{}, err
+	}
+
+	return relayerconf.Chain{
+		ID:            chainID,
+		Account:       chainID,
+		AddressPrefix: prefix,
+		RPCAddress:    rpcAddress,
+		GasPrice:      defaultGasPrice + denom,
+	}, nil
+}
+
+// relayerAccount returns the first account with a mnemonic that uses the default coin type.
+func relayerAccount(accounts []chain.DevAccount) (chain.DevAccount, error) {
+	defaultCoinType := fmt.Sprint(sdk.CoinType)
+
+	for _, a := range accounts {
+		if a.Mnemonic != "" && (a.CoinType == "" || a.CoinType == defaultCoinType) {
+			return a, nil
+		}
+	}
+
+	return chain.DevAccount{}, errors.New("an account with a key created by Ignite is required to relay packets")
+}
+
+// stakingDenom returns the denom of the coins bonded by the first validator.
+func stakingDenom(cfg *chainconfig.Config) (string, error) {
+	validator, err := chainconfig.FirstValidator(cfg)
+	if err != nil {
+		return "", err
+	}
+
+	coin, err := sdk.ParseCoinNormalized(validator.Bonded)
+	if err != nil {
+		return "", err
+	}
+
+	return coin.Denom, nil
+}
+
+// newRelayerConfig creates a relayer config with a path between each pair of chains.
+func newRelayerConfig(chains []relayerconf.Chain, port, version string, ordered bool) relayerconf.Config {
+	ordering := relayer.OrderingUnordered
+	if ordered {
+		ordering = relayer.OrderingOrdered
+	}
+
+	conf := relayerconf.Config{
+		Version: relayerconf.SupportVersion,
+		Chains:  chains,
+	}
+
+	for i, src := range chains {
+		for _, dst := range chains[i+1:] {
+			conf.Paths = append(conf.Paths, relayerconf.Path{
+				ID:       relayer.PathID(src.ID, dst.ID),
+				Ordering: ordering,
+				Src: relayerconf.PathEnd{
+					ChainID: src.ID,
+					PortID:  port,
+					Version: version,
+				},
+				Dst: relayerconf.PathEnd{
+					ChainID: dst.ID,
+					PortID:  port,
+					Version: version,
+				},
+			})
+		}
+	}
+
+	return conf
+}
+
+// waitForBlocks waits until the node of the RPC address produces blocks.
+func waitForBlocks(ctx context.Context, rpcAddress string) error {
+	errReady := errors.New("ready")
+
+	err := ctxticker.DoNow(ctx, readyCheckInterval, func() error {
+		client, err := cosmosclient.New(ctx, cosmosclient.WithNodeAddress(rpcAddress))
+		if err != nil {
+			return nil
+		}
+
+		// the height is zero until the first block is committed
+		height, err := client.LatestBlockHeight(ctx)
+		if err != nil || height < 1 {
+			return nil
+		}
+
+		return errReady
+	})
+	if errors.Is(err, errReady) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,104 @@
+package ibcdev
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainconfig "github.com/ignite/cli/ignite/config/chain"
+	"github.com/ignite/cli/ignite/pkg/relayer"
+	relayerconf "github.com/ignite/cli/ignite/pkg/relayer/config"
+	"github.com/ignite/cli/ignite/services/chain"
+)
+
+func TestNewRelayerConfig(t *testing.T) {
+	// Arrange
+	chains := []relayerconf.Chain{{ID: "mars"}, {ID: "venus"}, {ID: "earth"}}
+
+	// Act
+	conf := newRelayerConfig(chains, "blog", "blog-1", true)
+
+	// Assert
+	require.Equal(t, chains, conf.Chains)
+	require.Len(t, conf.Paths, 3)
+
+	var ids []string
+	for _, p := range conf.Paths {
+		ids = append(ids, p.ID)
+		require.Equal(t, relayer.OrderingOrdered, p.Ordering)
+		require.Equal(t, "blog", p.Src.PortID)
+		require.Equal(t, "blog", p.Dst.PortID)
+		require.Equal(t, "blog-1", p.Dst.Version)
+		require.Empty(t, p.Src.ChannelID)
+	}
+
+	require.Equal(t, []string{"mars-venus", "mars-earth", "venus-earth"}, ids)
+}
+
+func TestRelayerAccount(t *testing.T) {
+	accounts := []chain.DevAccount{
+		{Name: "treasury", Address: "cosmos1treasury"},
+		{Name: "eve", Address: "cosmos1eve", Mnemonic: "eve words", CoinType: "7777"},
+		{Name: "alice", Address: "cosmos1alice", Mnemonic: "alice words"},
+	}
+
+	account, err := relayerAccount(accounts)
+	require.NoError(t, err)
+	require.Equal(t, "alice", account.Name)
+
+	_, err = relayerAccount(accounts[:2])
+	require.Error(t, err)
+}
+
+func TestStakingDenom(t *testing.T) {
+	cfg := &chainconfig.Config{
+		Validators: []chainconfig.Validator{{Name: "alice", Bonded: "100000000uatom"}},
+	}
+
+	denom, err := stakingDenom(cfg)
+	require.NoError(t, err)
+	require.Equal(t, "uatom", denom)
+
+	_, err = stakingDenom(&chainconfig.Config{})
+	require.Error(t, err)
+}
+
+func TestHandleLifecycle(t *testing.T) {
+	d := &Dev{
+		relink:  make(chan struct{}, 1),
+		heights: make(map[string]int64),
+	}
+	relinked := func() bool {
+		select {
+		case <-d.relink:
+			return true
+		default:
+			return false
+		}
+	}
+
+	// the chains are linked again when a chain is reset
+	require.NoError(t, d.handleLifecycle(chain.LifecycleEvent{Type: chain.LifecycleStateReset, ChainID: "mars"}))
+	require.True(t, relinked())
+
+	// the chains aren't linked again when a node restarts with its state
+	require.NoError(t, d.handleLifecycle(chain.LifecycleEvent{
+		Type:     chain.LifecycleNewBlock,
+		ChainID:  "mars",
+		NewBlock: &chain.NewBlockPayload{Height: 10},
+	}))
+	require.NoError(t, d.handleLifecycle(chain.LifecycleEvent{
+		Type:        chain.LifecycleNodeStarted,
+		ChainID:     "mars",
+		NodeStarted: &chain.NodeStartedPayload{Height: 11},
+	}))
+	require.False(t, relinked())
+
+	// the chains are linked again when a node restarts from a lower height
+	require.NoError(t, d.handleLifecycle(chain.LifecycleEvent{
+		Type:        chain.LifecycleNodeStarted,
+		ChainID:     "mars",
+		NodeStarted: &chain.NodeStartedPayload{Height: 1},
+	}))
+	require.True(t, relinked())
+}
@@ -0,0 +1,225 @@
+package plugin
+
+import (
+	"context"
+	"net/rpc"
+	"os"
+
+	"github.com/pkg/errors"
+
+	chainconfig "github.com/ignite/cli/ignite/config/chain"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/exec"
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+)
+
+// ErrAppNotFound is returned by the client API when the plugin isn't executed for an app.
+var ErrAppNotFound = errors.New("ignite is not executed in an app directory")
+
+// Chain is the chain of the app Ignite is executed for.
+type Chain interface {
+	// ID returns the ID of the chain.
+	ID() (string, error)
+	// AppPath returns the path of the app source code.
+	AppPath() string
+	// ConfigPath returns the path of the config file of the chain.
+	ConfigPath() string
+	// Home returns the home directory of the chain node.
+	Home() (string, error)
+	// Binary returns the name of the app binary.
+	Binary() (string, error)
+	// RPCPublicAddress returns the address of the RPC of the node served for development.
+	RPCPublicAddress() (string, error)
+	// Config returns the config of the chain.
+	Config() (*chainconfig.Config, error)
+}
+
+// ClientAPI allows plugins to call back into Ignite while they are executed.
+//
+//go:generate mockery --srcpkg . --name ClientAPI --structname PluginClientAPI --filename client_api.go --with-expecter
+type ClientAPI interface {
+	// GetChainInfo returns the information of the chain of the app.
+	GetChainInfo() (ChainInfo, error)
+
+	// GetModules returns the Cosmos SDK modules defined in the app.
+	GetModules() ([]module.Module, error)
+
+	// Scaffold executes an `ignite scaffold` command in the app directory.
+	// For example Scaffold("map", "post", "title") scaffolds a map of posts.
+	// The interactive questions are answered with yes and the chain can't be
+	// scaffolded because the command is executed for an existing app.
+	Scaffold(args ...string) error
+}
+
+// ChainInfo is the information of the chain of an app.
+type ChainInfo struct {
+	// ChainID is the ID of the chain.
+	ChainID string
+	// AppPath is the path of the app source code.
+	AppPath string
+	// ConfigPath is the path of the config file of the chain, empty when the
+	// default config is used.
+	ConfigPath string
+	// Home is the home directory of the chain node.
+	Home string
+	// Binary is the name of the app binary.
+	Binary string
+	// RPCAddress is the address of the RPC of the node served for development.
+	RPCAddress string
+	// Config is the config of the chain.
+	Config *chainconfig.Config
+}
+
+// APIOption configures the client API.
+type APIOption func(*clientAPI)
+
+// WithChain sets the chain of the app Ignite is executed for.
+func WithChain(c Chain) APIOption {
+	return func(api *clientAPI) {
+		api.chain = c
+	}
+}
+
+type clientAPI struct {
+	ctx   context.Context
+	chain Chain
+}
+
+// NewClientAPI creates the client API given to the plugins.
+// All the methods return ErrAppNotFound when no chain is defined.
+func NewClientAPI(ctx context.Context, options ...APIOption) ClientAPI {
+	api := &clientAPI{ctx: ctx}
+	for _, apply := range options {
+		apply(api)
+	}
+	return api
+}
+
+func (api *clientAPI) GetChainInfo() (ChainInfo, error) {
+	if api.chain == nil {
+		return ChainInfo{}, ErrAppNotFound
+	}
+
+	chainID, err := api.chain.ID()
+	if err != nil {
+		return ChainInfo{}, err
+	}
+
+	home, err := api.chain.Home()
+	if err != nil {
+		return ChainInfo{}, err
+	}
+
+	binary, err := api.chain.Binary()
+	if err != nil {
+		return ChainInfo{}, err
+	}
+
+	rpcAddress, err := api.chain.RPCPublicAddress()
+	if err != nil {
+		return ChainInfo{}, err
+	}
+
+	cfg, err := api.chain.Config()
+	if err != nil {
+		return ChainInfo{}, err
+	}
+
+	return ChainInfo{
+		ChainID:    chainID,
+		AppPath:    api.chain.AppPath(),
+		ConfigPath: api.chain.ConfigPath(),
+		Home:       home,
+		Binary:     binary,
+		RPCAddress: rpcAddress,
+		Config:     cfg,
+	}, nil
+}
+
+func (api *clientAPI) GetModules() ([]module.Module, error) {
+	if api.chain == nil {
+		return nil, ErrAppNotFound
+	}
+
+	cfg, err := api.chain.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	appPath := api.chain.AppPath()
+	return module.Discover(api.ctx, appPath, appPath, cfg.Build.Proto.Path)
+}
+
+func (api *clientAPI) Scaffold(args ...string) error {
+	if api.chain == nil {
+		return ErrAppNotFound
+	}
+
+	if len(args) > 0 && args[0] == "chain" {
+		return errors.New("a chain can't be scaffolded by a plugin executed for an app")
+	}
+
+	// the scaffold commands are executed by the running binary, so they behave
+	// like when they are executed by the user
+	bin, err := os.Executable()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// the commands are executed in the app directory instead of using the path
+	// flag because the path flag of some commands is the path of the scaffolded files
+	command := append([]string{bin, "scaffold"}, args...)
+	command = append(command, "--yes")
+
+	return exec.Exec(
+		api.ctx,
+		command,
+		exec.StepOption(step.Workdir(api.chain.AppPath())),
+		exec.StepOption(step.Stdout(os.Stdout)),
+		exec.StepOption(step.Stderr(os.Stderr)),
+	)
+}
+
+// ClientAPIRPC is an implementation of ClientAPI that talks over RPC.
+type ClientAPIRPC struct{ client *rpc.Client }
+
+// GetChainInfo implements ClientAPI.GetChainInfo.
+func (c *ClientAPIRPC) GetChainInfo() (ChainInfo, error) {
+	var resp ChainInfo
+	return resp, c.client.Call("Plugin.GetChainInfo", new(interface{}), &resp)
+}
+
+// GetModules implements ClientAPI.GetModules.
+func (c *ClientAPIRPC) GetModules() ([]module.Module, error) {
+	var resp []module.Module
+	return resp, c.client.Call("Plugin.GetModules", new(interface{}), &resp)
+}
+
+// Scaffold implements ClientAPI.Scaffold.
+func (c *ClientAPIRPC) Scaffold(args ...string) error {
+	var resp interface{}
+	return c.client.Call("Plugin.Scaffold", args, &resp)
+}
+
+// ClientAPIRPCServer is the RPC server that ClientAPIRPC talks to, it's served
+// by Ignite to the plugins.
+type ClientAPIRPCServer struct {
+	// This is the real implementation
+	Impl ClientAPI
+}
+
+func (s *ClientAPIRPCServer) GetChainInfo(_ interface{}, resp *ChainInfo) error {
+	var err error
+	*resp, err = s.Impl.GetChainInfo()
+	return err
+}
+
+func (s *ClientAPIRPCServer) GetModules(_ interface{}, resp *[]module.Module) error {
+	var err error
+	*resp, err = s.Impl.GetModules()
+	return err
+}
+
+func (s *ClientAPIRPCServer) Scaffold(args []string, _ *interface{}) error {
+	return s.Impl.Scaffold(args...)
+}
@@ -0,0 +1,141 @@
+package plugin_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	hplugin "github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	chainconfig "github.com/ignite/cli/ignite/config/chain"
+	"github.com/ignite/cli/ignite/config/chain/base"
+	"github.com/ignite/cli/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/ignite/services/plugin"
+	"github.com/ignite/cli/ignite/services/plugin/mocks"
+)
+
+func TestClientAPIRPC(t *testing.T) {
+	// Arrange
+	var (
+		chainInfo = plugin.ChainInfo{
+			ChainID:    "mars",
+			AppPath:    "/apps/mars",
+			Home:       "/home/.mars",
+			Binary:     "marsd",
+			RPCAddress: "localhost:26657",
+			Config: &chainconfig.Config{
+				Config: base.Config{
+					Genesis: map[string]interface{}{
+						"chain_id": "mars",
+						"app_state": map[string]interface{}{
+							"crisis": map[string]interface{}{"constant_fee": []interface{}{"1000stake"}},
+						},
+					},
+				},
+				Validators: []chainconfig.Validator{{Name: "alice", Bonded: "100000000stake"}},
+			},
+		}
+		modules = []module.Module{{Name: "blog", GoModulePath: "github.com/mars/x/blog"}}
+		api     = mocks.NewPluginClientAPI(t)
+		impl    = mocks.NewPluginInterface(t)
+	)
+
+	api.EXPECT().GetChainInfo().Return(chainInfo, nil)
+	api.EXPECT().GetModules().Return(modules, nil)
+	api.EXPECT().Scaffold("map", "post", "title").Return(errors.New("scaffold failed"))
+
+	impl.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ plugin.ExecutedCommand, api plugin.ClientAPI) error {
+			info, err := api.GetChainInfo()
+			require.NoError(t, err)
+			require.Equal(t, chainInfo, info)
+
+			mods, err := api.GetModules()
+			require.NoError(t, err)
+			require.Equal(t, modules, mods)
+
+			return api.Scaffold("map", "post", "title")
+		})
+
+	client, _ := hplugin.TestPluginRPCConn(t, map[string]hplugin.Plugin{
+		"test": &plugin.InterfacePlugin{Impl: impl},
+	}, nil)
+	defer client.Close()
+
+	raw, err := client.Dispense("test")
+	require.NoError(t, err)
+
+	// Act
+	err = raw.(plugin.Interface).Execute(plugin.ExecutedCommand{}, api)
+
+	// Assert
+	require.ErrorContains(t, err, "scaffold failed")
+}
+
+func TestClientAPIWithoutChain(t *testing.T) {
+	api := plugin.NewClientAPI(context.Background())
+
+	_, err := api.GetChainInfo()
+	require.ErrorIs(t, err, plugin.ErrAppNotFound)
+
+	_, err = api.GetModules()
+	require.ErrorIs(t, err, plugin.ErrAppNotFound)
+
+	require.ErrorIs(t, api.Scaffold("map", "post"), plugin.ErrAppNotFound)
+}
+
+type testChain struct {
+	plugin.Chain
+}
+
+func TestClientAPIScaffoldChain(t *testing.T) {
+	api := plugin.NewClientAPI(context.Background(), plugin.WithChain(testChain{}))
+
+	require.EqualError(t, api.Scaffold("chain", "mars"), "a chain can't be scaffolded by a plugin executed for an app")
+}
+
+func TestExecuteServeEventRPC(t *testing.T) {
+	// Arrange
+	var (
+		event = plugin.ServeEvent{
+			Type:    plugin.ServeNodeStarted,
+			ChainID: "mars",
+			NodeStarted: &plugin.NodeStartedPayload{
+				RPCAddress: "http://localhost:26657",
+				APIAddress: "http://localhost:1317",
+				Home:       "/home/.mars",
+				Height:     1,
+			},
+		}
+		api  = mocks.NewPluginClientAPI(t)
+		impl = mocks.NewPluginInterface(t)
+	)
+
+	api.EXPECT().GetChainInfo().Return(plugin.ChainInfo{ChainID: "mars"}, nil)
+
+	impl.EXPECT().
+		ExecuteServeEvent(event, mock.Anything).
+		RunAndReturn(func(_ plugin.ServeEvent, api plugin.ClientAPI) error {
+			info, err := api.GetChainInfo()
+			require.NoError(t, err)
+			require.Equal(t, "mars", info.ChainID)
+			return nil
+		})
+
+	client, _ := hplugin.TestPluginRPCConn(t, map[string]hplugin.Plugin{
+		"test": &plugin.InterfacePlugin{Impl: impl},
+	}, nil)
+	defer client.Close()
+
+	raw, err := client.Dispense("test")
+	require.NoError(t, err)
+
+	// Act
+	err = raw.(plugin.Interface).ExecuteServeEvent(event, api)
+
+	// Assert
+	require.NoError(t, err)
+}
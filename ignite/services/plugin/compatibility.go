@@ -0,0 +1,81 @@
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/blang/semver/v4"
+
+	"github.com/ignite/cli/ignite/version"
+)
+
+// InterfaceVersion is the version of the plugin Interface.
+// It's incremented every time the Interface or the types exchanged with the
+// plugins change in a way that breaks the plugins built for a previous version.
+const InterfaceVersion = 1
+
+// updatePluginFix is the fix of the incompatibilities that are solved by
+// updating the plugin.
+const updatePluginFix = "update the plugin with `ignite plugin update` or ask its authors to update it"
+
+// IncompatibleError is returned when a plugin isn't compatible with the running
+// Ignite version.
+type IncompatibleError struct {
+	// Reason explains why the plugin is incompatible.
+	Reason string
+	// Fix explains how to make the plugin compatible.
+	Fix string
+}
+
+func (e *IncompatibleError) Error() string {
+	return fmt.Sprintf("incompatible plugin: %s, %s", e.Reason, e.Fix)
+}
+
+// CheckCompatibility returns an IncompatibleError when the plugin of the
+// manifest can't be used with the running Ignite version.
+// The Ignite version range isn't checked when Ignite isn't a released version.
+func (m Manifest) CheckCompatibility() error {
+	switch {
+	case m.InterfaceVersion == 0:
+		return &IncompatibleError{
+			Reason: "the plugin doesn't declare the version of the plugin interface it implements, it's probably built for an older Ignite version",
+			Fix:    fmt.Sprintf("update the plugin to implement the plugin interface version %d", InterfaceVersion),
+		}
+	case m.InterfaceVersion < InterfaceVersion:
+		return &IncompatibleError{
+			Reason: fmt.Sprintf("the plugin implements the plugin interface version %d but Ignite requires the version %d", m.InterfaceVersion, InterfaceVersion),
+			Fix:    updatePluginFix,
+		}
+	case m.InterfaceVersion > InterfaceVersion:
+		return &IncompatibleError{
+			Reason: fmt.Sprintf("the plugin implements the plugin interface version %d but Ignite supports the version %d", m.InterfaceVersion, InterfaceVersion),
+			Fix:    "upgrade Ignite to a newer version",
+		}
+	}
+
+	if m.IgniteVersion == "" {
+		return nil
+	}
+
+	versionRange, err := semver.ParseRange(m.IgniteVersion)
+	if err != nil {
+		return &IncompatibleError{
+			Reason: fmt.Sprintf("the plugin Ignite version range %q is invalid: %s", m.IgniteVersion, err),
+			Fix:    "ask the plugin authors to fix the range",
+		}
+	}
+
+	current, err := semver.ParseTolerant(version.Version)
+	if err != nil {
+		// Ignite is built from the sources, the version is unknown
+		return nil
+	}
+
+	if !versionRange(current) {
+		return &IncompatibleError{
+			Reason: fmt.Sprintf("the plugin requires Ignite %s but the version is %s", m.IgniteVersion, version.Version),
+			Fix:    "install an Ignite version compatible with the plugin or update the plugin with `ignite plugin update`",
+		}
+	}
+
+	return nil
+}
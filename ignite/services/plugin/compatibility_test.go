@@ -0,0 +1,90 @@
+package plugin_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/services/plugin"
+	"github.com/ignite/cli/ignite/version"
+)
+
+func TestManifestCheckCompatibility(t *testing.T) {
+	tests := []struct {
+		name          string
+		version       string
+		manifest      plugin.Manifest
+		expectedError string
+	}{
+		{
+			name:     "ok: no Ignite version range",
+			version:  "v0.27.1",
+			manifest: plugin.Manifest{InterfaceVersion: plugin.InterfaceVersion},
+		},
+		{
+			name:    "ok: Ignite version in range",
+			version: "v0.27.1",
+			manifest: plugin.Manifest{
+				InterfaceVersion: plugin.InterfaceVersion,
+				IgniteVersion:    ">=0.27.0 <0.28.0",
+			},
+		},
+		{
+			name:    "ok: Ignite built from the sources",
+			version: "development",
+			manifest: plugin.Manifest{
+				InterfaceVersion: plugin.InterfaceVersion,
+				IgniteVersion:    ">=0.28.0",
+			},
+		},
+		{
+			name:          "fail: no interface version",
+			version:       "v0.27.1",
+			manifest:      plugin.Manifest{},
+			expectedError: "incompatible plugin: the plugin doesn't declare the version of the plugin interface it implements, it's probably built for an older Ignite version, update the plugin to implement the plugin interface version 1",
+		},
+		{
+			name:          "fail: newer interface version",
+			version:       "v0.27.1",
+			manifest:      plugin.Manifest{InterfaceVersion: plugin.InterfaceVersion + 1},
+			expectedError: "incompatible plugin: the plugin implements the plugin interface version 2 but Ignite supports the version 1, upgrade Ignite to a newer version",
+		},
+		{
+			name:    "fail: Ignite version out of range",
+			version: "v0.27.1",
+			manifest: plugin.Manifest{
+				InterfaceVersion: plugin.InterfaceVersion,
+				IgniteVersion:    ">=0.28.0 || <0.26.0",
+			},
+			expectedError: "incompatible plugin: the plugin requires Ignite >=0.28.0 || <0.26.0 but the version is v0.27.1, install an Ignite version compatible with the plugin or update the plugin with `ignite plugin update`",
+		},
+		{
+			name:    "fail: invalid Ignite version range",
+			version: "v0.27.1",
+			manifest: plugin.Manifest{
+				InterfaceVersion: plugin.InterfaceVersion,
+				IgniteVersion:    "latest",
+			},
+			expectedError: `incompatible plugin: the plugin Ignite version range "latest" is invalid`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			defer func(v string) { version.Version = v }(version.Version)
+			version.Version = tt.version
+
+			// Act
+			err := tt.manifest.CheckCompatibility()
+
+			// Assert
+			if tt.expectedError != "" {
+				var incompatibleErr *plugin.IncompatibleError
+				require.ErrorAs(t, err, &incompatibleErr)
+				require.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package plugin
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Flag is a serializable representation of pflag.Flag.
+type Flag struct {
+	Name      string // name as it appears on command line
+	Shorthand string // one-letter abbreviated flag
+	Usage     string // help message
+	DefValue  string // default value (as text); for usage message
+	Type      FlagType
+	Value     string
+	// Changed indicates if the user set the value of the flag.
+	Changed bool
+	// Persistent indicates wether or not the flag is propagated on children
+	// commands
+	Persistent bool
+	// Required indicates that the command fails when the flag isn't set,
+	// like cobra.Command.MarkFlagRequired.
+	Required bool
+	// Annotations holds the other annotations of the flag, like the shell
+	// completion hints added by cobra.Command.MarkFlagFilename or
+	// cobra.Command.MarkFlagDirname.
+	Annotations map[string][]string
+}
+
+// FlagType represents the pflag.Flag.Value.Type().
+type FlagType string
+
+// All the flag types supported by pflag.
+// If a plugin receives another type, it will output an error.
+const (
+	FlagTypeBool           FlagType = "bool"
+	FlagTypeBoolSlice      FlagType = "boolSlice"
+	FlagTypeBytesBase64    FlagType = "bytesBase64"
+	FlagTypeBytesHex       FlagType = "bytesHex"
+	FlagTypeCount          FlagType = "count"
+	FlagTypeDuration       FlagType = "duration"
+	FlagTypeDurationSlice  FlagType = "durationSlice"
+	FlagTypeFloat32        FlagType = "float32"
+	FlagTypeFloat32Slice   FlagType = "float32Slice"
+	FlagTypeFloat64        FlagType = "float64"
+	FlagTypeFloat64Slice   FlagType = "float64Slice"
+	FlagTypeInt            FlagType = "int"
+	FlagTypeInt8           FlagType = "int8"
+	FlagTypeInt16          FlagType = "int16"
+	FlagTypeInt32          FlagType = "int32"
+	FlagTypeInt32Slice     FlagType = "int32Slice"
+	FlagTypeInt64          FlagType = "int64"
+	FlagTypeInt64Slice     FlagType = "int64Slice"
+	FlagTypeIntSlice       FlagType = "intSlice"
+	FlagTypeIP             FlagType = "ip"
+	FlagTypeIPMask         FlagType = "ipMask"
+	FlagTypeIPNet          FlagType = "ipNet"
+	FlagTypeIPSlice        FlagType = "ipSlice"
+	FlagTypeString         FlagType = "string"
+	FlagTypeStringArray    FlagType = "stringArray"
+	FlagTypeStringSlice    FlagType = "stringSlice"
+	FlagTypeStringToInt    FlagType = "stringToInt"
+	FlagTypeStringToInt64  FlagType = "stringToInt64"
+	FlagTypeStringToString FlagType = "stringToString"
+	FlagTypeUint           FlagType = "uint"
+	FlagTypeUint8          FlagType = "uint8"
+	FlagTypeUint16         FlagType = "uint16"
+	FlagTypeUint32         FlagType = "uint32"
+	FlagTypeUint64         FlagType = "uint64"
+	FlagTypeUintSlice      FlagType = "uintSlice"
+)
+
+// feedFlagSet fills flagger with f.
+func (f Flag) feedFlagSet(fgr flagger) error {
+	fs := fgr.Flags()
+	if f.Persistent {
+		fs = fgr.PersistentFlags()
+	}
+	// The flag is defined with the zero value of its type, the default value
+	// and the value are then restored from their text representation.
+	switch f.Type {
+	case FlagTypeBool:
+		fs.BoolP(f.Name, f.Shorthand, false, f.Usage)
+	case FlagTypeBoolSlice:
+		fs.BoolSliceP(f.Name, f.Shorthand, nil, f.Usage)
+	case FlagTypeBytesBase64:
+		fs.BytesBase64P(f.Name, f.Shorthand, nil, f.Usage)
+	case FlagTypeBytesHex:
+		fs.BytesHexP(f.Name, f.Shorthand, nil, f.Usage)
+	case FlagTypeCount:
+		fs.CountP(f.Name, f.Shorthand, f.Usage)
+	case FlagTypeDuration:
+		fs.DurationP(f.Name, f.Shorthand, 0, f.Usage)
+	case FlagTypeDurationSlice:
+		fs.DurationSliceP(f.Name, f.Shorthand, nil, f.Usage)
+	case FlagTypeFloat32:
+		fs.Float32P(f.Name, f.Shorthand, 0, f.Usage)
+	case FlagTypeFloat32Slice:
+		fs.Float32SliceP(f.Name, f.Shorthand, nil, f.Usage)
+	case FlagTypeFloat64:
+		fs.Float64P(f.Name, f.Shorthand, 0, f.Usage)
+	case FlagTypeFloat64Slice:
+		fs.Float64SliceP(f.Name, f.Shorthand, nil, f.Usage)
+	case FlagTypeInt:
+		fs.IntP(f.Name, f.Shorthand, 0, f.Usage)
+	case FlagTypeInt8:
+		fs.Int8P(f.Name, f.Shorthand, 0, f.Usage)
+	case FlagTypeInt16:
+		fs.Int16P(f.Name, f.Shorthand, 0, f.Usage)
+	case FlagTypeInt32:
+		fs.Int32P(f.Name, f.Shorthand, 0, f.Usage)
+	case FlagTypeInt32Slice:
+		fs.Int32SliceP(f.Name, f.Shorthand, nil, f.Usage)
+	case FlagTypeInt64:
+		fs.Int64P(f.Name, f.Shorthand, 0, f.Usage)
+	case FlagTypeInt64Slice:
+		fs.Int64SliceP(f.Name, f.Shorthand, nil, f.Usage)
+	case FlagTypeIntSlice:
+		fs.IntSliceP(f.Name, f.Shorthand, nil, f.Usage)
+	case FlagTypeIP:
+		fs.IPP(f.Name, f.Shorthand, nil, f.Usage)
+	case FlagTypeIPMask:
+		fs.IPMaskP(f.Name, f.Shorthand, nil, f.Usage)
+	case FlagTypeIPNet:
+		fs.IPNetP(f.Name, f.Shorthand, net.IPNet{}, f.Usage)
+	case FlagTypeIPSlice:
+		fs.IPSliceP(f.Name, f.Shorthand, nil, f.Usage)
+	case FlagTypeString:
+		fs.StringP(f.Name, f.Shorthand, "", f.Usage)
+	case FlagTypeStringArray:
+		fs.StringArrayP(f.Name, f.Shorthand, nil, f.Usage)
+	case FlagTypeStringSlice:
+		fs.StringSliceP(f.Name, f.Shorthand, nil, f.Usage)
+	case FlagTypeStringToInt:
+		fs.StringToIntP(f.Name, f.Shorthand, nil, f.Usage)
+	case FlagTypeStringToInt64:
+		fs.StringToInt64P(f.Name, f.Shorthand, nil, f.Usage)
+	case FlagTypeStringToString:
+		fs.StringToStringP(f.Name, f.Shorthand, nil, f.Usage)
+	case FlagTypeUint:
+		fs.UintP(f.Name, f.Shorthand, 0, f.Usage)
+	case FlagTypeUint8:
+		fs.Uint8P(f.Name, f.Shorthand, 0, f.Usage)
+	case FlagTypeUint16:
+		fs.Uint16P(f.Name, f.Shorthand, 0, f.Usage)
+	case FlagTypeUint32:
+		fs.Uint32P(f.Name, f.Shorthand, 0, f.Usage)
+	case FlagTypeUint64:
+		fs.Uint64P(f.Name, f.Shorthand, 0, f.Usage)
+	case FlagTypeUintSlice:
+		fs.UintSliceP(f.Name, f.Shorthand, nil, f.Usage)
+	default:
+		return fmt.Errorf("flagset unmarshal: unhandled flag type %q in flag %#v", f.Type, f)
+	}
+
+	pf := fs.Lookup(f.Name)
+	// Value is empty for the flags declared in the manifests, in that case the
+	// flag holds its default value.
+	value := f.DefValue
+	if f.Value != "" || f.Changed {
+		value = f.Value
+	}
+	if value != "" && value != pf.Value.String() {
+		if err := setFlagValue(pf.Value, value); err != nil {
+			return fmt.Errorf("flagset unmarshal: invalid value %q for flag %q: %w", value, f.Name, err)
+		}
+	}
+	if f.DefValue != "" {
+		pf.DefValue = f.DefValue
+	}
+	pf.Changed = f.Changed
+
+	for k, v := range f.Annotations {
+		if err := fs.SetAnnotation(f.Name, k, v); err != nil {
+			return err
+		}
+	}
+	if f.Required {
+		if err := fs.SetAnnotation(f.Name, cobra.BashCompOneRequiredFlag, []string{"true"}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setFlagValue sets v from its text representation, as returned by v.String().
+func setFlagValue(v pflag.Value, s string) error {
+	// Slices and maps are written as a CSV record enclosed in brackets
+	trimmed := strings.TrimSuffix(strings.TrimPrefix(s, "["), "]")
+
+	if sv, ok := v.(pflag.SliceValue); ok {
+		if trimmed == "" {
+			return sv.Replace(nil)
+		}
+		ss, err := csv.NewReader(strings.NewReader(trimmed)).Read()
+		if err != nil {
+			return err
+		}
+		return sv.Replace(ss)
+	}
+
+	switch FlagType(v.Type()) {
+	case FlagTypeStringToInt, FlagTypeStringToInt64, FlagTypeStringToString:
+		if trimmed == "" {
+			return nil
+		}
+		return v.Set(trimmed)
+	}
+	return v.Set(s)
+}
+
+// flagger matches both cobra.Command and Command.
+type flagger interface {
+	Flags() *pflag.FlagSet
+	PersistentFlags() *pflag.FlagSet
+}
+
+func convertPFlags(fgr flagger) []Flag {
+	var ff []Flag
+	if fgr.Flags() != nil {
+		fgr.Flags().VisitAll(func(pf *pflag.Flag) {
+			ff = append(ff, convertPFlag(pf, false))
+		})
+	}
+	if fgr.PersistentFlags() != nil {
+		fgr.PersistentFlags().VisitAll(func(pf *pflag.Flag) {
+			ff = append(ff, convertPFlag(pf, true))
+		})
+	}
+	return ff
+}
+
+func convertPFlag(pf *pflag.Flag, persistent bool) Flag {
+	f := Flag{
+		Name:       pf.Name,
+		Shorthand:  pf.Shorthand,
+		Usage:      pf.Usage,
+		DefValue:   pf.DefValue,
+		Value:      pf.Value.String(),
+		Type:       FlagType(pf.Value.Type()),
+		Changed:    pf.Changed,
+		Persistent: persistent,
+	}
+	for k, v := range pf.Annotations {
+		if k == cobra.BashCompOneRequiredFlag {
+			f.Required = len(v) > 0 && v[0] == "true"
+			continue
+		}
+		if f.Annotations == nil {
+			f.Annotations = make(map[string][]string)
+		}
+		f.Annotations[k] = v
+	}
+	return f
+}
@@ -3,10 +3,8 @@ package plugin
 import (
 	"bytes"
 	"encoding/gob"
-	"fmt"
 	"net/rpc"
 	"os"
-	"strconv"
 	"strings"
 
 	"github.com/hashicorp/go-plugin"
@@ -18,6 +16,11 @@ func init() {
 	gob.Register(Manifest{})
 	gob.Register(ExecutedCommand{})
 	gob.Register(ExecutedHook{})
+	gob.Register(ServeEvent{})
+
+	// the chain config contains values of any type, like the genesis
+	gob.Register(map[string]interface{}{})
+	gob.Register([]interface{}{})
 }
 
 // An ignite plugin must implements the Plugin interface.
@@ -30,29 +33,45 @@ type Interface interface {
 	// Execute will be invoked by ignite when a plugin Command is executed.
 	// It is global for all commands declared in Manifest, if you have declared
 	// multiple commands, use cmd.Path to distinguish them.
-	Execute(cmd ExecutedCommand) error
+	// The api allows to call back into ignite, for instance to read the chain
+	// information, until Execute returns.
+	Execute(cmd ExecutedCommand, api ClientAPI) error
 
 	// ExecuteHookPre is invoked by ignite when a command specified by the Hook
 	// path is invoked.
 	// It is global for all hooks declared in Manifest, if you have declared
 	// multiple hooks, use hook.Name to distinguish them.
-	ExecuteHookPre(hook ExecutedHook) error
+	ExecuteHookPre(hook ExecutedHook, api ClientAPI) error
 	// ExecuteHookPost is invoked by ignite when a command specified by the hook
 	// path is invoked.
 	// It is global for all hooks declared in Manifest, if you have declared
 	// multiple hooks, use hook.Name to distinguish them.
-	ExecuteHookPost(hook ExecutedHook) error
+	ExecuteHookPost(hook ExecutedHook, api ClientAPI) error
 	// ExecuteHookCleanUp is invoked by ignite when a command specified by the
 	// hook path is invoked. Unlike ExecuteHookPost, it is invoked regardless of
 	// execution status of the command and hooks.
 	// It is global for all hooks declared in Manifest, if you have declared
 	// multiple hooks, use hook.Name to distinguish them.
-	ExecuteHookCleanUp(hook ExecutedHook) error
+	ExecuteHookCleanUp(hook ExecutedHook, api ClientAPI) error
+
+	// ExecuteServeEvent is invoked by ignite when a lifecycle event of a chain
+	// served with `ignite chain serve` occurs, for the event types declared in
+	// Manifest.ServeEvents.
+	// Use event.Type to distinguish the events, the payload that matches the
+	// type of the event is set.
+	ExecuteServeEvent(event ServeEvent, api ClientAPI) error
 }
 
 // Manifest represents the plugin behavior.
 type Manifest struct {
 	Name string
+	// IgniteVersion is the semver range of the Ignite versions the plugin is
+	// compatible with, for example ">=0.27.0 <0.28.0". Ranges can be combined
+	// with "||". An empty value means the plugin is compatible with any version.
+	IgniteVersion string
+	// InterfaceVersion is the version of the plugin Interface implemented by
+	// the plugin, it must be set to InterfaceVersion.
+	InterfaceVersion int
 	// Commands contains the commands that will be added to the list of ignite
 	// commands. Each commands are independent, for nested commands use the
 	// inner Commands field.
@@ -60,6 +79,11 @@ type Manifest struct {
 	// Hooks contains the hooks that will be attached to the existing ignite
 	// commands.
 	Hooks []Hook
+	// ServeEvents contains the types of the lifecycle events of
+	// `ignite chain serve` the plugin subscribes to, for example
+	// ServeNodeStarted.
+	// The events are delivered to ExecuteServeEvent while the chain is served.
+	ServeEvents []ServeEventType
 	// SharedHost enables sharing a single plugin server across all running instances
 	// of a plugin. Useful if a plugin adds or extends long running commands
 	//
@@ -216,75 +240,6 @@ func (c *ExecutedCommand) SetFlags(cmd *cobra.Command) {
 	c.pflags = cmd.PersistentFlags()
 }
 
-// Flag is a serializable representation of pflag.Flag.
-type Flag struct {
-	Name      string // name as it appears on command line
-	Shorthand string // one-letter abbreviated flag
-	Usage     string // help message
-	DefValue  string // default value (as text); for usage message
-	Type      FlagType
-	Value     string
-	// Persistent indicates wether or not the flag is propagated on children
-	// commands
-	Persistent bool
-}
-
-// FlagType represents the pflag.Flag.Value.Type().
-type FlagType string
-
-const (
-	// NOTE(tb): we declare only the main used cobra flag types for simplicity
-	// If a plugin receives an unhandled type, it will output an error.
-	FlagTypeString      FlagType = "string"
-	FlagTypeInt         FlagType = "int"
-	FlagTypeUint        FlagType = "uint"
-	FlagTypeInt64       FlagType = "int64"
-	FlagTypeUint64      FlagType = "uint64"
-	FlagTypeBool        FlagType = "bool"
-	FlagTypeStringSlice FlagType = "stringSlice"
-)
-
-// feedFlagSet fills flagger with f.
-func (f Flag) feedFlagSet(fgr flagger) error {
-	fs := fgr.Flags()
-	if f.Persistent {
-		fs = fgr.PersistentFlags()
-	}
-	switch f.Type {
-	case FlagTypeBool:
-		defVal, _ := strconv.ParseBool(f.DefValue)
-		fs.BoolP(f.Name, f.Shorthand, defVal, f.Usage)
-		fs.Set(f.Name, f.Value)
-	case FlagTypeInt:
-		defVal, _ := strconv.Atoi(f.DefValue)
-		fs.IntP(f.Name, f.Shorthand, defVal, f.Usage)
-		fs.Set(f.Name, f.Value)
-	case FlagTypeUint:
-		defVal, _ := strconv.ParseUint(f.DefValue, 10, 64)
-		fs.UintP(f.Name, f.Shorthand, uint(defVal), f.Usage)
-		fs.Set(f.Name, f.Value)
-	case FlagTypeInt64:
-		defVal, _ := strconv.ParseInt(f.DefValue, 10, 64)
-		fs.Int64P(f.Name, f.Shorthand, defVal, f.Usage)
-		fs.Set(f.Name, f.Value)
-	case FlagTypeUint64:
-		defVal, _ := strconv.ParseUint(f.DefValue, 10, 64)
-		fs.Uint64P(f.Name, f.Shorthand, defVal, f.Usage)
-		fs.Set(f.Name, f.Value)
-	case FlagTypeString:
-		fs.StringP(f.Name, f.Shorthand, f.DefValue, f.Usage)
-		fs.Set(f.Name, f.Value)
-	case FlagTypeStringSlice:
-		s := strings.Trim(f.DefValue, "[]")
-		defValue := strings.Fields(s)
-		fs.StringSliceP(f.Name, f.Shorthand, defValue, f.Usage)
-		fs.Set(f.Name, strings.Trim(f.Value, "[]"))
-	default:
-		return fmt.Errorf("flagset unmarshal: unhandled flag type %q in flag %#v", f.Type, f)
-	}
-	return nil
-}
-
 // gobCommandFlags is used to gob encode/decode Command.
 // Command can't be encoded because :
 // - flags is unexported (because we want to expose it via the Flags() method,
@@ -310,42 +265,6 @@ func (c ExecutedCommand) GobEncode() ([]byte, error) {
 	return b.Bytes(), err
 }
 
-// flagger matches both cobra.Command and Command.
-type flagger interface {
-	Flags() *pflag.FlagSet
-	PersistentFlags() *pflag.FlagSet
-}
-
-func convertPFlags(fgr flagger) []Flag {
-	var ff []Flag
-	if fgr.Flags() != nil {
-		fgr.Flags().VisitAll(func(pf *pflag.Flag) {
-			ff = append(ff, Flag{
-				Name:      pf.Name,
-				Shorthand: pf.Shorthand,
-				Usage:     pf.Usage,
-				DefValue:  pf.DefValue,
-				Value:     pf.Value.String(),
-				Type:      FlagType(pf.Value.Type()),
-			})
-		})
-	}
-	if fgr.PersistentFlags() != nil {
-		fgr.PersistentFlags().VisitAll(func(pf *pflag.Flag) {
-			ff = append(ff, Flag{
-				Name:       pf.Name,
-				Shorthand:  pf.Shorthand,
-				Usage:      pf.Usage,
-				DefValue:   pf.DefValue,
-				Value:      pf.Value.String(),
-				Type:       FlagType(pf.Value.Type()),
-				Persistent: true,
-			})
-		})
-	}
-	return ff
-}
-
 // GobDecode implements gob.Decoder.
 // It actually decodes a gobCommandContext struct and fills c with it.
 func (c *ExecutedCommand) GobDecode(bz []byte) error {
@@ -379,7 +298,10 @@ func HandshakeConfig() plugin.HandshakeConfig {
 }
 
 // InterfaceRPC is an implementation that talks over RPC.
-type InterfaceRPC struct{ client *rpc.Client }
+type InterfaceRPC struct {
+	client *rpc.Client
+	broker *plugin.MuxBroker
+}
 
 // Manifest implements Interface.Manifest.
 func (g *InterfaceRPC) Manifest() (Manifest, error) {
@@ -388,39 +310,62 @@ func (g *InterfaceRPC) Manifest() (Manifest, error) {
 }
 
 // Execute implements Interface.Commands.
-func (g *InterfaceRPC) Execute(c ExecutedCommand) error {
+func (g *InterfaceRPC) Execute(c ExecutedCommand, api ClientAPI) error {
 	var resp interface{}
 	return g.client.Call("Plugin.Execute", map[string]interface{}{
 		"executedCommand": c,
+		"clientAPI":       g.serveClientAPI(api),
 	}, &resp)
 }
 
-func (g *InterfaceRPC) ExecuteHookPre(hook ExecutedHook) error {
+func (g *InterfaceRPC) ExecuteHookPre(hook ExecutedHook, api ClientAPI) error {
 	var resp interface{}
 	return g.client.Call("Plugin.ExecuteHookPre", map[string]interface{}{
 		"executedHook": hook,
+		"clientAPI":    g.serveClientAPI(api),
 	}, &resp)
 }
 
-func (g *InterfaceRPC) ExecuteHookPost(hook ExecutedHook) error {
+func (g *InterfaceRPC) ExecuteHookPost(hook ExecutedHook, api ClientAPI) error {
 	var resp interface{}
 	return g.client.Call("Plugin.ExecuteHookPost", map[string]interface{}{
 		"executedHook": hook,
+		"clientAPI":    g.serveClientAPI(api),
 	}, &resp)
 }
 
-func (g *InterfaceRPC) ExecuteHookCleanUp(hook ExecutedHook) error {
+func (g *InterfaceRPC) ExecuteHookCleanUp(hook ExecutedHook, api ClientAPI) error {
 	var resp interface{}
 	return g.client.Call("Plugin.ExecuteHookCleanUp", map[string]interface{}{
 		"executedHook": hook,
+		"clientAPI":    g.serveClientAPI(api),
+	}, &resp)
+}
+
+func (g *InterfaceRPC) ExecuteServeEvent(event ServeEvent, api ClientAPI) error {
+	var resp interface{}
+	return g.client.Call("Plugin.ExecuteServeEvent", map[string]interface{}{
+		"serveEvent": event,
+		"clientAPI":  g.serveClientAPI(api),
 	}, &resp)
 }
 
+// serveClientAPI serves the client API on a new connection of the broker and
+// returns the connection ID. The plugin dials the connection before it's executed
+// and closes it once it's done.
+func (g *InterfaceRPC) serveClientAPI(api ClientAPI) uint32 {
+	id := g.broker.NextId()
+	go g.broker.AcceptAndServe(id, &ClientAPIRPCServer{Impl: api})
+	return id
+}
+
 // InterfaceRPCServer is the RPC server that InterfaceRPC talks to, conforming to
 // the requirements of net/rpc.
 type InterfaceRPCServer struct {
 	// This is the real implementation
 	Impl Interface
+
+	broker *plugin.MuxBroker
 }
 
 func (s *InterfaceRPCServer) Manifest(_ interface{}, resp *Manifest) error {
@@ -430,19 +375,46 @@ func (s *InterfaceRPCServer) Manifest(_ interface{}, resp *Manifest) error {
 }
 
 func (s *InterfaceRPCServer) Execute(args map[string]interface{}, _ *interface{}) error {
-	return s.Impl.Execute(args["executedCommand"].(ExecutedCommand))
+	return s.withClientAPI(args, func(api ClientAPI) error {
+		return s.Impl.Execute(args["executedCommand"].(ExecutedCommand), api)
+	})
 }
 
 func (s *InterfaceRPCServer) ExecuteHookPre(args map[string]interface{}, _ *interface{}) error {
-	return s.Impl.ExecuteHookPre(args["executedHook"].(ExecutedHook))
+	return s.withClientAPI(args, func(api ClientAPI) error {
+		return s.Impl.ExecuteHookPre(args["executedHook"].(ExecutedHook), api)
+	})
 }
 
 func (s *InterfaceRPCServer) ExecuteHookPost(args map[string]interface{}, _ *interface{}) error {
-	return s.Impl.ExecuteHookPost(args["executedHook"].(ExecutedHook))
+	return s.withClientAPI(args, func(api ClientAPI) error {
+		return s.Impl.ExecuteHookPost(args["executedHook"].(ExecutedHook), api)
+	})
 }
 
 func (s *InterfaceRPCServer) ExecuteHookCleanUp(args map[string]interface{}, _ *interface{}) error {
-	return s.Impl.ExecuteHookCleanUp(args["executedHook"].(ExecutedHook))
+	return s.withClientAPI(args, func(api ClientAPI) error {
+		return s.Impl.ExecuteHookCleanUp(args["executedHook"].(ExecutedHook), api)
+	})
+}
+
+func (s *InterfaceRPCServer) ExecuteServeEvent(args map[string]interface{}, _ *interface{}) error {
+	return s.withClientAPI(args, func(api ClientAPI) error {
+		return s.Impl.ExecuteServeEvent(args["serveEvent"].(ServeEvent), api)
+	})
+}
+
+// withClientAPI dials the client API served by ignite and calls f with it.
+func (s *InterfaceRPCServer) withClientAPI(args map[string]interface{}, f func(ClientAPI) error) error {
+	conn, err := s.broker.Dial(args["clientAPI"].(uint32))
+	if err != nil {
+		return err
+	}
+
+	client := rpc.NewClient(conn)
+	defer client.Close()
+
+	return f(&ClientAPIRPC{client: client})
 }
 
 // This is the implementation of plugin.Interface so we can serve/consume this
@@ -453,17 +425,17 @@ func (s *InterfaceRPCServer) ExecuteHookCleanUp(args map[string]interface{}, _ *
 // Client must return an implementation of our interface that communicates
 // over an RPC client. We return InterfaceRPC for this.
 //
-// Ignore MuxBroker. That is used to create more multiplexed streams on our
-// plugin connection and is a more advanced use case.
+// The MuxBroker is used to serve the ClientAPI to the plugin on another
+// multiplexed stream of the plugin connection.
 type InterfacePlugin struct {
 	// Impl Injection
 	Impl Interface
 }
 
-func (p *InterfacePlugin) Server(*plugin.MuxBroker) (interface{}, error) {
-	return &InterfaceRPCServer{Impl: p.Impl}, nil
+func (p *InterfacePlugin) Server(b *plugin.MuxBroker) (interface{}, error) {
+	return &InterfaceRPCServer{Impl: p.Impl, broker: b}, nil
 }
 
-func (InterfacePlugin) Client(_ *plugin.MuxBroker, c *rpc.Client) (interface{}, error) {
-	return &InterfaceRPC{client: c}, nil
+func (InterfacePlugin) Client(b *plugin.MuxBroker, c *rpc.Client) (interface{}, error) {
+	return &InterfaceRPC{client: c, broker: b}, nil
 }
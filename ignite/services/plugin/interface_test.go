@@ -1,9 +1,15 @@
 package plugin_test
 
 import (
+	"bytes"
+	"encoding/gob"
+	"io"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -167,3 +173,96 @@ func TestManifestImportCobraCommand(t *testing.T) {
 	}
 	assert.Equal(t, expectedManifest, manifest)
 }
+
+func TestCommandFlagsRoundTrip(t *testing.T) {
+	// Arrange
+	cmd := &cobra.Command{Use: "cmd"}
+	cmd.Flags().Duration("duration", time.Minute, "a duration")
+	cmd.Flags().Float64("float64", 1.5, "a float64")
+	cmd.Flags().IntSlice("int-slice", []int{1, 2}, "an int slice")
+	cmd.Flags().StringToString("string-to-string", map[string]string{"a": "b"}, "a string to string")
+	cmd.Flags().StringArray("string-array", []string{"a,b", "c"}, "a string array")
+	cmd.Flags().CountP("verbose", "v", "a count")
+	cmd.Flags().IPNet("ip-net", net.IPNet{IP: net.IPv4(10, 0, 0, 0), Mask: net.CIDRMask(8, 32)}, "an ip net")
+	cmd.Flags().String("file", "", "a file")
+	require.NoError(t, cmd.MarkFlagRequired("file"))
+	require.NoError(t, cmd.MarkFlagFilename("file", "yml"))
+	var manifest plugin.Manifest
+	manifest.ImportCobraCommand(cmd, "")
+
+	// Act
+	newCmd, err := manifest.Commands[0].ToCobraCommand()
+
+	// Assert
+	require.NoError(t, err)
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		nf := newCmd.Flags().Lookup(f.Name)
+		require.NotNil(t, nf, "missing flag %s", f.Name)
+		assert.Equal(t, f.Value.Type(), nf.Value.Type())
+		assert.Equal(t, f.Value.String(), nf.Value.String())
+		assert.Equal(t, f.DefValue, nf.DefValue)
+		assert.Equal(t, f.Shorthand, nf.Shorthand)
+		assert.Equal(t, f.NoOptDefVal, nf.NoOptDefVal)
+		assert.Equal(t, f.Annotations, nf.Annotations)
+		assert.False(t, nf.Changed)
+	})
+	newCmd.Run = func(*cobra.Command, []string) {}
+	newCmd.SetArgs(nil)
+	newCmd.SetOut(io.Discard)
+	newCmd.SetErr(io.Discard)
+	assert.EqualError(t, newCmd.Execute(), `required flag(s) "file" not set`)
+}
+
+func TestExecutedCommandGob(t *testing.T) {
+	// Arrange
+	cmd := &cobra.Command{Use: "cmd"}
+	cmd.Flags().Duration("duration", time.Minute, "a duration")
+	cmd.Flags().Float64("float64", 1.5, "a float64")
+	cmd.Flags().IntSlice("int-slice", []int{1, 2}, "an int slice")
+	cmd.Flags().StringToString("string-to-string", nil, "a string to string")
+	cmd.Flags().CountP("verbose", "v", "a count")
+	cmd.PersistentFlags().StringSlice("string-slice", nil, "a string slice")
+	require.NoError(t, cmd.ParseFlags([]string{
+		"--duration", "2h",
+		"--int-slice", "3,4",
+		"--string-to-string", "a=b,c=d",
+		"-vvv",
+		"--string-slice", "x,y",
+	}))
+	execCmd := plugin.ExecutedCommand{Use: "cmd"}
+	execCmd.SetFlags(cmd)
+
+	// Act
+	var b bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&b).Encode(execCmd))
+	var decoded plugin.ExecutedCommand
+	err := gob.NewDecoder(&b).Decode(&decoded)
+
+	// Assert
+	require.NoError(t, err)
+	duration, _ := decoded.Flags().GetDuration("duration")
+	assert.Equal(t, 2*time.Hour, duration)
+	assert.True(t, decoded.Flags().Changed("duration"))
+	float, _ := decoded.Flags().GetFloat64("float64")
+	assert.Equal(t, 1.5, float)
+	assert.False(t, decoded.Flags().Changed("float64"))
+	intSlice, _ := decoded.Flags().GetIntSlice("int-slice")
+	assert.Equal(t, []int{3, 4}, intSlice)
+	stringToString, _ := decoded.Flags().GetStringToString("string-to-string")
+	assert.Equal(t, map[string]string{"a": "b", "c": "d"}, stringToString)
+	count, _ := decoded.Flags().GetCount("verbose")
+	assert.Equal(t, 3, count)
+	stringSlice, _ := decoded.PersistentFlags().GetStringSlice("string-slice")
+	assert.Equal(t, []string{"x", "y"}, stringSlice)
+}
+
+func TestCommandToCobraCommandUnhandledFlagType(t *testing.T) {
+	pcmd := plugin.Command{
+		Use:   "new",
+		Flags: []plugin.Flag{{Name: "custom", Type: "custom"}},
+	}
+
+	_, err := pcmd.ToCobraCommand()
+
+	require.ErrorContains(t, err, `unhandled flag type "custom"`)
+}
@@ -0,0 +1,200 @@
+package plugin
+
+import (
+	"context"
+	"debug/buildinfo"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	pluginsconfig "github.com/ignite/cli/ignite/config/plugins"
+	"github.com/ignite/cli/ignite/pkg/checksum"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/gocmd"
+	"github.com/ignite/cli/ignite/pkg/xgit"
+)
+
+// LockMismatchError is returned when a remote plugin doesn't match its entry
+// in the lock file. Running `ignite plugin update` accepts the plugin as it is
+// and replaces its entry.
+type LockMismatchError struct {
+	// Path is the path of the plugin.
+	Path string
+	// Field is the name of the locked value that doesn't match.
+	Field string
+	// Locked is the value found in the lock file.
+	Locked string
+	// Actual is the value of the plugin.
+	Actual string
+}
+
+func (e *LockMismatchError) Error() string {
+	return fmt.Sprintf(
+		"plugin %q doesn't match the lock file: %s is %q but %q is locked, run `ignite plugin update %s` to accept the change",
+		e.Path,
+		e.Field,
+		e.Actual,
+		e.Locked,
+		e.Path,
+	)
+}
+
+// locked returns the entry of the plugin in the lock file.
+func (p *Plugin) locked() (pluginsconfig.LockedPlugin, bool) {
+	if p.lock == nil {
+		return pluginsconfig.LockedPlugin{}, false
+	}
+	return p.lock.Get(p.Path)
+}
+
+// checkLock verifies that the plugin matches its entry in the lock file.
+// Plugins that aren't locked yet are added to the lock file.
+func (p *Plugin) checkLock(ctx context.Context) {
+	if p.Error != nil || p.lock == nil {
+		return
+	}
+
+	commit, err := xgit.HeadCommit(p.cloneDir)
+	if err != nil {
+		p.Error = errors.Wrap(err, "plugin lock")
+		return
+	}
+
+	lp, ok := p.locked()
+	if ok && lp.Commit != commit {
+		// The lock file changed since the plugin was fetched, for example after
+		// pulling the changes of a teammate, fetch the locked commit instead.
+		if err := p.clean(); err != nil {
+			p.Error = err
+			return
+		}
+		p.fetch()
+		p.build(ctx)
+		if p.Error != nil {
+			return
+		}
+		if commit, err = xgit.HeadCommit(p.cloneDir); err != nil {
+			p.Error = errors.Wrap(err, "plugin lock")
+			return
+		}
+		if lp.Commit != commit {
+			p.Error = &LockMismatchError{Path: p.Path, Field: "commit", Locked: lp.Commit, Actual: commit}
+			return
+		}
+	}
+
+	goSum, err := goSumChecksum(p.srcPath)
+	if err != nil {
+		p.Error = errors.Wrap(err, "plugin lock")
+		return
+	}
+	info, err := buildinfo.ReadFile(p.binaryPath())
+	if err != nil {
+		p.Error = errors.Wrap(err, "plugin lock")
+		return
+	}
+	sum, err := checksum.File(p.binaryPath())
+	if err != nil {
+		p.Error = errors.Wrap(err, "plugin lock")
+		return
+	}
+	target := gocmd.BuildTarget(buildSetting(info, "GOOS"), buildSetting(info, "GOARCH"))
+
+	if !ok {
+		lp = pluginsconfig.LockedPlugin{
+			Path:      p.Path,
+			Commit:    commit,
+			GoSum:     goSum,
+			GoVersion: info.GoVersion,
+		}
+	} else {
+		switch {
+		case lp.GoSum == "" && goSum != "":
+			// the go.sum checksum wasn't recorded by older versions of the lock
+			lp.GoSum = goSum
+		case lp.GoSum != goSum:
+			p.Error = &LockMismatchError{Path: p.Path, Field: "go.sum checksum", Locked: lp.GoSum, Actual: goSum}
+			return
+		}
+		if goMinorVersion(lp.GoVersion) != goMinorVersion(info.GoVersion) {
+			p.Error = &LockMismatchError{Path: p.Path, Field: "Go version", Locked: lp.GoVersion, Actual: info.GoVersion}
+			return
+		}
+		if lp.GoVersion != info.GoVersion {
+			// Binaries built by another patch release of Go differ, the source
+			// of the plugin is still pinned by its commit and go.sum.
+			p.ev.Send(
+				fmt.Sprintf(
+					"Plugin %q is built with %s but %s is locked, the checksum of the binary is not verified",
+					p.Path,
+					info.GoVersion,
+					lp.GoVersion,
+				),
+				events.Icon(icons.Info),
+			)
+			p.saveLock(lp)
+			return
+		}
+		if lockedSum, ok := lp.Checksums[target]; ok {
+			if lockedSum != sum {
+				p.Error = &LockMismatchError{Path: p.Path, Field: target + " checksum", Locked: lockedSum, Actual: sum}
+				return
+			}
+			p.saveLock(lp)
+			return
+		}
+	}
+
+	// Record the checksum of the binary, binaries differ for each target so
+	// the checksum of a target is added the first time the plugin is built
+	// for it.
+	if lp.Checksums == nil {
+		lp.Checksums = make(map[string]string)
+	}
+	lp.Checksums[target] = sum
+	p.saveLock(lp)
+}
+
+// saveLock saves the entry of the plugin in the lock file when it changed.
+func (p *Plugin) saveLock(lp pluginsconfig.LockedPlugin) {
+	if locked, ok := p.locked(); ok && reflect.DeepEqual(locked, lp) {
+		return
+	}
+	p.lock.Set(lp)
+	if err := p.lock.Save(); err != nil {
+		p.Error = err
+	}
+}
+
+// goSumChecksum returns the checksum of the go.sum file found in dir, or an
+// empty string if there's no go.sum file.
+func goSumChecksum(dir string) (string, error) {
+	sum, err := checksum.File(filepath.Join(dir, "go.sum"))
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	return sum, err
+}
+
+// goMinorVersion returns the Go version without its patch number,
+// for example go1.20 for go1.20.4.
+func goMinorVersion(version string) string {
+	if parts := strings.SplitN(version, ".", 3); len(parts) == 3 {
+		return parts[0] + "." + parts[1]
+	}
+	return version
+}
+
+func buildSetting(info *buildinfo.BuildInfo, key string) string {
+	for _, s := range info.Settings {
+		if s.Key == key {
+			return s.Value
+		}
+	}
+	return ""
+}
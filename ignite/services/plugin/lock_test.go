@@ -0,0 +1,193 @@
+package plugin
+
+import (
+	"context"
+	"os"
+	"path"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+
+	pluginsconfig "github.com/ignite/cli/ignite/config/plugins"
+	"github.com/ignite/cli/ignite/pkg/gocmd"
+	"github.com/ignite/cli/ignite/pkg/xgit"
+)
+
+func TestPluginCheckLock(t *testing.T) {
+	// Helper to commit a plugin that only depends on the standard library, so
+	// it can be built offline.
+	commitPlugin := func(t *testing.T, repoDir, msg string) string {
+		require := require.New(t)
+		err := os.WriteFile(path.Join(repoDir, "go.mod"), []byte("module github.com/ignite/locked\n\ngo 1.20\n"), 0o644)
+		require.NoError(err)
+		err = os.WriteFile(path.Join(repoDir, "main.go"), []byte("package main\n\nfunc main() { println(\""+msg+"\") }\n"), 0o644)
+		require.NoError(err)
+		repo, err := git.PlainOpen(repoDir)
+		require.NoError(err)
+		w, err := repo.Worktree()
+		require.NoError(err)
+		_, err = w.Add(".")
+		require.NoError(err)
+		h, err := w.Commit(msg, &git.CommitOptions{
+			Author: &object.Signature{
+				Name:  "bob",
+				Email: "bob@example.com",
+				When:  time.Now(),
+			},
+		})
+		require.NoError(err)
+		return h.String()
+	}
+	// Helper to load a plugin cloned from repoDir and locked in lock.
+	loadPlugin := func(t *testing.T, repoDir string, lock *pluginsconfig.Lock) *Plugin {
+		cloneDir := path.Join(t.TempDir(), "locked")
+		p := &Plugin{
+			Plugin:     pluginsconfig.Plugin{Path: "github.com/ignite/locked"},
+			cloneURL:   repoDir,
+			cloneDir:   cloneDir,
+			srcPath:    cloneDir,
+			binaryName: "locked",
+			lock:       lock,
+		}
+		p.fetch()
+		p.build(context.Background())
+		require.NoError(t, p.Error)
+		return p
+	}
+	target := gocmd.BuildTarget(runtime.GOOS, runtime.GOARCH)
+
+	repoDir := t.TempDir()
+	_, err := git.PlainInit(repoDir, false)
+	require.NoError(t, err)
+	firstCommit := commitPlugin(t, repoDir, "first")
+	lastCommit := commitPlugin(t, repoDir, "last")
+
+	t.Run("ok: plugin is added to the lock", func(t *testing.T) {
+		lock, err := pluginsconfig.ParseLockDir(t.TempDir())
+		require.NoError(t, err)
+		p := loadPlugin(t, repoDir, lock)
+
+		p.checkLock(context.Background())
+
+		require.NoError(t, p.Error)
+		saved, err := pluginsconfig.ParseLockDir(path.Dir(lock.Path()))
+		require.NoError(t, err)
+		require.Len(t, saved.Plugins, 1)
+		lp := saved.Plugins[0]
+		require.Equal(t, p.Path, lp.Path)
+		require.Equal(t, lastCommit, lp.Commit)
+		require.Empty(t, lp.GoSum)
+		require.Equal(t, runtime.Version(), lp.GoVersion)
+		require.Len(t, lp.Checksums[target], 64)
+
+		// the binary built by someone else matches the lock
+		other := loadPlugin(t, repoDir, saved)
+		other.checkLock(context.Background())
+		require.NoError(t, other.Error)
+	})
+
+	t.Run("ok: locked commit is fetched", func(t *testing.T) {
+		lock, err := pluginsconfig.ParseLockDir(t.TempDir())
+		require.NoError(t, err)
+		lock.Set(pluginsconfig.LockedPlugin{
+			Path:      "github.com/ignite/locked",
+			Commit:    firstCommit,
+			GoVersion: runtime.Version(),
+		})
+		p := loadPlugin(t, repoDir, lock)
+
+		p.checkLock(context.Background())
+
+		require.NoError(t, p.Error)
+		commit, err := xgit.HeadCommit(p.cloneDir)
+		require.NoError(t, err)
+		require.Equal(t, firstCommit, commit)
+		lp, _ := lock.Get(p.Path)
+		require.Len(t, lp.Checksums[target], 64)
+	})
+
+	t.Run("fail: checksum mismatch", func(t *testing.T) {
+		lock, err := pluginsconfig.ParseLockDir(t.TempDir())
+		require.NoError(t, err)
+		lock.Set(pluginsconfig.LockedPlugin{
+			Path:      "github.com/ignite/locked",
+			Commit:    lastCommit,
+			GoVersion: runtime.Version(),
+			Checksums: map[string]string{target: "tampered"},
+		})
+		p := loadPlugin(t, repoDir, lock)
+
+		p.checkLock(context.Background())
+
+		var mismatchErr *LockMismatchError
+		require.ErrorAs(t, p.Error, &mismatchErr)
+		require.Equal(t, target+" checksum", mismatchErr.Field)
+		require.Equal(t, "tampered", mismatchErr.Locked)
+
+		// updating accepts the plugin
+		err = Update(p)
+
+		require.NoError(t, err)
+		lp, _ := lock.Get(p.Path)
+		require.Equal(t, lastCommit, lp.Commit)
+		require.NotEqual(t, "tampered", lp.Checksums[target])
+	})
+
+	t.Run("fail: go.sum checksum mismatch", func(t *testing.T) {
+		lock, err := pluginsconfig.ParseLockDir(t.TempDir())
+		require.NoError(t, err)
+		lock.Set(pluginsconfig.LockedPlugin{
+			Path:      "github.com/ignite/locked",
+			Commit:    lastCommit,
+			GoSum:     "tampered",
+			GoVersion: runtime.Version(),
+		})
+		p := loadPlugin(t, repoDir, lock)
+
+		p.checkLock(context.Background())
+
+		var mismatchErr *LockMismatchError
+		require.ErrorAs(t, p.Error, &mismatchErr)
+		require.Equal(t, "go.sum checksum", mismatchErr.Field)
+	})
+
+	t.Run("ok: Go patch version mismatch", func(t *testing.T) {
+		lock, err := pluginsconfig.ParseLockDir(t.TempDir())
+		require.NoError(t, err)
+		lp := pluginsconfig.LockedPlugin{
+			Path:      "github.com/ignite/locked",
+			Commit:    lastCommit,
+			GoVersion: goMinorVersion(runtime.Version()) + ".999",
+			Checksums: map[string]string{target: "built-with-another-go"},
+		}
+		lock.Set(lp)
+		p := loadPlugin(t, repoDir, lock)
+
+		p.checkLock(context.Background())
+
+		require.NoError(t, p.Error)
+		locked, _ := lock.Get(p.Path)
+		require.Equal(t, lp, locked)
+	})
+
+	t.Run("fail: Go version mismatch", func(t *testing.T) {
+		lock, err := pluginsconfig.ParseLockDir(t.TempDir())
+		require.NoError(t, err)
+		lock.Set(pluginsconfig.LockedPlugin{
+			Path:      "github.com/ignite/locked",
+			Commit:    lastCommit,
+			GoVersion: "go1.0",
+		})
+		p := loadPlugin(t, repoDir, lock)
+
+		p.checkLock(context.Background())
+
+		var mismatchErr *LockMismatchError
+		require.ErrorAs(t, p.Error, &mismatchErr)
+		require.Equal(t, "Go version", mismatchErr.Field)
+	})
+}
@@ -21,13 +21,13 @@ func (_m *PluginInterface) EXPECT() *PluginInterface_Expecter {
 	return &PluginInterface_Expecter{mock: &_m.Mock}
 }
 
-// Execute provides a mock function with given fields: cmd
-func (_m *PluginInterface) Execute(cmd plugin.ExecutedCommand) error {
-	ret := _m.Called(cmd)
+// Execute provides a mock function with given fields: cmd, api
+func (_m *PluginInterface) Execute(cmd plugin.ExecutedCommand, api plugin.ClientAPI) error {
+	ret := _m.Called(cmd, api)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(plugin.ExecutedCommand) error); ok {
-		r0 = rf(cmd)
+	if rf, ok := ret.Get(0).(func(plugin.ExecutedCommand, plugin.ClientAPI) error); ok {
+		r0 = rf(cmd, api)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -42,13 +42,14 @@ type PluginInterface_Execute_Call struct {
 
 // Execute is a helper method to define mock.On call
 //   - cmd plugin.ExecutedCommand
-func (_e *PluginInterface_Expecter) Execute(cmd interface{}) *PluginInterface_Execute_Call {
-	return &PluginInterface_Execute_Call{Call: _e.mock.On("Execute", cmd)}
+//   - api plugin.ClientAPI
+func (_e *PluginInterface_Expecter) Execute(cmd interface{}, api interface{}) *PluginInterface_Execute_Call {
+	return &PluginInterface_Execute_Call{Call: _e.mock.On("Execute", cmd, api)}
 }
 
-func (_c *PluginInterface_Execute_Call) Run(run func(cmd plugin.ExecutedCommand)) *PluginInterface_Execute_Call {
+func (_c *PluginInterface_Execute_Call) Run(run func(cmd plugin.ExecutedCommand, api plugin.ClientAPI)) *PluginInterface_Execute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(plugin.ExecutedCommand))
+		run(args[0].(plugin.ExecutedCommand), args[1].(plugin.ClientAPI))
 	})
 	return _c
 }
@@ -58,18 +59,18 @@ func (_c *PluginInterface_Execute_Call) Return(_a0 error) *PluginInterface_Execu
 	return _c
 }
 
-func (_c *PluginInterface_Execute_Call) RunAndReturn(run func(plugin.ExecutedCommand) error) *PluginInterface_Execute_Call {
+func (_c *PluginInterface_Execute_Call) RunAndReturn(run func(plugin.ExecutedCommand, plugin.ClientAPI) error) *PluginInterface_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExecuteHookCleanUp provides a mock function with given fields: hook
-func (_m *PluginInterface) ExecuteHookCleanUp(hook plugin.ExecutedHook) error {
-	ret := _m.Called(hook)
+// ExecuteHookCleanUp provides a mock function with given fields: hook, api
+func (_m *PluginInterface) ExecuteHookCleanUp(hook plugin.ExecutedHook, api plugin.ClientAPI) error {
+	ret := _m.Called(hook, api)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(plugin.ExecutedHook) error); ok {
-		r0 = rf(hook)
+	if rf, ok := ret.Get(0).(func(plugin.ExecutedHook, plugin.ClientAPI) error); ok {
+		r0 = rf(hook, api)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -84,13 +85,14 @@ type PluginInterface_ExecuteHookCleanUp_Call struct {
 
 // ExecuteHookCleanUp is a helper method to define mock.On call
 //   - hook plugin.ExecutedHook
-func (_e *PluginInterface_Expecter) ExecuteHookCleanUp(hook interface{}) *PluginInterface_ExecuteHookCleanUp_Call {
-	return &PluginInterface_ExecuteHookCleanUp_Call{Call: _e.mock.On("ExecuteHookCleanUp", hook)}
+//   - api plugin.ClientAPI
+func (_e *PluginInterface_Expecter) ExecuteHookCleanUp(hook interface{}, api interface{}) *PluginInterface_ExecuteHookCleanUp_Call {
+	return &PluginInterface_ExecuteHookCleanUp_Call{Call: _e.mock.On("ExecuteHookCleanUp", hook, api)}
 }
 
-func (_c *PluginInterface_ExecuteHookCleanUp_Call) Run(run func(hook plugin.ExecutedHook)) *PluginInterface_ExecuteHookCleanUp_Call {
+func (_c *PluginInterface_ExecuteHookCleanUp_Call) Run(run func(hook plugin.ExecutedHook, api plugin.ClientAPI)) *PluginInterface_ExecuteHookCleanUp_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(plugin.ExecutedHook))
+		run(args[0].(plugin.ExecutedHook), args[1].(plugin.ClientAPI))
 	})
 	return _c
 }
@@ -100,18 +102,18 @@ func (_c *PluginInterface_ExecuteHookCleanUp_Call) Return(_a0 error) *PluginInte
 	return _c
 }
 
-func (_c *PluginInterface_ExecuteHookCleanUp_Call) RunAndReturn(run func(plugin.ExecutedHook) error) *PluginInterface_ExecuteHookCleanUp_Call {
+func (_c *PluginInterface_ExecuteHookCleanUp_Call) RunAndReturn(run func(plugin.ExecutedHook, plugin.ClientAPI) error) *PluginInterface_ExecuteHookCleanUp_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExecuteHookPost provides a mock function with given fields: hook
-func (_m *PluginInterface) ExecuteHookPost(hook plugin.ExecutedHook) error {
-	ret := _m.Called(hook)
+// ExecuteHookPost provides a mock function with given fields: hook, api
+func (_m *PluginInterface) ExecuteHookPost(hook plugin.ExecutedHook, api plugin.ClientAPI) error {
+	ret := _m.Called(hook, api)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(plugin.ExecutedHook) error); ok {
-		r0 = rf(hook)
+	if rf, ok := ret.Get(0).(func(plugin.ExecutedHook, plugin.ClientAPI) error); ok {
+		r0 = rf(hook, api)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -126,13 +128,14 @@ type PluginInterface_ExecuteHookPost_Call struct {
 
 // ExecuteHookPost is a helper method to define mock.On call
 //   - hook plugin.ExecutedHook
-func (_e *PluginInterface_Expecter) ExecuteHookPost(hook interface{}) *PluginInterface_ExecuteHookPost_Call {
-	return &PluginInterface_ExecuteHookPost_Call{Call: _e.mock.On("ExecuteHookPost", hook)}
+//   - api plugin.ClientAPI
+func (_e *PluginInterface_Expecter) ExecuteHookPost(hook interface{}, api interface{}) *PluginInterface_ExecuteHookPost_Call {
+	return &PluginInterface_ExecuteHookPost_Call{Call: _e.mock.On("ExecuteHookPost", hook, api)}
 }
 
-func (_c *PluginInterface_ExecuteHookPost_Call) Run(run func(hook plugin.ExecutedHook)) *PluginInterface_ExecuteHookPost_Call {
+func (_c *PluginInterface_ExecuteHookPost_Call) Run(run func(hook plugin.ExecutedHook, api plugin.ClientAPI)) *PluginInterface_ExecuteHookPost_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(plugin.ExecutedHook))
+		run(args[0].(plugin.ExecutedHook), args[1].(plugin.ClientAPI))
 	})
 	return _c
 }
@@ -142,18 +145,18 @@ func (_c *PluginInterface_ExecuteHookPost_Call) Return(_a0 error) *PluginInterfa
 	return _c
 }
 
-func (_c *PluginInterface_ExecuteHookPost_Call) RunAndReturn(run func(plugin.ExecutedHook) error) *PluginInterface_ExecuteHookPost_Call {
+func (_c *PluginInterface_ExecuteHookPost_Call) RunAndReturn(run func(plugin.ExecutedHook, plugin.ClientAPI) error) *PluginInterface_ExecuteHookPost_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExecuteHookPre provides a mock function with given fields: hook
-func (_m *PluginInterface) ExecuteHookPre(hook plugin.ExecutedHook) error {
-	ret := _m.Called(hook)
+// ExecuteHookPre provides a mock function with given fields: hook, api
+func (_m *PluginInterface) ExecuteHookPre(hook plugin.ExecutedHook, api plugin.ClientAPI) error {
+	ret := _m.Called(hook, api)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(plugin.ExecutedHook) error); ok {
-		r0 = rf(hook)
+	if rf, ok := ret.Get(0).(func(plugin.ExecutedHook, plugin.ClientAPI) error); ok {
+		r0 = rf(hook, api)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -168,13 +171,14 @@ type PluginInterface_ExecuteHookPre_Call struct {
 
 // ExecuteHookPre is a helper method to define mock.On call
 //   - hook plugin.ExecutedHook
-func (_e *PluginInterface_Expecter) ExecuteHookPre(hook interface{}) *PluginInterface_ExecuteHookPre_Call {
-	return &PluginInterface_ExecuteHookPre_Call{Call: _e.mock.On("ExecuteHookPre", hook)}
+//   - api plugin.ClientAPI
+func (_e *PluginInterface_Expecter) ExecuteHookPre(hook interface{}, api interface{}) *PluginInterface_ExecuteHookPre_Call {
+	return &PluginInterface_ExecuteHookPre_Call{Call: _e.mock.On("ExecuteHookPre", hook, api)}
 }
 
-func (_c *PluginInterface_ExecuteHookPre_Call) Run(run func(hook plugin.ExecutedHook)) *PluginInterface_ExecuteHookPre_Call {
+func (_c *PluginInterface_ExecuteHookPre_Call) Run(run func(hook plugin.ExecutedHook, api plugin.ClientAPI)) *PluginInterface_ExecuteHookPre_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(plugin.ExecutedHook))
+		run(args[0].(plugin.ExecutedHook), args[1].(plugin.ClientAPI))
 	})
 	return _c
 }
@@ -184,7 +188,50 @@ func (_c *PluginInterface_ExecuteHookPre_Call) Return(_a0 error) *PluginInterfac
 	return _c
 }
 
-func (_c *PluginInterface_ExecuteHookPre_Call) RunAndReturn(run func(plugin.ExecutedHook) error) *PluginInterface_ExecuteHookPre_Call {
+func (_c *PluginInterface_ExecuteHookPre_Call) RunAndReturn(run func(plugin.ExecutedHook, plugin.ClientAPI) error) *PluginInterface_ExecuteHookPre_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExecuteServeEvent provides a mock function with given fields: event, api
+func (_m *PluginInterface) ExecuteServeEvent(event plugin.ServeEvent, api plugin.ClientAPI) error {
+	ret := _m.Called(event, api)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(plugin.ServeEvent, plugin.ClientAPI) error); ok {
+		r0 = rf(event, api)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PluginInterface_ExecuteServeEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecuteServeEvent'
+type PluginInterface_ExecuteServeEvent_Call struct {
+	*mock.Call
+}
+
+// ExecuteServeEvent is a helper method to define mock.On call
+//   - event plugin.ServeEvent
+//   - api plugin.ClientAPI
+func (_e *PluginInterface_Expecter) ExecuteServeEvent(event interface{}, api interface{}) *PluginInterface_ExecuteServeEvent_Call {
+	return &PluginInterface_ExecuteServeEvent_Call{Call: _e.mock.On("ExecuteServeEvent", event, api)}
+}
+
+func (_c *PluginInterface_ExecuteServeEvent_Call) Run(run func(event plugin.ServeEvent, api plugin.ClientAPI)) *PluginInterface_ExecuteServeEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(plugin.ServeEvent), args[1].(plugin.ClientAPI))
+	})
+	return _c
+}
+
+func (_c *PluginInterface_ExecuteServeEvent_Call) Return(_a0 error) *PluginInterface_ExecuteServeEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PluginInterface_ExecuteServeEvent_Call) RunAndReturn(run func(plugin.ServeEvent, plugin.ClientAPI) error) *PluginInterface_ExecuteServeEvent_Call {
 	_c.Call.Return(run)
 	return _c
 }
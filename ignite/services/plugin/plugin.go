@@ -58,6 +58,9 @@ type Plugin struct {
 	// plugin instance is controlling the rpc server.
 	isHost bool
 
+	// lock holds the lock file of the config the plugin is declared in.
+	lock *pluginsconfig.Lock
+
 	ev events.Bus
 }
 
@@ -71,6 +74,19 @@ func CollectEvents(ev events.Bus) Option {
 	}
 }
 
+// WithLock pins the remote plugins to the entries of the lock files of the
+// local and global plugin configs. A nil lock disables the pinning of the
+// plugins declared in that config.
+func WithLock(local, global *pluginsconfig.Lock) Option {
+	return func(p *Plugin) {
+		if p.Global {
+			p.lock = global
+		} else {
+			p.lock = local
+		}
+	}
+}
+
 // Load loads the plugins found in the chain config.
 //
 // There's 2 kinds of plugins, local or remote.
@@ -99,13 +115,32 @@ func Load(ctx context.Context, plugins []pluginsconfig.Plugin, options ...Option
 }
 
 // Update removes the cache directory of plugins and fetch them again.
+// The remote plugins are fetched at the latest commit of their reference, then
+// rebuilt and their entries in the lock file are replaced.
 func Update(plugins ...*Plugin) error {
+	ctx := context.Background()
 	for _, p := range plugins {
+		// Updating is the way to accept a plugin that doesn't match its lock
+		var mismatchErr *LockMismatchError
+		if errors.As(p.Error, &mismatchErr) {
+			p.Error = nil
+		}
 		err := p.clean()
 		if err != nil {
 			return err
 		}
+		if p.Error != nil || p.IsLocalPath() {
+			continue
+		}
+		if p.lock != nil {
+			p.lock.Remove(p.Path)
+		}
 		p.fetch()
+		p.build(ctx)
+		p.checkLock(ctx)
+		if p.Error != nil {
+			return p.Error
+		}
 	}
 	return nil
 }
@@ -223,6 +258,7 @@ func (p *Plugin) load(ctx context.Context) {
 			// binary not found, need to build it
 			p.build(ctx)
 		}
+		p.checkLock(ctx)
 	}
 	if p.Error != nil {
 		return
@@ -287,13 +323,28 @@ func (p *Plugin) load(ctx context.Context) {
 
 	// We should have an Interface now! This feels like a normal interface
 	// implementation but is in fact over an RPC connection.
-	p.Interface = raw.(Interface)
+	i := raw.(Interface)
 
-	m, err := p.Interface.Manifest()
+	// check the compatibility before any other call to the plugin, because
+	// calls to an incompatible plugin fail with obscure errors.
+	m, err := i.Manifest()
 	if err != nil {
-		p.Error = errors.Wrapf(err, "manifest load")
+		// the manifest of a plugin built for another plugin interface version
+		// can't always be decoded.
+		err = &IncompatibleError{
+			Reason: fmt.Sprintf("the plugin manifest can't be loaded (%s), the plugin is probably built for another Ignite version", err),
+			Fix:    updatePluginFix,
+		}
+	} else {
+		err = m.CheckCompatibility()
+	}
+	if err != nil {
+		p.Error = err
+		p.client.Kill()
+		return
 	}
 
+	p.Interface = i
 	p.manifest = m
 
 	// write the rpc context to cache if the plugin is declared as host.
@@ -322,7 +373,13 @@ func (p *Plugin) fetch() {
 	p.ev.Send(fmt.Sprintf("Fetching plugin %q", p.cloneURL), events.ProgressStart())
 	defer p.ev.Send(fmt.Sprintf("Plugin fetched %q", p.cloneURL), events.ProgressFinish())
 
-	urlref := strings.Join([]string{p.cloneURL, p.reference}, "@")
+	ref := p.reference
+	if lp, ok := p.locked(); ok {
+		// fetch the exact commit the plugin is locked to, the reference
+		// might have moved since.
+		ref = lp.Commit
+	}
+	urlref := strings.Join([]string{p.cloneURL, ref}, "@")
 	err := xgit.Clone(context.Background(), urlref, p.cloneDir)
 	if err != nil {
 		p.Error = errors.Wrapf(err, "cloning %q", p.repoPath)
@@ -337,11 +394,18 @@ func (p *Plugin) build(ctx context.Context) {
 	p.ev.Send(fmt.Sprintf("Building plugin %q", p.Path), events.ProgressStart())
 	defer p.ev.Send(fmt.Sprintf("Plugin built %q", p.Path), events.ProgressFinish())
 
-	if err := gocmd.ModTidy(ctx, p.srcPath); err != nil {
+	// trim the file system paths so the binary doesn't depend on where the
+	// plugin is cloned, this makes the checksum of the binary reproducible.
+	flags := []string{gocmd.FlagTrimpath}
+	if _, ok := p.locked(); ok {
+		// the dependencies of a locked plugin are pinned by its go.sum, they
+		// must not be resolved again.
+		flags = append(flags, gocmd.FlagMod, gocmd.FlagModValueReadOnly)
+	} else if err := gocmd.ModTidy(ctx, p.srcPath); err != nil {
 		p.Error = errors.Wrapf(err, "go mod tidy")
 		return
 	}
-	if err := gocmd.Build(ctx, p.binaryName, p.srcPath, nil); err != nil {
+	if err := gocmd.Build(ctx, p.binaryName, p.srcPath, flags); err != nil {
 		p.Error = errors.Wrapf(err, "go build")
 		return
 	}
@@ -348,10 +348,11 @@ func TestPluginLoad(t *testing.T) {
 			manifest, err := p.Interface.Manifest()
 			require.NoError(err)
 			assert.Equal(p.binaryName, manifest.Name)
-			assert.NoError(p.Interface.Execute(ExecutedCommand{}))
-			assert.NoError(p.Interface.ExecuteHookPre(ExecutedHook{}))
-			assert.NoError(p.Interface.ExecuteHookPost(ExecutedHook{}))
-			assert.NoError(p.Interface.ExecuteHookCleanUp(ExecutedHook{}))
+			api := NewClientAPI(context.Background())
+			assert.NoError(p.Interface.Execute(ExecutedCommand{}, api))
+			assert.NoError(p.Interface.ExecuteHookPre(ExecutedHook{}, api))
+			assert.NoError(p.Interface.ExecuteHookPost(ExecutedHook{}, api))
+			assert.NoError(p.Interface.ExecuteHookCleanUp(ExecutedHook{}, api))
 		})
 	}
 }
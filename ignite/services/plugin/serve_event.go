@@ -0,0 +1,104 @@
+package plugin
+
+// ServeEventType is the type of a lifecycle event of a chain served with `ignite chain serve`.
+type ServeEventType string
+
+const (
+	// ServeBuildStarted is sent when the app starts to be built.
+	ServeBuildStarted ServeEventType = "build-started"
+
+	// ServeBuildFailed is sent when the app fails to build.
+	ServeBuildFailed ServeEventType = "build-failed"
+
+	// ServeBuildSucceeded is sent when the app binary is built.
+	ServeBuildSucceeded ServeEventType = "build-succeeded"
+
+	// ServeStateReset is sent when the state of the chain is reset.
+	ServeStateReset ServeEventType = "state-reset"
+
+	// ServeChainInitialized is sent when the chain is initialized with a new
+	// state, including when the state is restored from a snapshot or a fork.
+	ServeChainInitialized ServeEventType = "chain-initialized"
+
+	// ServeNodeStarted is sent when the node accepts RPC requests and the
+	// first block is committed.
+	ServeNodeStarted ServeEventType = "node-started"
+
+	// ServeNewBlock is sent for each block committed by the node.
+	ServeNewBlock ServeEventType = "new-block"
+)
+
+// ServeEventTypes lists all the serve event types.
+var ServeEventTypes = []ServeEventType{
+	ServeBuildStarted,
+	ServeBuildFailed,
+	ServeBuildSucceeded,
+	ServeStateReset,
+	ServeChainInitialized,
+	ServeNodeStarted,
+	ServeNewBlock,
+}
+
+// ServeEvent is a lifecycle event of a chain served with `ignite chain serve`.
+// Only the payload that matches the type of the event is set.
+type ServeEvent struct {
+	// Type is the type of the event.
+	Type ServeEventType
+
+	// ChainID is the ID of the served chain.
+	ChainID string
+
+	// BuildFailed is the payload of ServeBuildFailed events.
+	BuildFailed *BuildFailedPayload
+
+	// BuildSucceeded is the payload of ServeBuildSucceeded events.
+	BuildSucceeded *BuildSucceededPayload
+
+	// ChainInitialized is the payload of ServeChainInitialized events.
+	ChainInitialized *ChainInitializedPayload
+
+	// NodeStarted is the payload of ServeNodeStarted events.
+	NodeStarted *NodeStartedPayload
+
+	// NewBlock is the payload of ServeNewBlock events.
+	NewBlock *NewBlockPayload
+}
+
+// BuildFailedPayload is the payload of ServeBuildFailed events.
+type BuildFailedPayload struct {
+	// Error is the error message of the build.
+	Error string
+}
+
+// BuildSucceededPayload is the payload of ServeBuildSucceeded events.
+type BuildSucceededPayload struct {
+	// Binary is the name of the app binary.
+	Binary string
+}
+
+// ChainInitializedPayload is the payload of ServeChainInitialized events.
+type ChainInitializedPayload struct {
+	// Home is the home directory of the node.
+	Home string
+}
+
+// NodeStartedPayload is the payload of ServeNodeStarted events.
+type NodeStartedPayload struct {
+	// RPCAddress is the address of the Tendermint RPC of the node.
+	RPCAddress string
+
+	// APIAddress is the address of the Cosmos SDK API of the node.
+	APIAddress string
+
+	// Home is the home directory of the node.
+	Home string
+
+	// Height is the latest block height when the node is started.
+	Height int64
+}
+
+// NewBlockPayload is the payload of ServeNewBlock events.
+type NewBlockPayload struct {
+	// Height is the height of the new block.
+	Height int64
+}
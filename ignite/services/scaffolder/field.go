@@ -0,0 +1,339 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/emicklei/proto"
+	"github.com/gobuffalo/genny/v2"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field"
+	"github.com/ignite/cli/ignite/templates/typed"
+	"github.com/ignite/cli/ignite/templates/typed/list"
+	maptype "github.com/ignite/cli/ignite/templates/typed/map"
+	"github.com/ignite/cli/ignite/templates/typed/singleton"
+)
+
+// AddFields adds fields to a list, map or single type scaffolded in a module.
+// It returns the files that must be updated manually because they were modified
+// after the type was scaffolded.
+func (s Scaffolder) AddFields(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	moduleName,
+	typeName string,
+	fields []string,
+) (sm xgenny.SourceModification, notUpdated []string, err error) {
+	kind, previous, err := s.scaffoldedType(moduleName, typeName)
+	if err != nil {
+		return sm, nil, err
+	}
+
+	// Check and parse provided fields
+	if err := checkCustomTypes(ctx, s.path, s.modpath.Package, previous.ModuleName, fields); err != nil {
+		return sm, nil, err
+	}
+	added, err := field.ParseFields(fields, checkForbiddenTypeField, previous.MsgSigner.Original)
+	if err != nil {
+		return sm, nil, err
+	}
+	for _, f := range added {
+		for _, existing := range append(previous.Fields, previous.Indexes...) {
+			if f.Name.LowerCamel == existing.Name.LowerCamel {
+				return sm, nil, fmt.Errorf("the field %s already exists in %s", f.Name.Original, typeName)
+			}
+		}
+	}
+
+	opts := *previous
+	opts.Fields = append(append(field.Fields{}, previous.Fields...), added...)
+
+	return s.updateFields(ctx, cacheStorage, tracer, kind, previous, &opts)
+}
+
+// RemoveFields removes fields from a list, map or single type scaffolded in a module.
+// It returns the files that must be updated manually because they were modified
+// after the type was scaffolded.
+func (s Scaffolder) RemoveFields(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	moduleName,
+	typeName string,
+	fieldNames []string,
+) (sm xgenny.SourceModification, notUpdated []string, err error) {
+	kind, previous, err := s.scaffoldedType(moduleName, typeName)
+	if err != nil {
+		return sm, nil, err
+	}
+
+	removed := make(map[string]struct{})
+	for _, fieldName := range fieldNames {
+		name, err := multiformatname.NewName(fieldName)
+		if err != nil {
+			return sm, nil, err
+		}
+
+		var found bool
+		for _, f := range previous.Fields {
+			if f.Name.LowerCamel == name.LowerCamel {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return sm, nil, fmt.Errorf("the field %s doesn't exist in %s or can't be removed", fieldName, typeName)
+		}
+		for _, index := range previous.SecondaryIndexes {
+			if index.Name.LowerCamel == name.LowerCamel {
+				return sm, nil, fmt.Errorf("the field %s is a secondary index of %s and can't be removed", fieldName, typeName)
+			}
+		}
+		removed[name.LowerCamel] = struct{}{}
+	}
+
+	opts := *previous
+	opts.Fields = nil
+	for _, f := range previous.Fields {
+		if _, ok := removed[f.Name.LowerCamel]; !ok {
+			opts.Fields = append(opts.Fields, f)
+		}
+	}
+
+	return s.updateFields(ctx, cacheStorage, tracer, kind, previous, &opts)
+}
+
+func (s Scaffolder) updateFields(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	tracer *placeholder.Tracer,
+	kind ComponentKind,
+	previous,
+	opts *typed.Options,
+) (sm xgenny.SourceModification, notUpdated []string, err error) {
+	var g *genny.Generator
+	switch kind {
+	case ComponentList:
+		g, notUpdated, err = list.NewFieldsGenerator(previous, opts)
+	case ComponentMap:
+		g, notUpdated, err = maptype.NewFieldsGenerator(previous, opts)
+	default:
+		g, notUpdated, err = singleton.NewFieldsGenerator(previous, opts)
+	}
+	if err != nil {
+		return sm, nil, err
+	}
+
+	added, _ := typed.FieldsDiff(previous.Fields, opts.Fields)
+	gens, err := supportEnums(
+		nil,
+		opts.AppPath,
+		opts.AppName,
+		opts.ModulePath,
+		opts.ModuleName,
+		added,
+	)
+	if err != nil {
+		return sm, nil, err
+	}
+	gens = append(gens, g)
+	sm, err = xgenny.RunWithValidation(tracer, gens...)
+	if err != nil {
+		return sm, nil, err
+	}
+	return sm, notUpdated, finish(ctx, cacheStorage, opts.AppPath, s.modpath.RawPath)
+}
+
+// scaffoldedType returns the kind and the options of a type scaffolded in a module.
+// The options are built from the proto files of the module.
+func (s Scaffolder) scaffoldedType(moduleName, typeName string) (ComponentKind, *typed.Options, error) {
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return "", nil, err
+	}
+	moduleName = mfName.LowerCase
+
+	name, err := multiformatname.NewName(typeName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	isIBC, err := isIBCModule(s.path, moduleName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	opts := &typed.Options{
+		AppName:    s.modpath.Package,
+		AppPath:    s.path,
+		ModulePath: s.modpath.RawPath,
+		ModuleName: moduleName,
+		TypeName:   name,
+		IsIBC:      isIBC,
+	}
+
+	// the kind of the type is defined by its field in the genesis state
+	genesisState, err := protoMessage(opts.ProtoPath("genesis.proto"), typed.ProtoGenesisStateMessage)
+	if err != nil {
+		return "", nil, err
+	}
+	kind := ComponentKind("")
+	for _, f := range protoFields(genesisState) {
+		if f.Type != name.UpperCamel {
+			continue
+		}
+		switch {
+		case !f.Repeated:
+			kind = ComponentSingle
+		case hasProtoField(genesisState, name.LowerCamel+"Count"):
+			kind = ComponentList
+		default:
+			kind = ComponentMap
+		}
+	}
+	if kind == "" {
+		return "", nil, fmt.Errorf("%s is not a list, map or single type of the module %s", typeName, moduleName)
+	}
+
+	enums, err := protoEnums(filepath.Join(s.path, protoFolder, s.modpath.Package, moduleName))
+	if err != nil {
+		return "", nil, err
+	}
+	isEnum := func(typename string) bool {
+		_, ok := enums[typename]
+		return ok
+	}
+
+	// the signer is the first field of the message to create the type
+	opts.MsgSigner, _ = multiformatname.NewName("creator")
+	opts.NoMessage = true
+	msgCreate, err := protoMessage(opts.ProtoPath("tx.proto"), "MsgCreate"+name.UpperCamel)
+	if err == nil {
+		opts.NoMessage = false
+		for _, f := range protoFields(msgCreate) {
+			if f.Sequence == 1 {
+				if opts.MsgSigner, err = multiformatname.NewName(f.Name); err != nil {
+					return "", nil, err
+				}
+			}
+		}
+	}
+
+	_, err = os.Stat(filepath.Join(s.path, moduleDir, moduleName, "simulation", name.Snake+".go"))
+	opts.NoSimulation = os.IsNotExist(err)
+
+	// fields that are not defined by the user
+	reserved := map[string]struct{}{}
+	if !opts.NoMessage {
+		reserved[opts.MsgSigner.LowerCamel] = struct{}{}
+	}
+	if kind == ComponentList {
+		reserved["id"] = struct{}{}
+	}
+	if kind == ComponentMap {
+		queryGet, err := protoMessage(opts.ProtoPath("query.proto"), "QueryGet"+name.UpperCamel+"Request")
+		if err != nil {
+			return "", nil, err
+		}
+		for _, f := range protoFields(queryGet) {
+			index, err := field.ParseProtoField(f, isEnum)
+			if err != nil {
+				return "", nil, err
+			}
+			opts.Indexes = append(opts.Indexes, index)
+			reserved[f.Name] = struct{}{}
+		}
+	}
+
+	msg, err := protoMessage(opts.ProtoPath(name.Snake+".proto"), name.UpperCamel)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, f := range protoFields(msg) {
+		if _, ok := reserved[f.Name]; ok {
+			continue
+		}
+		typeField, err := field.ParseProtoField(f, isEnum)
+		if err != nil {
+			return "", nil, err
+		}
+		opts.Fields = append(opts.Fields, typeField)
+	}
+
+	// the secondary indexes of a map have a query to list the values by field
+	if kind == ComponentMap {
+		queryFile, err := protoutil.ParseProtoPath(opts.ProtoPath("query.proto"))
+		if err != nil {
+			return "", nil, err
+		}
+		for _, f := range opts.Fields {
+			queryBy := fmt.Sprintf("QueryList%sBy%sRequest", name.UpperCamel, f.Name.UpperCamel)
+			if _, err := protoutil.GetMessageByName(queryFile, queryBy); err == nil {
+				opts.SecondaryIndexes = append(opts.SecondaryIndexes, f)
+			}
+		}
+	}
+
+	return kind, opts, nil
+}
+
+// protoMessage returns a message defined in a proto file.
+func protoMessage(path, name string) (*proto.Message, error) {
+	f, err := protoutil.ParseProtoPath(path)
+	if err != nil {
+		return nil, err
+	}
+	return protoutil.GetMessageByName(f, name)
+}
+
+// protoFields returns the fields of a proto message.
+func protoFields(m *proto.Message) (fields []*proto.NormalField) {
+	for _, el := range m.Elements {
+		if f, ok := el.(*proto.NormalField); ok {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+func hasProtoField(m *proto.Message, name string) bool {
+	for _, f := range protoFields(m) {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// protoEnums returns the names of the enums defined in the proto files of a directory.
+func protoEnums(dir string) (map[string]struct{}, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.proto"))
+	if err != nil {
+		return nil, err
+	}
+
+	enums := make(map[string]struct{})
+	for _, path := range paths {
+		f, err := protoutil.ParseProtoPath(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, el := range f.Elements {
+			if e, ok := el.(*proto.Enum); ok {
+				enums[e.Name] = struct{}{}
+			}
+		}
+	}
+	return enums, nil
+}
@@ -0,0 +1,304 @@
+package scaffolder
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+)
+
+const (
+	historyCacheNamespace      = "scaffolder.history"
+	historyIndexCacheNamespace = "scaffolder.history.index"
+)
+
+// ComponentKind defines the kind of a scaffolded component.
+type ComponentKind string
+
+const (
+	ComponentList    ComponentKind = "list"
+	ComponentMap     ComponentKind = "map"
+	ComponentSingle  ComponentKind = "single"
+	ComponentType    ComponentKind = "type"
+	ComponentMessage ComponentKind = "message"
+	ComponentQuery   ComponentKind = "query"
+	ComponentPacket  ComponentKind = "packet"
+)
+
+// ComponentKinds returns the kinds of the components that can be removed once scaffolded.
+func ComponentKinds() []ComponentKind {
+	return []ComponentKind{
+		ComponentList,
+		ComponentMap,
+		ComponentSingle,
+		ComponentType,
+		ComponentMessage,
+		ComponentQuery,
+		ComponentPacket,
+	}
+}
+
+// componentRecord describes the changes made to the source code of an app
+// when a component was scaffolded.
+type componentRecord struct {
+	Kind     ComponentKind
+	Module   string
+	Name     string
+	Created  []string
+	Modified []fileRecord
+}
+
+// fileRecord describes the changes made to an existing file.
+type fileRecord struct {
+	Path  string
+	Hunks []hunk
+}
+
+// hunk is a group of consecutive lines that changed in a file.
+type hunk struct {
+	// Before is the line that precedes the changed lines, empty when they are at the start of the file.
+	Before string
+
+	// Original contains the lines before the change.
+	Original []string
+
+	// Changed contains the lines after the change.
+	Changed []string
+}
+
+// historyRecorder lists the files of an app before a component is scaffolded
+// to record later the changes made to the source code of the app.
+type historyRecorder struct {
+	appPath string
+	files   map[string]struct{}
+}
+
+func newHistoryRecorder(appPath string) (historyRecorder, error) {
+	files, err := appFiles(appPath)
+	if err != nil {
+		return historyRecorder{}, err
+	}
+	return historyRecorder{
+		appPath: appPath,
+		files:   files,
+	}, nil
+}
+
+// record saves the changes made to the source code of the app when the component was scaffolded.
+// It must be called after the source code is formatted and the proto files are compiled.
+func (r historyRecorder) record(
+	cacheStorage cache.Storage,
+	kind ComponentKind,
+	moduleName string,
+	name multiformatname.Name,
+	sm xgenny.SourceModification,
+) error {
+	files, err := appFiles(r.appPath)
+	if err != nil {
+		return err
+	}
+
+	rec := componentRecord{
+		Kind:   kind,
+		Module: moduleName,
+		Name:   name.Snake,
+	}
+	for file := range files {
+		if _, ok := r.files[file]; !ok {
+			rec.Created = append(rec.Created, file)
+		}
+	}
+	sort.Strings(rec.Created)
+
+	for _, file := range sm.ModifiedFiles() {
+		original, ok := sm.OriginalContent(file)
+		if !ok {
+			continue
+		}
+		content, err := os.ReadFile(file)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		hunks := diffHunks(string(original), string(content))
+		if len(hunks) == 0 {
+			continue
+		}
+
+		path, err := filepath.Rel(r.appPath, file)
+		if err != nil {
+			return err
+		}
+		rec.Modified = append(rec.Modified, fileRecord{
+			Path:  path,
+			Hunks: hunks,
+		})
+	}
+	sort.Slice(rec.Modified, func(i, j int) bool {
+		return rec.Modified[i].Path < rec.Modified[j].Path
+	})
+
+	key := historyKey(r.appPath, kind, moduleName, name)
+	if err := cache.New[componentRecord](cacheStorage, historyCacheNamespace).Put(key, rec); err != nil {
+		return err
+	}
+
+	// keep the scaffolded components of the app in order
+	keys, err := historyKeys(cacheStorage, r.appPath)
+	if err != nil {
+		return err
+	}
+	keys = append(removeKey(keys, key), key)
+	return cache.New[[]string](cacheStorage, historyIndexCacheNamespace).Put(r.appPath, keys)
+}
+
+func historyKey(appPath string, kind ComponentKind, moduleName string, name multiformatname.Name) string {
+	return cache.Key(appPath, string(kind), moduleName, name.Snake)
+}
+
+// historyKeys returns the keys of the recorded components of an app in the order they were scaffolded.
+func historyKeys(cacheStorage cache.Storage, appPath string) ([]string, error) {
+	keys, err := cache.New[[]string](cacheStorage, historyIndexCacheNamespace).Get(appPath)
+	if err == cache.ErrorNotFound {
+		return nil, nil
+	}
+	return keys, err
+}
+
+func removeKey(keys []string, key string) []string {
+	filtered := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if k != key {
+			filtered = append(filtered, k)
+		}
+	}
+	return filtered
+}
+
+// appFiles returns the paths of the app files relative to the app path.
+// Hidden directories and Node.js dependencies are skipped.
+func appFiles(appPath string) (map[string]struct{}, error) {
+	files := make(map[string]struct{})
+	err := filepath.WalkDir(appPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			name := d.Name()
+			if path != appPath && (strings.HasPrefix(name, ".") || name == "node_modules") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		rel, err := filepath.Rel(appPath, path)
+		if err != nil {
+			return err
+		}
+		files[rel] = struct{}{}
+		return nil
+	})
+	return files, err
+}
+
+// diffHunks returns the groups of lines that changed between the original and the new content.
+func diffHunks(original, content string) []hunk {
+	a, b := strings.Split(original, "\n"), strings.Split(content, "\n")
+
+	var hunks []hunk
+	m := difflib.NewMatcherWithJunk(a, b, false, nil)
+	for _, op := range m.GetOpCodes() {
+		if op.Tag == 'e' {
+			continue
+		}
+
+		h := hunk{
+			Original: append([]string{}, a[op.I1:op.I2]...),
+			Changed:  append([]string{}, b[op.J1:op.J2]...),
+		}
+		if op.J1 > 0 {
+			h.Before = b[op.J1-1]
+		}
+		hunks = append(hunks, h)
+	}
+	return hunks
+}
+
+// revertHunks reverts the changes described by the hunks in the content.
+// Lines are compared ignoring the whitespaces to support the content being formatted
+// after the changes were made. It returns false when a change cannot be found.
+func revertHunks(content string, hunks []hunk) (string, bool) {
+	lines := strings.Split(content, "\n")
+
+	// revert the changes from the bottom of the file to keep the context of the previous ones
+	for i := len(hunks) - 1; i >= 0; i-- {
+		h := hunks[i]
+
+		pos := findHunk(lines, h)
+		if pos < 0 {
+			return content, false
+		}
+
+		reverted := make([]string, 0, len(lines)-len(h.Changed)+len(h.Original))
+		reverted = append(reverted, lines[:pos]...)
+		reverted = append(reverted, h.Original...)
+		reverted = append(reverted, lines[pos+len(h.Changed):]...)
+		lines = reverted
+	}
+	return strings.Join(lines, "\n"), true
+}
+
+// findHunk returns the position of the changed lines of the hunk.
+// The changed lines preceded by the same line than when the changes were made are preferred,
+// otherwise the changed lines are only returned when they are found once.
+func findHunk(lines []string, h hunk) int {
+	var (
+		before  = normalizeLine(h.Before)
+		matches []int
+	)
+	for pos := 0; pos+len(h.Changed) <= len(lines); pos++ {
+		if !linesMatch(lines[pos:pos+len(h.Changed)], h.Changed) {
+			continue
+		}
+		if (pos == 0 && h.Before == "") || (pos > 0 && normalizeLine(lines[pos-1]) == before) {
+			return pos
+		}
+		matches = append(matches, pos)
+	}
+
+	if len(matches) == 1 && !isBlank(h.Changed) {
+		return matches[0]
+	}
+	return -1
+}
+
+func linesMatch(lines, expected []string) bool {
+	for i := range expected {
+		if normalizeLine(lines[i]) != normalizeLine(expected[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isBlank(lines []string) bool {
+	for _, l := range lines {
+		if normalizeLine(l) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizeLine(line string) string {
+	return strings.Join(strings.Fields(line), " ")
+}
@@ -0,0 +1,124 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const historyOriginal = `package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	// this line is used by starport scaffolding # 2
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	// this line is used by starport scaffolding # 3
+}
+`
+
+const historyScaffolded = `package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreatePost{}, "blog/CreatePost", nil)
+	// this line is used by starport scaffolding # 2
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreatePost{},
+	)
+	// this line is used by starport scaffolding # 3
+}
+`
+
+func TestRevertHunks(t *testing.T) {
+	hunks := diffHunks(historyOriginal, historyScaffolded)
+	require.Len(t, hunks, 2)
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+		wantOK  bool
+	}{
+		{
+			name:    "should revert the scaffolded code",
+			content: historyScaffolded,
+			want:    historyOriginal,
+			wantOK:  true,
+		},
+		{
+			name: "should revert the scaffolded code with other changes",
+			content: `package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreatePost{}, "blog/CreatePost", nil)
+	cdc.RegisterConcrete(&MsgCreateUser{}, "blog/CreateUser", nil)
+	// this line is used by starport scaffolding # 2
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+	&MsgCreatePost{},
+	)
+	// this line is used by starport scaffolding # 3
+}
+`,
+			want: `package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateUser{}, "blog/CreateUser", nil)
+	// this line is used by starport scaffolding # 2
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	// this line is used by starport scaffolding # 3
+}
+`,
+			wantOK: true,
+		},
+		{
+			name:    "should fail when the scaffolded code was modified",
+			content: historyOriginal,
+			want:    historyOriginal,
+			wantOK:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := revertHunks(tt.content, hunks)
+			require.Equal(t, tt.wantOK, ok)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRemoveEmptyDirs(t *testing.T) {
+	root := t.TempDir()
+	emptyDir := filepath.Join(root, "x", "blog", "keeper")
+	require.NoError(t, os.MkdirAll(emptyDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "x", "file"), nil, 0o644))
+
+	require.NoError(t, removeEmptyDirs(root, emptyDir))
+	require.NoDirExists(t, filepath.Join(root, "x", "blog"))
+	require.DirExists(t, filepath.Join(root, "x"))
+}
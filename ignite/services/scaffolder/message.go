@@ -114,6 +114,12 @@ func (s Scaffolder) AddMessage(
 		return sm, err
 	}
 
+	// list the app files to record the changes made by the scaffolding
+	history, err := newHistoryRecorder(s.path)
+	if err != nil {
+		return sm, err
+	}
+
 	var (
 		g    *genny.Generator
 		opts = &message.Options{
@@ -157,6 +163,19 @@ func (s Scaffolder) AddMessage(
 		return sm, err
 	}
 
+	gens, err = supportEnums(
+		gens,
+		opts.AppPath,
+		opts.AppName,
+		opts.ModulePath,
+		opts.ModuleName,
+		opts.Fields,
+		opts.ResFields,
+	)
+	if err != nil {
+		return sm, err
+	}
+
 	// Scaffold
 	g, err = message.NewGenerator(tracer, opts)
 	if err != nil {
@@ -167,7 +186,10 @@ func (s Scaffolder) AddMessage(
 	if err != nil {
 		return sm, err
 	}
-	return sm, finish(ctx, cacheStorage, opts.AppPath, s.modpath.RawPath)
+	if err := finish(ctx, cacheStorage, opts.AppPath, s.modpath.RawPath); err != nil {
+		return sm, err
+	}
+	return sm, history.record(cacheStorage, ComponentMessage, moduleName, name, sm)
 }
 
 // checkForbiddenMessageField returns true if the name is forbidden as a message name.
@@ -120,6 +120,12 @@ func (s Scaffolder) AddPacket(
 		return sm, err
 	}
 
+	// list the app files to record the changes made by the scaffolding
+	history, err := newHistoryRecorder(s.path)
+	if err != nil {
+		return sm, err
+	}
+
 	// Generate the packet
 	var (
 		g    *genny.Generator
@@ -135,15 +141,32 @@ func (s Scaffolder) AddPacket(
 			MsgSigner:  mfSigner,
 		}
 	)
+	gens, err := supportEnums(
+		nil,
+		opts.AppPath,
+		opts.AppName,
+		opts.ModulePath,
+		opts.ModuleName,
+		opts.Fields,
+		opts.AckFields,
+	)
+	if err != nil {
+		return sm, err
+	}
+
 	g, err = ibc.NewPacket(tracer, opts)
 	if err != nil {
 		return sm, err
 	}
-	sm, err = xgenny.RunWithValidation(tracer, g)
+	gens = append(gens, g)
+	sm, err = xgenny.RunWithValidation(tracer, gens...)
 	if err != nil {
 		return sm, err
 	}
-	return sm, finish(ctx, cacheStorage, opts.AppPath, s.modpath.RawPath)
+	if err := finish(ctx, cacheStorage, opts.AppPath, s.modpath.RawPath); err != nil {
+		return sm, err
+	}
+	return sm, history.record(cacheStorage, ComponentPacket, moduleName, name, sm)
 }
 
 // isIBCModule returns true if the provided module implements the IBC module interface
@@ -7,6 +7,7 @@ import (
 	"github.com/gobuffalo/genny/v2"
 
 	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/templates/field"
 	modulecreate "github.com/ignite/cli/ignite/templates/module/create"
 )
 
@@ -54,6 +55,30 @@ func supportGenesisTests(
 	return gens, nil
 }
 
+// supportEnums appends the generators to create the proto files of the
+// enum fields, or to add the missing values to the enums defined in the module.
+func supportEnums(
+	gens []*genny.Generator,
+	appPath,
+	appName,
+	modulePath,
+	moduleName string,
+	fields ...field.Fields,
+) ([]*genny.Generator, error) {
+	var enums field.Fields
+	for _, f := range fields {
+		enums = append(enums, f.Enums()...)
+	}
+	if len(enums) == 0 {
+		return gens, nil
+	}
+	enumGens, err := modulecreate.AddEnums(appPath, appName, modulePath, moduleName, enums...)
+	if err != nil {
+		return gens, err
+	}
+	return append(gens, enumGens...), nil
+}
+
 // supportMsgServer checks if the module supports the MsgServer convention
 // appends the generator to support it if it doesn't
 // https://github.com/cosmos/cosmos-sdk/blob/main/docs/architecture/adr-031-msg-service.md
@@ -63,6 +63,12 @@ func (s Scaffolder) AddQuery(
 		return sm, err
 	}
 
+	// list the app files to record the changes made by the scaffolding
+	history, err := newHistoryRecorder(s.path)
+	if err != nil {
+		return sm, err
+	}
+
 	var (
 		g    *genny.Generator
 		opts = &query.Options{
@@ -78,14 +84,31 @@ func (s Scaffolder) AddQuery(
 		}
 	)
 
+	gens, err := supportEnums(
+		nil,
+		opts.AppPath,
+		opts.AppName,
+		opts.ModulePath,
+		opts.ModuleName,
+		opts.ReqFields,
+		opts.ResFields,
+	)
+	if err != nil {
+		return sm, err
+	}
+
 	// Scaffold
 	g, err = query.NewGenerator(tracer, opts)
 	if err != nil {
 		return sm, err
 	}
-	sm, err = xgenny.RunWithValidation(tracer, g)
+	gens = append(gens, g)
+	sm, err = xgenny.RunWithValidation(tracer, gens...)
 	if err != nil {
 		return sm, err
 	}
-	return sm, finish(ctx, cacheStorage, opts.AppPath, s.modpath.RawPath)
+	if err := finish(ctx, cacheStorage, opts.AppPath, s.modpath.RawPath); err != nil {
+		return sm, err
+	}
+	return sm, history.record(cacheStorage, ComponentQuery, moduleName, name, sm)
 }
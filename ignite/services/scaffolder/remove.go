@@ -0,0 +1,158 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+)
+
+// RemoveComponent removes a component previously scaffolded in a module.
+// The files created when the component was scaffolded are deleted and the changes
+// made to the existing files are reverted.
+// It returns the modified files in the source modification and the list of removed files.
+func (s Scaffolder) RemoveComponent(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	kind ComponentKind,
+	moduleName,
+	componentName string,
+) (sm xgenny.SourceModification, removed []string, err error) {
+	sm = xgenny.NewSourceModification()
+
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, nil, err
+	}
+	moduleName = mfName.LowerCase
+
+	name, err := multiformatname.NewName(componentName)
+	if err != nil {
+		return sm, nil, err
+	}
+
+	records := cache.New[componentRecord](cacheStorage, historyCacheNamespace)
+	key := historyKey(s.path, kind, moduleName, name)
+	rec, err := records.Get(key)
+	if err == cache.ErrorNotFound {
+		return sm, nil, fmt.Errorf(
+			"no scaffolding history found for the %s %s in the module %s",
+			kind,
+			name.Original,
+			moduleName,
+		)
+	} else if err != nil {
+		return sm, nil, err
+	}
+
+	// the files created with the component can't be removed when they
+	// were modified later when scaffolding other components.
+	keys, err := historyKeys(cacheStorage, s.path)
+	if err != nil {
+		return sm, nil, err
+	}
+	created := make(map[string]struct{})
+	for _, path := range rec.Created {
+		created[path] = struct{}{}
+	}
+	for _, k := range removeKey(keys, key) {
+		other, err := records.Get(k)
+		if err == cache.ErrorNotFound {
+			continue
+		} else if err != nil {
+			return sm, nil, err
+		}
+		for _, f := range other.Modified {
+			if _, ok := created[f.Path]; ok {
+				return sm, nil, fmt.Errorf(
+					"the file %s created with the %s %s is modified by the %s %s, remove it first",
+					f.Path,
+					kind,
+					name.Original,
+					other.Kind,
+					other.Name,
+				)
+			}
+		}
+	}
+
+	// revert the changes before writing anything to avoid leaving the app half reverted
+	reverted := make(map[string]string)
+	for _, f := range rec.Modified {
+		path := filepath.Join(s.path, f.Path)
+		content, err := os.ReadFile(path)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return sm, nil, err
+		}
+
+		newContent, ok := revertHunks(string(content), f.Hunks)
+		if !ok {
+			return sm, nil, fmt.Errorf(
+				"cannot revert the changes made to %s, the scaffolded code was modified",
+				f.Path,
+			)
+		}
+		reverted[path] = newContent
+	}
+
+	for path, content := range reverted {
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			return sm, nil, err
+		}
+		sm.AppendModifiedFiles(path)
+	}
+
+	for _, f := range rec.Created {
+		path := filepath.Join(s.path, f)
+		if err := os.Remove(path); os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return sm, nil, err
+		}
+		removed = append(removed, path)
+
+		if err := removeEmptyDirs(s.path, filepath.Dir(path)); err != nil {
+			return sm, nil, err
+		}
+	}
+
+	if err := records.Delete(key); err != nil {
+		return sm, nil, err
+	}
+	if err := cache.New[[]string](cacheStorage, historyIndexCacheNamespace).Put(s.path, removeKey(keys, key)); err != nil {
+		return sm, nil, err
+	}
+
+	return sm, removed, finish(ctx, cacheStorage, s.path, s.modpath.RawPath)
+}
+
+// removeEmptyDirs removes the directory and its parents while they are empty
+// without removing the root directory.
+func removeEmptyDirs(root, dir string) error {
+	for dir != root && filepath.Dir(dir) != dir {
+		entries, err := os.ReadDir(dir)
+		if os.IsNotExist(err) {
+			dir = filepath.Dir(dir)
+			continue
+		} else if err != nil {
+			return err
+		}
+		if len(entries) > 0 {
+			return nil
+		}
+		if err := os.Remove(dir); err != nil {
+			return err
+		}
+		dir = filepath.Dir(dir)
+	}
+	return nil
+}
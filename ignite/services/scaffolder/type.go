@@ -35,13 +35,28 @@ type addTypeOptions struct {
 	isMap       bool
 	isSingleton bool
 
-	indexes []string
+	indexes          []string
+	secondaryIndexes []string
 
 	withoutMessage    bool
 	withoutSimulation bool
 	signer            string
 }
 
+// componentKind returns the kind of the component scaffolded with the options.
+func (o addTypeOptions) componentKind() ComponentKind {
+	switch {
+	case o.isList:
+		return ComponentList
+	case o.isMap:
+		return ComponentMap
+	case o.isSingleton:
+		return ComponentSingle
+	default:
+		return ComponentType
+	}
+}
+
 // newAddTypeOptions returns a addTypeOptions with default options.
 func newAddTypeOptions(moduleName string) addTypeOptions {
 	return addTypeOptions{
@@ -78,6 +93,13 @@ func DryType() AddTypeKind {
 	return func(o *addTypeOptions) {}
 }
 
+// TypeWithSecondaryIndexes adds secondary indexes to list the values of a map type by these fields.
+func TypeWithSecondaryIndexes(fields ...string) AddTypeOption {
+	return func(o *addTypeOptions) {
+		o.secondaryIndexes = fields
+	}
+}
+
 // TypeWithModule module to scaffold type into.
 func TypeWithModule(name string) AddTypeOption {
 	return func(o *addTypeOptions) {
@@ -155,6 +177,13 @@ func (s Scaffolder) AddType(
 		return sm, err
 	}
 
+	// enums are defined in a proto file named after them, like the type
+	for _, f := range tFields.Enums() {
+		if f.Name.Snake == name.Snake {
+			return sm, fmt.Errorf("the enum field %s can't have the same name as the type", f.Name.Original)
+		}
+	}
+
 	mfSigner, err := multiformatname.NewName(o.signer)
 	if err != nil {
 		return sm, err
@@ -165,6 +194,12 @@ func (s Scaffolder) AddType(
 		return sm, err
 	}
 
+	// list the app files to record the changes made by the scaffolding
+	history, err := newHistoryRecorder(s.path)
+	if err != nil {
+		return sm, err
+	}
+
 	var (
 		g    *genny.Generator
 		opts = &typed.Options{
@@ -219,12 +254,24 @@ func (s Scaffolder) AddType(
 		return sm, err
 	}
 
+	gens, err = supportEnums(
+		gens,
+		opts.AppPath,
+		opts.AppName,
+		opts.ModulePath,
+		opts.ModuleName,
+		opts.Fields,
+	)
+	if err != nil {
+		return sm, err
+	}
+
 	// create the type generator depending on the model
 	switch {
 	case o.isList:
 		g, err = list.NewGenerator(tracer, opts)
 	case o.isMap:
-		g, err = mapGenerator(tracer, opts, o.indexes)
+		g, err = mapGenerator(tracer, opts, o.indexes, o.secondaryIndexes)
 	case o.isSingleton:
 		g, err = singleton.NewGenerator(tracer, opts)
 	default:
@@ -241,7 +288,10 @@ func (s Scaffolder) AddType(
 		return sm, err
 	}
 
-	return sm, finish(ctx, cacheStorage, opts.AppPath, s.modpath.RawPath)
+	if err := finish(ctx, cacheStorage, opts.AppPath, s.modpath.RawPath); err != nil {
+		return sm, err
+	}
+	return sm, history.record(cacheStorage, o.componentKind(), moduleName, name, sm)
 }
 
 // checkForbiddenTypeIndex returns true if the name is forbidden as a index name.
@@ -290,7 +340,12 @@ func parseTypeFields(opts addTypeOptions) (field.Fields, error) {
 }
 
 // mapGenerator returns the template generator for a map.
-func mapGenerator(replacer placeholder.Replacer, opts *typed.Options, indexes []string) (*genny.Generator, error) {
+func mapGenerator(
+	replacer placeholder.Replacer,
+	opts *typed.Options,
+	indexes,
+	secondaryIndexes []string,
+) (*genny.Generator, error) {
 	// Parse indexes with the associated type
 	parsedIndexes, err := field.ParseFields(indexes, checkForbiddenTypeIndex)
 	if err != nil {
@@ -309,5 +364,44 @@ func mapGenerator(replacer placeholder.Replacer, opts *typed.Options, indexes []
 	}
 
 	opts.Indexes = parsedIndexes
+
+	// Secondary indexes must be fields of the type with an index type
+	opts.SecondaryIndexes, err = parseSecondaryIndexes(opts.Fields, secondaryIndexes)
+	if err != nil {
+		return nil, err
+	}
+
 	return maptype.NewGenerator(replacer, opts)
 }
+
+// parseSecondaryIndexes returns the fields used as secondary indexes.
+func parseSecondaryIndexes(fields field.Fields, names []string) (field.Fields, error) {
+	var secondaryIndexes field.Fields
+	for _, name := range names {
+		mfName, err := multiformatname.NewName(name)
+		if err != nil {
+			return nil, err
+		}
+
+		var found bool
+		for _, f := range fields {
+			if f.Name.LowerCamel != mfName.LowerCamel {
+				continue
+			}
+			if dt, ok := datatype.IsSupportedType(f.DatatypeName); !ok || dt.NonIndex {
+				return nil, fmt.Errorf("the field %s can't be a secondary index because its type is %s", name, f.DatatypeName)
+			}
+			for _, index := range secondaryIndexes {
+				if index.Name.LowerCamel == f.Name.LowerCamel {
+					return nil, fmt.Errorf("the secondary index %s is defined twice", name)
+				}
+			}
+			secondaryIndexes = append(secondaryIndexes, f)
+			found = true
+		}
+		if !found {
+			return nil, fmt.Errorf("the secondary index %s is not a field of the type", name)
+		}
+	}
+	return secondaryIndexes, nil
+}
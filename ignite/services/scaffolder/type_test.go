@@ -216,5 +216,68 @@ func TestCheckForbiddenTypeIndexField(t *testing.T) {
 	}
 }
 
+func TestParseSecondaryIndexes(t *testing.T) {
+	fields, err := field.ParseFields(
+		[]string{"owner:address", "denom", "amount:coin", "price:uint"},
+		checkForbiddenTypeField,
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		indexes     []string
+		expected    []string
+		shouldError bool
+	}{
+		{
+			name:     "should pass with no secondary index",
+			indexes:  nil,
+			expected: nil,
+		},
+		{
+			name:     "should pass with fields of index types",
+			indexes:  []string{"owner", "price"},
+			expected: []string{"owner", "price"},
+		},
+		{
+			name:     "should pass with a field name in another format",
+			indexes:  []string{"Denom"},
+			expected: []string{"denom"},
+		},
+		{
+			name:        "should fail with a field that is not indexable",
+			indexes:     []string{"amount"},
+			shouldError: true,
+		},
+		{
+			name:        "should fail with an unknown field",
+			indexes:     []string{"seller"},
+			shouldError: true,
+		},
+		{
+			name:        "should fail with a duplicated field",
+			indexes:     []string{"owner", "owner"},
+			shouldError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			indexes, err := parseSecondaryIndexes(fields, tc.indexes)
+			if tc.shouldError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var names []string
+			for _, index := range indexes {
+				names = append(names, index.Name.LowerCamel)
+			}
+			require.Equal(t, tc.expected, names)
+		})
+	}
+}
+
 func TestAddType(t *testing.T) {
 }
@@ -0,0 +1,65 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/emicklei/proto"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis/protoutil"
+)
+
+// sampleAddressPrefix is the bech32 prefix used for the sample addresses of the
+// genesis tests, where the address prefix is not validated.
+const sampleAddressPrefix = "cosmos"
+
+// DataAddress is an account address data type definition.
+// The test values are encoded when the tests run to use the address prefix of the app.
+var DataAddress = DataType{
+	DataType:          func(string) string { return "string" },
+	DefaultTestExpr:   "sdk.AccAddress([]byte(\"address\")).String()",
+	ValueLoop:         "sdk.AccAddress([]byte(strconv.Itoa(i))).String()",
+	ValueIndex:        "sdk.AccAddress([]byte(strconv.Itoa(0))).String()",
+	ValueInvalidIndex: "sdk.AccAddress([]byte(strconv.Itoa(100000))).String()",
+	ProtoType: func(_, name string, index int) string {
+		return fmt.Sprintf("string %s = %d", name, index)
+	},
+	GenesisArgs: func(name multiformatname.Name, value int) string {
+		return fmt.Sprintf("%s: \"%s\",\n", name.UpperCamel, sampleAddress(value))
+	},
+	CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+		return fmt.Sprintf(`%[1]v%[2]v := args[%[3]v]
+					if _, err := sdk.AccAddressFromBech32(%[1]v%[2]v); err != nil {
+						return err
+					}`, prefix, name.UpperCamel, argIndex)
+	},
+	ToBytes: func(name string) string {
+		return fmt.Sprintf(`_, %[1]vBytes, err := bech32.DecodeAndConvert(%[1]v)
+					if err != nil {
+						return nil, err
+					}
+					%[1]vBytes = append(binary.AppendUvarint(nil, uint64(len(%[1]vBytes))), %[1]vBytes...)`, name)
+	},
+	ToString: func(name string) string {
+		return name
+	},
+	ToProtoField: func(_, name string, index int) *proto.NormalField {
+		return protoutil.NewField(name, "string", index)
+	},
+	GoCLIImports: []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+	GoKeyImports: []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types/bech32"}},
+}
+
+// sampleAddress returns a valid bech32 account address that is unique for each value.
+func sampleAddress(value int) string {
+	bz := make([]byte, 20)
+	bz[len(bz)-1] = byte(value)
+	bz[len(bz)-2] = byte(value >> 8)
+
+	addr, err := bech32.ConvertAndEncode(sampleAddressPrefix, bz)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
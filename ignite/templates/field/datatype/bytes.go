@@ -0,0 +1,29 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/emicklei/proto"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis/protoutil"
+)
+
+// DataBytes is a bytes data type definition.
+var DataBytes = DataType{
+	DataType:         func(string) string { return "[]byte" },
+	DefaultTestValue: "xyz",
+	ProtoType: func(_, name string, index int) string {
+		return fmt.Sprintf("bytes %s = %d", name, index)
+	},
+	GenesisArgs: func(name multiformatname.Name, value int) string {
+		return fmt.Sprintf("%s: []byte(\"%d\"),\n", name.UpperCamel, value)
+	},
+	CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+		return fmt.Sprintf("%s%s := []byte(args[%d])", prefix, name.UpperCamel, argIndex)
+	},
+	ToProtoField: func(_, name string, index int) *proto.NormalField {
+		return protoutil.NewField(name, "bytes", index)
+	},
+	NonIndex: true,
+}
@@ -0,0 +1,46 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/emicklei/proto"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis/protoutil"
+)
+
+// decimalCustomType is the Go type used by gogoproto for decimal fields.
+const decimalCustomType = "github.com/cosmos/cosmos-sdk/types.Dec"
+
+// DataDecimal is a decimal data type definition.
+var DataDecimal = DataType{
+	DataType:         func(string) string { return "sdk.Dec" },
+	DefaultTestValue: "1.5",
+	ProtoType: func(_, name string, index int) string {
+		return fmt.Sprintf(
+			"string %s = %d [(gogoproto.customtype) = \"%s\", (gogoproto.nullable) = false]",
+			name, index, decimalCustomType,
+		)
+	},
+	GenesisArgs: func(name multiformatname.Name, value int) string {
+		return fmt.Sprintf("%s: sdk.NewDec(%d),\n", name.UpperCamel, value)
+	},
+	CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+		return fmt.Sprintf(`%s%s, err := sdk.NewDecFromStr(args[%d])
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, argIndex)
+	},
+	GoCLIImports:     []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+	GoGenesisImports: []GoImport{{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"}},
+	ProtoImports:     []string{"gogoproto/gogo.proto"},
+	NonIndex:         true,
+	ToProtoField: func(_, name string, index int) *proto.NormalField {
+		return protoutil.NewField(
+			name, "string", index, protoutil.WithFieldOptions(
+				protoutil.NewOption("gogoproto.customtype", decimalCustomType, protoutil.Custom()),
+				protoutil.NewOption("gogoproto.nullable", "false", protoutil.Custom()),
+			),
+		)
+	},
+}
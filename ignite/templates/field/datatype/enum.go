@@ -0,0 +1,58 @@
+package datatype
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/emicklei/proto"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis/protoutil"
+)
+
+// EnumValueSeparator represents the separator of the enum values.
+const EnumValueSeparator = "|"
+
+// DataEnum is an enum data type definition.
+// The data type is the name of the proto enum, which is
+// defined in its own proto file within the module.
+var DataEnum = DataType{
+	DataType:         func(datatype string) string { return datatype },
+	DefaultTestValue: "0",
+	ProtoType: func(datatype, name string, index int) string {
+		return fmt.Sprintf("%s %s = %d", datatype, name, index)
+	},
+	// the genesis args use the first enum value, which only the field knows
+	GenesisArgs: func(multiformatname.Name, int) string { return "" },
+	CLIArgs: func(name multiformatname.Name, datatype, prefix string, argIndex int) string {
+		// the value can be the proto value name, the value name without the
+		// enum prefix in any case, or the value number.
+		return fmt.Sprintf(`%[1]v%[2]vName := strings.ToUpper(args[%[4]v])
+					%[1]v%[2]vValue, ok := types.%[3]v_value[%[1]v%[2]vName]
+					if !ok {
+						%[1]v%[2]vValue, ok = types.%[3]v_value["%[5]v_"+%[1]v%[2]vName]
+					}
+					if !ok {
+						value, err := cast.ToInt32E(args[%[4]v])
+						if err != nil {
+							return err
+						}
+						%[1]v%[2]vValue = value
+					}
+					%[1]v%[2]v := types.%[3]v(%[1]v%[2]vValue)`, prefix, name.UpperCamel, datatype, argIndex, enumValuePrefix(datatype))
+	},
+	ToProtoField: func(datatype, name string, index int) *proto.NormalField {
+		return protoutil.NewField(name, datatype, index)
+	},
+	GoCLIImports: []GoImport{{Name: "strings"}, {Name: "github.com/spf13/cast"}},
+	NonIndex:     true,
+}
+
+// enumValuePrefix returns the prefix of the proto value names of an enum.
+func enumValuePrefix(enumName string) string {
+	name, err := multiformatname.NewName(enumName)
+	if err != nil {
+		return strings.ToUpper(enumName)
+	}
+	return strings.ToUpper(name.Snake)
+}
@@ -27,7 +27,8 @@ var (
 			return fmt.Sprintf("%s%s := args[%d]", prefix, name.UpperCamel, argIndex)
 		},
 		ToBytes: func(name string) string {
-			return fmt.Sprintf("%[1]vBytes := []byte(%[1]v)", name)
+			return fmt.Sprintf(`%[1]vBytes := binary.AppendUvarint(nil, uint64(len(%[1]v)))
+					%[1]vBytes = append(%[1]vBytes, %[1]v...)`, name)
 		},
 		ToString: func(name string) string {
 			return name
@@ -28,6 +28,16 @@ const (
 	Coin Name = "coin"
 	// Coins represents the coin array type name.
 	Coins Name = "array.coin"
+	// Bytes represents the bytes type name.
+	Bytes Name = "bytes"
+	// Address represents the account address type name.
+	Address Name = "address"
+	// Decimal represents the decimal type name.
+	Decimal Name = "decimal"
+	// Timestamp represents the timestamp type name.
+	Timestamp Name = "timestamp"
+	// Enum represents the enum type name.
+	Enum Name = "enum"
 	// Custom represents the custom type name.
 	Custom Name = Name(TypeCustom)
 
@@ -59,6 +69,11 @@ var supportedTypes = map[Name]DataType{
 	Coin:             DataCoin,
 	Coins:            DataCoinSlice,
 	CoinSliceAlias:   DataCoinSlice,
+	Bytes:            DataBytes,
+	Address:          DataAddress,
+	Decimal:          DataDecimal,
+	Timestamp:        DataTimestamp,
+	Enum:             DataEnum,
 	Custom:           DataCustom,
 }
 
@@ -71,8 +86,12 @@ type DataType struct {
 	ProtoType         func(datatype, name string, index int) string
 	GenesisArgs       func(name multiformatname.Name, value int) string
 	ProtoImports      []string
+	GoImports         []GoImport
 	GoCLIImports      []GoImport
+	GoKeyImports      []GoImport
+	GoGenesisImports  []GoImport
 	DefaultTestValue  string
+	DefaultTestExpr   string
 	ValueLoop         string
 	ValueIndex        string
 	ValueInvalidIndex string
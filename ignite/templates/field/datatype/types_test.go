@@ -59,6 +59,31 @@ func TestIsSupportedType(t *testing.T) {
 			typename: datatype.Coins,
 			ok:       true,
 		},
+		{
+			name:     "bytes",
+			typename: datatype.Bytes,
+			ok:       true,
+		},
+		{
+			name:     "address",
+			typename: datatype.Address,
+			ok:       true,
+		},
+		{
+			name:     "decimal",
+			typename: datatype.Decimal,
+			ok:       true,
+		},
+		{
+			name:     "timestamp",
+			typename: datatype.Timestamp,
+			ok:       true,
+		},
+		{
+			name:     "enum",
+			typename: datatype.Enum,
+			ok:       true,
+		},
 		{
 			name:     "custom",
 			typename: datatype.Custom,
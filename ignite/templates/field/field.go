@@ -3,6 +3,9 @@ package field
 
 import (
 	"fmt"
+	"html/template"
+	"strconv"
+	"strings"
 
 	"github.com/emicklei/proto"
 
@@ -16,6 +19,9 @@ type Field struct {
 	Name         multiformatname.Name
 	DatatypeName datatype.Name
 	Datatype     string
+
+	// EnumValues contains the values of the field when its type is an enum.
+	EnumValues []string
 }
 
 // DataType returns the field Datatype.
@@ -41,13 +47,17 @@ func (f Field) ProtoType(index int) string {
 	return dt.ProtoType(f.Datatype, f.ProtoFieldName(), index)
 }
 
-// DefaultTestValue returns the Datatype value default.
-func (f Field) DefaultTestValue() string {
+// DefaultTestValue returns the Go expression of the Datatype value default.
+// The expression is returned as HTML so the templates don't escape it.
+func (f Field) DefaultTestValue() template.HTML {
 	dt, ok := datatype.IsSupportedType(f.DatatypeName)
 	if !ok {
 		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
 	}
-	return dt.DefaultTestValue
+	if dt.DefaultTestExpr != "" {
+		return template.HTML(dt.DefaultTestExpr)
+	}
+	return template.HTML(strconv.Quote(dt.DefaultTestValue))
 }
 
 // ValueLoop returns the Datatype value for loop iteration.
@@ -87,24 +97,30 @@ func (f Field) ValueInvalidIndex() string {
 }
 
 // GenesisArgs returns the Datatype genesis args.
+// Enum fields use their first value, which is the default value of the enum.
 func (f Field) GenesisArgs(value int) string {
 	dt, ok := datatype.IsSupportedType(f.DatatypeName)
 	if !ok {
 		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
 	}
+	if f.IsEnum() && len(f.EnumValues) > 0 {
+		return fmt.Sprintf("%s: types.%s_%s,\n", f.Name.UpperCamel, f.Datatype, f.ProtoEnumValues()[0])
+	}
 	return dt.GenesisArgs(f.Name, value)
 }
 
 // CLIArgs returns the Datatype CLI args.
-func (f Field) CLIArgs(prefix string, argIndex int) string {
+// The code is returned as HTML so the templates don't escape it.
+func (f Field) CLIArgs(prefix string, argIndex int) template.HTML {
 	dt, ok := datatype.IsSupportedType(f.DatatypeName)
 	if !ok {
 		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
 	}
-	return dt.CLIArgs(f.Name, f.Datatype, prefix, argIndex)
+	return template.HTML(dt.CLIArgs(f.Name, f.Datatype, prefix, argIndex))
 }
 
 // ToBytes returns the Datatype byte array cast.
+// The code returns nil and an error from the enclosing function when the value can't be encoded.
 func (f Field) ToBytes(name string) string {
 	dt, ok := datatype.IsSupportedType(f.DatatypeName)
 	if !ok {
@@ -138,6 +154,15 @@ func (f Field) ToProtoField(index int) *proto.NormalField {
 	return dt.ToProtoField(f.Datatype, f.Name.LowerCamel, index)
 }
 
+// GoImports returns the Datatype imports required to use the Go type.
+func (f Field) GoImports() []datatype.GoImport {
+	dt, ok := datatype.IsSupportedType(f.DatatypeName)
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	return dt.GoImports
+}
+
 // GoCLIImports returns the Datatype imports for CLI package.
 func (f Field) GoCLIImports() []datatype.GoImport {
 	dt, ok := datatype.IsSupportedType(f.DatatypeName)
@@ -147,6 +172,43 @@ func (f Field) GoCLIImports() []datatype.GoImport {
 	return dt.GoCLIImports
 }
 
+// GoKeyImports returns the Datatype imports required to encode the field in a store key.
+func (f Field) GoKeyImports() []datatype.GoImport {
+	dt, ok := datatype.IsSupportedType(f.DatatypeName)
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	return dt.GoKeyImports
+}
+
+// GoGenesisImports returns the Datatype imports required by the genesis args.
+func (f Field) GoGenesisImports() []datatype.GoImport {
+	dt, ok := datatype.IsSupportedType(f.DatatypeName)
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	return dt.GoGenesisImports
+}
+
+// IsEnum checks if the field type is an enum.
+func (f Field) IsEnum() bool {
+	return f.DatatypeName == datatype.Enum
+}
+
+// ProtoEnumValues returns the enum values with the names used in proto.
+// Enum values are prefixed with the enum name to follow the proto style guide.
+func (f Field) ProtoEnumValues() []string {
+	values := make([]string, len(f.EnumValues))
+	for i, v := range f.EnumValues {
+		name, err := multiformatname.NewName(v)
+		if err != nil {
+			panic(err)
+		}
+		values[i] = strings.ToUpper(fmt.Sprintf("%s_%s", f.Name.Snake, name.Snake))
+	}
+	return values
+}
+
 // ProtoImports returns the Datatype imports for proto files.
 func (f Field) ProtoImports() []string {
 	dt, ok := datatype.IsSupportedType(f.DatatypeName)
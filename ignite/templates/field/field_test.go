@@ -0,0 +1,38 @@
+package field
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldGenesisArgs(t *testing.T) {
+	tests := []struct {
+		name  string
+		field string
+		want  string
+	}{
+		{
+			name:  "decimal",
+			field: "price:decimal",
+			want:  "Price: sdk.NewDec(3),\n",
+		},
+		{
+			name:  "timestamp",
+			field: "deadline:timestamp",
+			want:  "Deadline: time.Unix(3, 0).UTC(),\n",
+		},
+		{
+			name:  "enum",
+			field: "color:enum:red|green",
+			want:  "Color: types.Color_COLOR_RED,\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields, err := ParseFields([]string{tt.field}, noCheck)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, fields[0].GenesisArgs(3))
+		})
+	}
+}
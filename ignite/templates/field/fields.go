@@ -10,12 +10,32 @@ import (
 // Fields represents a Field slice.
 type Fields []Field
 
+// GoImports returns all go imports required to use the field types.
+func (f Fields) GoImports() []datatype.GoImport {
+	return f.goImports(Field.GoImports)
+}
+
 // GoCLIImports returns all go CLI imports.
 func (f Fields) GoCLIImports() []datatype.GoImport {
+	return f.goImports(Field.GoCLIImports)
+}
+
+// GoKeyImports returns all go imports required to encode the fields in a store key.
+func (f Fields) GoKeyImports() []datatype.GoImport {
+	return f.goImports(Field.GoKeyImports)
+}
+
+// GoGenesisImports returns all go imports required by the genesis args of the fields.
+func (f Fields) GoGenesisImports() []datatype.GoImport {
+	return f.goImports(Field.GoGenesisImports)
+}
+
+// goImports returns the imports of the fields without duplicates.
+func (f Fields) goImports(imports func(Field) []datatype.GoImport) []datatype.GoImport {
 	allImports := make([]datatype.GoImport, 0)
 	exist := make(map[string]struct{})
-	for _, fields := range f {
-		for _, goImport := range fields.GoCLIImports() {
+	for _, field := range f {
+		for _, goImport := range imports(field) {
 			if _, ok := exist[goImport.Name]; ok {
 				continue
 			}
@@ -52,10 +72,11 @@ func (f Fields) String() string {
 }
 
 // Custom return a list of custom fields.
+// Enum fields are included because each enum is defined in its own proto file.
 func (f Fields) Custom() []string {
 	fields := make([]string, 0)
 	for _, field := range f {
-		if field.DatatypeName == datatype.TypeCustom {
+		if field.DatatypeName == datatype.TypeCustom || field.IsEnum() {
 			dataType, err := multiformatname.NewName(field.Datatype)
 			if err != nil {
 				panic(err)
@@ -65,3 +86,14 @@ func (f Fields) Custom() []string {
 	}
 	return fields
 }
+
+// Enums returns the fields with an enum type.
+func (f Fields) Enums() Fields {
+	fields := make(Fields, 0)
+	for _, field := range f {
+		if field.IsEnum() {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
@@ -9,29 +9,58 @@ import (
 )
 
 // validateField validates the field Name and type, and checks the name is not forbidden by Ignite CLI.
-func validateField(field string, isForbiddenField func(string) error) (multiformatname.Name, datatype.Name, error) {
+// The enum values are returned when the field type is an enum.
+func validateField(field string, isForbiddenField func(string) error) (multiformatname.Name, datatype.Name, []string, error) {
 	fieldSplit := strings.Split(field, datatype.Separator)
-	if len(fieldSplit) > 2 {
-		return multiformatname.Name{}, "", fmt.Errorf("invalid field format: %s, should be 'Name' or 'Name:type'", field)
+	if len(fieldSplit) > 3 || (len(fieldSplit) == 3 && datatype.Name(fieldSplit[1]) != datatype.Enum) {
+		return multiformatname.Name{}, "", nil, fmt.Errorf(
+			"invalid field format: %s, should be 'Name', 'Name:type' or 'Name:enum:Value1|Value2'",
+			field,
+		)
 	}
 
 	name, err := multiformatname.NewName(fieldSplit[0])
 	if err != nil {
-		return name, "", err
+		return name, "", nil, err
 	}
 
 	// Ensure the field Name is not a Go reserved Name, it would generate an incorrect code
 	if err := isForbiddenField(name.LowerCamel); err != nil {
-		return name, "", fmt.Errorf("%s can't be used as a field Name: %w", name, err)
+		return name, "", nil, fmt.Errorf("%s can't be used as a field Name: %w", name, err)
 	}
 
 	// Check if the object has an explicit type. The default is a string
 	dataTypeName := datatype.String
-	isTypeSpecified := len(fieldSplit) == 2
+	isTypeSpecified := len(fieldSplit) >= 2
 	if isTypeSpecified {
 		dataTypeName = datatype.Name(fieldSplit[1])
 	}
-	return name, dataTypeName, nil
+
+	if dataTypeName != datatype.Enum {
+		return name, dataTypeName, nil, nil
+	}
+
+	// Enum fields must define at least one value
+	if len(fieldSplit) != 3 || fieldSplit[2] == "" {
+		return name, "", nil, fmt.Errorf("enum field %s must define its values, e.g. 'Name:enum:Value1|Value2'", name.Original)
+	}
+
+	var (
+		values []string
+		exist  = make(map[string]struct{})
+	)
+	for _, v := range strings.Split(fieldSplit[2], datatype.EnumValueSeparator) {
+		value, err := multiformatname.NewName(v)
+		if err != nil {
+			return name, "", nil, fmt.Errorf("invalid enum value %s: %w", v, err)
+		}
+		if _, ok := exist[value.Snake]; ok {
+			return name, "", nil, fmt.Errorf("the enum value %s is duplicated", v)
+		}
+		exist[value.Snake] = struct{}{}
+		values = append(values, value.Original)
+	}
+	return name, dataTypeName, values, nil
 }
 
 // ParseFields parses the provided fields, analyses the types
@@ -51,7 +80,7 @@ func ParseFields(
 
 	var parsedFields Fields
 	for _, field := range fields {
-		name, datatypeName, err := validateField(field, isForbiddenField)
+		name, datatypeName, enumValues, err := validateField(field, isForbiddenField)
 		if err != nil {
 			return parsedFields, err
 		}
@@ -62,6 +91,17 @@ func ParseFields(
 		}
 		existingFields[name.LowerCamel] = struct{}{}
 
+		// Enum types are named after the field
+		if datatypeName == datatype.Enum {
+			parsedFields = append(parsedFields, Field{
+				Name:         name,
+				Datatype:     name.UpperCamel,
+				DatatypeName: datatypeName,
+				EnumValues:   enumValues,
+			})
+			continue
+		}
+
 		// Check if is a static type
 		if _, ok := datatype.IsSupportedType(datatypeName); ok {
 			parsedFields = append(parsedFields, Field{
@@ -35,6 +35,18 @@ func TestForbiddenParseFields(t *testing.T) {
 	// invalid format
 	_, err = ParseFields([]string{"foo:int:int"}, alwaysInvalid)
 	require.Error(t, err)
+
+	// enum without values
+	_, err = ParseFields([]string{"foo:enum"}, noCheck)
+	require.Error(t, err)
+
+	// enum with empty value
+	_, err = ParseFields([]string{"foo:enum:a||b"}, noCheck)
+	require.Error(t, err)
+
+	// enum with duplicated values
+	_, err = ParseFields([]string{"foo:enum:a|b|a"}, noCheck)
+	require.Error(t, err)
 }
 
 func TestParseFields1(t *testing.T) {
@@ -182,6 +194,47 @@ func TestParseFields1(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "test bytes, address, decimal and timestamp types",
+			fields: []string{
+				name1.Original + ":bytes",
+				name2.Original + ":address",
+				name3.Original + ":decimal",
+				name4.Original + ":timestamp",
+			},
+			want: Fields{
+				{
+					Name:         name1,
+					DatatypeName: datatype.Bytes,
+				},
+				{
+					Name:         name2,
+					DatatypeName: datatype.Address,
+				},
+				{
+					Name:         name3,
+					DatatypeName: datatype.Decimal,
+				},
+				{
+					Name:         name4,
+					DatatypeName: datatype.Timestamp,
+				},
+			},
+		},
+		{
+			name: "test enum types",
+			fields: []string{
+				name2.Original + ":enum:pending|done",
+			},
+			want: Fields{
+				{
+					Name:         name2,
+					DatatypeName: datatype.Enum,
+					Datatype:     "FooBar",
+					EnumValues:   []string{"pending", "done"},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
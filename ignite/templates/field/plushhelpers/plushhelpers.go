@@ -13,6 +13,8 @@ import (
 // ExtendPlushContext sets available field helpers on the provided context.
 func ExtendPlushContext(ctx *plush.Context) {
 	ctx.Set("mergeGoImports", mergeGoImports)
+	ctx.Set("mergeGoTypeImports", mergeGoTypeImports)
+	ctx.Set("mergeGoKeyImports", mergeGoKeyImports)
 	ctx.Set("mergeProtoImports", mergeProtoImports)
 	ctx.Set("mergeCustomImports", mergeCustomImports)
 	ctx.Set("title", xstrings.Title)
@@ -35,18 +37,15 @@ func mergeCustomImports(fields ...field.Fields) []string {
 }
 
 func mergeGoImports(fields ...field.Fields) []datatype.GoImport {
-	allImports := make([]datatype.GoImport, 0)
-	exist := make(map[string]struct{})
-	for _, fields := range fields {
-		for _, goImport := range fields.GoCLIImports() {
-			if _, ok := exist[goImport.Name]; ok {
-				continue
-			}
-			exist[goImport.Name] = struct{}{}
-			allImports = append(allImports, goImport)
-		}
-	}
-	return allImports
+	return mergeFields(fields...).GoCLIImports()
+}
+
+func mergeGoTypeImports(fields ...field.Fields) []datatype.GoImport {
+	return mergeFields(fields...).GoImports()
+}
+
+func mergeGoKeyImports(fields ...field.Fields) []datatype.GoImport {
+	return mergeFields(fields...).GoKeyImports()
 }
 
 func mergeProtoImports(fields ...field.Fields) []string {
@@ -63,3 +62,12 @@ func mergeProtoImports(fields ...field.Fields) []string {
 	}
 	return allImports
 }
+
+// mergeFields returns a single list with the fields of all the lists.
+func mergeFields(fields ...field.Fields) field.Fields {
+	var merged field.Fields
+	for _, f := range fields {
+		merged = append(merged, f...)
+	}
+	return merged
+}
@@ -0,0 +1,81 @@
+package field
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/emicklei/proto"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/templates/field/datatype"
+)
+
+// protoDatatypes lists the types that can be identified from the definition of a proto field.
+// The address type is not included because it is defined the same way than the string type.
+var protoDatatypes = []datatype.Name{
+	datatype.String,
+	datatype.StringSlice,
+	datatype.Bool,
+	datatype.Int,
+	datatype.IntSlice,
+	datatype.Uint,
+	datatype.UintSlice,
+	datatype.Coin,
+	datatype.Coins,
+	datatype.Bytes,
+	datatype.Decimal,
+	datatype.Timestamp,
+}
+
+// ParseProtoField returns the field that corresponds to a field of a proto message.
+// Fields that don't have a scalar or a supported type are custom types, unless
+// their type is an enum according to isEnum.
+func ParseProtoField(f *proto.NormalField, isEnum func(typename string) bool) (Field, error) {
+	name, err := multiformatname.NewName(f.Name)
+	if err != nil {
+		return Field{}, err
+	}
+
+	for _, typeName := range protoDatatypes {
+		dt, _ := datatype.IsSupportedType(typeName)
+		if protoFieldsEqual(dt.ToProtoField("", f.Name, f.Sequence), f) {
+			return Field{
+				Name:         name,
+				DatatypeName: typeName,
+			}, nil
+		}
+	}
+
+	if f.Repeated || len(f.Options) > 0 {
+		return Field{}, fmt.Errorf("the type of the field %s is not supported", f.Name)
+	}
+	if isEnum(f.Type) {
+		return Field{
+			Name:         name,
+			DatatypeName: datatype.Enum,
+			Datatype:     f.Type,
+		}, nil
+	}
+	return Field{
+		Name:         name,
+		DatatypeName: datatype.Custom,
+		Datatype:     f.Type,
+	}, nil
+}
+
+func protoFieldsEqual(want, got *proto.NormalField) bool {
+	if want.Type != got.Type || want.Repeated != got.Repeated || len(want.Options) != len(got.Options) {
+		return false
+	}
+	for i, opt := range want.Options {
+		if protoOptionName(opt.Name) != protoOptionName(got.Options[i].Name) ||
+			opt.Constant.Source != got.Options[i].Constant.Source {
+			return false
+		}
+	}
+	return true
+}
+
+func protoOptionName(name string) string {
+	return strings.NewReplacer("(", "", ")", "").Replace(name)
+}
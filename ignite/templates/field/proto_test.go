@@ -0,0 +1,63 @@
+package field
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/emicklei/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/ignite/templates/field/datatype"
+)
+
+func TestParseProtoField(t *testing.T) {
+	f, err := protoutil.ParseProtoFile(strings.NewReader(`syntax = "proto3";
+
+message Post {
+  string title = 1;
+  repeated string tags = 2;
+  uint64 count = 3;
+  cosmos.base.v1beta1.Coin price = 4 [(gogoproto.nullable) = false];
+  string rate = 5 [(gogoproto.customtype) = "github.com/cosmos/cosmos-sdk/types.Dec", (gogoproto.nullable) = false];
+  google.protobuf.Timestamp deadline = 6 [(gogoproto.stdtime) = true, (gogoproto.nullable) = false];
+  Status status = 7;
+  Details details = 8;
+  repeated Details history = 9;
+}`))
+	require.NoError(t, err)
+	msg, err := protoutil.GetMessageByName(f, "Post")
+	require.NoError(t, err)
+
+	isEnum := func(typename string) bool { return typename == "Status" }
+
+	tests := []struct {
+		name         string
+		datatypeName datatype.Name
+		datatype     string
+		wantErr      bool
+	}{
+		{name: "title", datatypeName: datatype.String},
+		{name: "tags", datatypeName: datatype.StringSlice},
+		{name: "count", datatypeName: datatype.Uint},
+		{name: "price", datatypeName: datatype.Coin},
+		{name: "rate", datatypeName: datatype.Decimal},
+		{name: "deadline", datatypeName: datatype.Timestamp},
+		{name: "status", datatypeName: datatype.Enum, datatype: "Status"},
+		{name: "details", datatypeName: datatype.Custom, datatype: "Details"},
+		{name: "history", wantErr: true},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseProtoField(msg.Elements[i].(*proto.NormalField), isEnum)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.name, got.Name.LowerCamel)
+			require.Equal(t, tt.datatypeName, got.DatatypeName)
+			require.Equal(t, tt.datatype, got.Datatype)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package modulecreate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/emicklei/proto"
+	"github.com/gobuffalo/genny/v2"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field"
+	"github.com/ignite/cli/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/ignite/templates/module"
+)
+
+// AddEnums returns the generators to generate the proto files of the enum fields.
+// Each enum is defined in its own proto file, named after the enum. The values
+// missing in the enum are added to the proto file when it already exists.
+func AddEnums(appPath, appName, modulePath, moduleName string, enums ...field.Field) ([]*genny.Generator, error) {
+	var (
+		gens   []*genny.Generator
+		merged = make(map[string]*field.Field)
+		order  []*field.Field
+	)
+
+	// merge the values of the fields that use the same enum
+	for _, enum := range enums {
+		f, ok := merged[enum.Datatype]
+		if !ok {
+			f = &field.Field{
+				Name:         enum.Name,
+				Datatype:     enum.Datatype,
+				DatatypeName: enum.DatatypeName,
+			}
+			merged[enum.Datatype] = f
+			order = append(order, f)
+		}
+		for _, v := range enum.EnumValues {
+			if !containsEnumValue(f.EnumValues, v) {
+				f.EnumValues = append(f.EnumValues, v)
+			}
+		}
+	}
+
+	for _, enum := range order {
+		path := filepath.Join(appPath, "proto", appName, moduleName, enum.Name.Snake+".proto")
+		if _, err := os.Stat(path); err == nil {
+			gens = append(gens, updateEnum(path, *enum))
+			continue
+		} else if !os.IsNotExist(err) {
+			return nil, err
+		}
+
+		g, err := newEnum(appPath, appName, modulePath, moduleName, *enum)
+		if err != nil {
+			return nil, err
+		}
+		gens = append(gens, g)
+	}
+	return gens, nil
+}
+
+// newEnum returns the generator to create the proto file of an enum.
+func newEnum(appPath, appName, modulePath, moduleName string, enum field.Field) (*genny.Generator, error) {
+	var (
+		g        = genny.New()
+		template = xgenny.NewEmbedWalker(fsEnum, "files/enum/", appPath)
+	)
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", moduleName)
+	ctx.Set("modulePath", modulePath)
+	ctx.Set("protoPkgName", module.ProtoPackageName(gomodulepath.ExtractAppPath(modulePath), moduleName))
+	ctx.Set("enumName", enum.Datatype)
+	ctx.Set("enumValues", enum.ProtoEnumValues())
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{appName}}", appName))
+	g.Transformer(genny.Replace("{{moduleName}}", moduleName))
+	g.Transformer(genny.Replace("{{enumName}}", enum.Name.Snake))
+	return g, nil
+}
+
+// updateEnum returns the generator to add the missing values of an enum to its
+// existing proto file. It fails when the proto file doesn't define the enum,
+// which happens when a type with the same name is already scaffolded.
+func updateEnum(path string, enum field.Field) *genny.Generator {
+	g := genny.New()
+	g.RunFn(func(r *genny.Runner) error {
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		protoFile, err := protoutil.ParseProtoFile(f)
+		if err != nil {
+			return err
+		}
+
+		var protoEnum *proto.Enum
+		for _, e := range protoFile.Elements {
+			if v, ok := e.(*proto.Enum); ok && v.Name == enum.Datatype {
+				protoEnum = v
+				break
+			}
+		}
+		if protoEnum == nil {
+			return fmt.Errorf(
+				"can't define the %s enum because %s already exists and doesn't define it",
+				enum.Datatype,
+				filepath.Base(path),
+			)
+		}
+
+		var (
+			existing = make(map[string]struct{})
+			next     int
+		)
+		for _, e := range protoEnum.Elements {
+			if v, ok := e.(*proto.EnumField); ok {
+				existing[v.Name] = struct{}{}
+				if v.Integer >= next {
+					next = v.Integer + 1
+				}
+			}
+		}
+
+		var updated bool
+		for _, v := range enum.ProtoEnumValues() {
+			if _, ok := existing[v]; ok {
+				continue
+			}
+			protoutil.Append(protoEnum, protoutil.NewEnumField(v, next))
+			next++
+			updated = true
+		}
+		if !updated {
+			return nil
+		}
+
+		return r.File(genny.NewFileS(path, protoutil.Print(protoFile)))
+	})
+	return g
+}
+
+func containsEnumValue(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -19,4 +19,7 @@ var (
 
 	//go:embed files/simapp/* files/simapp/**/*
 	fsSimapp embed.FS
+
+	//go:embed files/enum/* files/enum/**/*
+	fsEnum embed.FS
 )
@@ -0,0 +1,191 @@
+package typed
+
+import (
+	"context"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/emicklei/proto"
+	"github.com/gobuffalo/genny/v2"
+	"github.com/gobuffalo/packd"
+
+	"github.com/ignite/cli/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field"
+)
+
+// NewFieldsGenerator returns the generator to update the fields of a type scaffolded from the boxes.
+// The previous options describe the type as it was scaffolded while the options contain its new fields.
+// Fields are added to or removed from the proto messages of the type, and the files rendered from the
+// boxes are rendered again with the new fields. Files modified since they were scaffolded are not
+// updated, their paths are returned so they can be updated manually.
+func NewFieldsGenerator(previous, opts *Options, boxes ...packd.Walker) (*genny.Generator, []string, error) {
+	g := genny.New()
+	g.RunFn(protoFieldsModify(previous, opts))
+
+	previousFiles, err := render(previous, boxes...)
+	if err != nil {
+		return nil, nil, err
+	}
+	files, err := render(opts, boxes...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var notUpdated []string
+	for path, content := range files {
+		// proto files are modified in place
+		if filepath.Ext(path) == ".proto" {
+			continue
+		}
+
+		previousContent, ok := previousFiles[path]
+		if !ok || previousContent == content {
+			continue
+		}
+
+		current, err := os.ReadFile(path)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, nil, err
+		}
+
+		if string(current) != previousContent {
+			notUpdated = append(notUpdated, path)
+			continue
+		}
+		g.File(genny.NewFileS(path, content))
+	}
+	sort.Strings(notUpdated)
+
+	return g, notUpdated, nil
+}
+
+// render returns the formatted content of the files rendered from the boxes by path.
+func render(opts *Options, boxes ...packd.Walker) (map[string]string, error) {
+	g := genny.New()
+	for _, box := range boxes {
+		if err := Box(box, opts, g); err != nil {
+			return nil, err
+		}
+	}
+
+	runner := xgenny.DryRunner(context.Background())
+	if err := runner.With(g); err != nil {
+		return nil, err
+	}
+	if err := runner.Run(); err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]string)
+	for _, f := range runner.Results().Files {
+		content := f.String()
+		if filepath.Ext(f.Name()) == ".go" {
+			// files are compared with the scaffolded ones which are formatted
+			if formatted, err := format.Source([]byte(content)); err == nil {
+				content = string(formatted)
+			}
+		}
+		files[f.Name()] = content
+	}
+	return files, nil
+}
+
+// protoFieldsModify adds and removes the fields of the type in its proto message
+// and in the proto messages to create and update it.
+func protoFieldsModify(previous, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		added, removed := FieldsDiff(previous.Fields, opts.Fields)
+
+		messages := map[string][]string{
+			opts.ProtoPath(opts.TypeName.Snake + ".proto"): {opts.TypeName.UpperCamel},
+		}
+		if !opts.NoMessage {
+			messages[opts.ProtoPath("tx.proto")] = []string{
+				"MsgCreate" + opts.TypeName.UpperCamel,
+				"MsgUpdate" + opts.TypeName.UpperCamel,
+			}
+		}
+
+		for path, names := range messages {
+			f, err := r.Disk.Find(path)
+			if err != nil {
+				return err
+			}
+			protoFile, err := protoutil.ParseProtoFile(f)
+			if err != nil {
+				return err
+			}
+
+			// Ensure custom types are imported
+			var protoImports []*proto.Import
+			for _, imp := range added.ProtoImports() {
+				protoImports = append(protoImports, protoutil.NewImport(imp))
+			}
+			for _, f := range added.Custom() {
+				protoPath := fmt.Sprintf("%[1]v/%[2]v/%[3]v.proto", opts.AppName, opts.ModuleName, f)
+				protoImports = append(protoImports, protoutil.NewImport(protoPath))
+			}
+			if err = protoutil.AddImports(protoFile, true, protoImports...); err != nil {
+				return fmt.Errorf("failed while adding imports in %s: %w", path, err)
+			}
+
+			for _, name := range names {
+				msg, err := protoutil.GetMessageByName(protoFile, name)
+				if err != nil {
+					return fmt.Errorf("failed while looking up message '%s' in %s: %w", name, path, err)
+				}
+
+				for _, f := range removed {
+					if protoutil.RemoveFields(msg, f.Name.LowerCamel) == 0 {
+						return fmt.Errorf("field '%s' not found in message '%s' in %s", f.Name.LowerCamel, name, path)
+					}
+				}
+				for _, f := range added {
+					if protoutil.IsReservedName(msg, f.Name.LowerCamel) {
+						return fmt.Errorf(
+							"field '%s' can't be added to message '%s' in %s because its name is reserved by a removed field",
+							f.Name.LowerCamel, name, path,
+						)
+					}
+					protoutil.Append(msg, f.ToProtoField(protoutil.NextUniqueID(msg)))
+				}
+			}
+
+			newFile := genny.NewFileS(path, protoutil.Print(protoFile))
+			if err := r.File(newFile); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// FieldsDiff returns the fields added and removed from the previous fields.
+func FieldsDiff(previous, fields field.Fields) (added, removed field.Fields) {
+	contains := func(fields field.Fields, f field.Field) bool {
+		for _, f2 := range fields {
+			if strings.EqualFold(f.Name.LowerCamel, f2.Name.LowerCamel) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, f := range fields {
+		if !contains(previous, f) {
+			added = append(added, f)
+		}
+	}
+	for _, f := range previous {
+		if !contains(fields, f) {
+			removed = append(removed, f)
+		}
+	}
+	return added, removed
+}
@@ -0,0 +1,150 @@
+package typed_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/gobuffalo/packd"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/templates/field"
+	"github.com/ignite/cli/ignite/templates/typed"
+)
+
+const typeTemplate = `package types
+
+type <%= TypeName.UpperCamel %>Fields struct {<%= for (field) in Fields { %>
+	<%= field.Name.UpperCamel %> <%= field.DataType() %><% } %>
+}
+`
+
+func TestNewFieldsGenerator(t *testing.T) {
+	// Arrange
+	appPath := t.TempDir()
+	typeName, err := multiformatname.NewName("post")
+	require.NoError(t, err)
+	previousFields, err := field.ParseFields([]string{"title"}, func(string) error { return nil })
+	require.NoError(t, err)
+	fields, err := field.ParseFields([]string{"title", "tags:array.uint"}, func(string) error { return nil })
+	require.NoError(t, err)
+
+	previous := &typed.Options{
+		AppName:    "blog",
+		AppPath:    appPath,
+		ModuleName: "blog",
+		ModulePath: "github.com/test/blog",
+		TypeName:   typeName,
+		Fields:     previousFields,
+		NoMessage:  true,
+	}
+	opts := *previous
+	opts.Fields = fields
+
+	// create the files as they were scaffolded
+	protoPath := previous.ProtoPath("post.proto")
+	require.NoError(t, os.MkdirAll(filepath.Dir(protoPath), 0o755))
+	require.NoError(t, os.WriteFile(protoPath, []byte(`syntax = "proto3";
+package blog.blog;
+
+message Post {
+  string title = 1;
+}
+`), 0o644))
+
+	unmodifiedPath := filepath.Join(appPath, "x/blog/types/post_fields.go")
+	modifiedPath := filepath.Join(appPath, "x/blog/types/post_fields_modified.go")
+	require.NoError(t, os.MkdirAll(filepath.Dir(unmodifiedPath), 0o755))
+	scaffolded := "package types\n\ntype PostFields struct {\n\tTitle string\n}\n"
+	require.NoError(t, os.WriteFile(unmodifiedPath, []byte(scaffolded), 0o644))
+	require.NoError(t, os.WriteFile(modifiedPath, []byte(scaffolded+"\n// modified\n"), 0o644))
+
+	box := packd.NewMemoryBox()
+	require.NoError(t, box.AddString(filepath.Join(appPath, "x/{{moduleName}}/types/{{typeName}}_fields.go.plush"), typeTemplate))
+	require.NoError(t, box.AddString(filepath.Join(appPath, "x/{{moduleName}}/types/{{typeName}}_fields_modified.go.plush"), typeTemplate))
+
+	// Act
+	g, notUpdated, err := typed.NewFieldsGenerator(previous, &opts, box)
+	require.NoError(t, err)
+	runner := genny.WetRunner(context.Background())
+	require.NoError(t, runner.With(g))
+	require.NoError(t, runner.Run())
+
+	// Assert
+	require.Equal(t, []string{modifiedPath}, notUpdated)
+
+	content, err := os.ReadFile(unmodifiedPath)
+	require.NoError(t, err)
+	require.Equal(t, "package types\n\ntype PostFields struct {\n\tTitle string\n\tTags  []uint64\n}\n", string(content))
+
+	content, err = os.ReadFile(modifiedPath)
+	require.NoError(t, err)
+	require.Equal(t, scaffolded+"\n// modified\n", string(content))
+
+	content, err = os.ReadFile(protoPath)
+	require.NoError(t, err)
+	require.Regexp(t, `repeated uint64 tags\s+= 2;`, string(content))
+}
+
+func TestNewFieldsGeneratorReservesRemovedFields(t *testing.T) {
+	// Arrange
+	appPath := t.TempDir()
+	typeName, err := multiformatname.NewName("post")
+	require.NoError(t, err)
+	parseFields := func(fields ...string) field.Fields {
+		f, err := field.ParseFields(fields, func(string) error { return nil })
+		require.NoError(t, err)
+		return f
+	}
+	runGenerator := func(previous, fields field.Fields) error {
+		opts := &typed.Options{
+			AppName:    "blog",
+			AppPath:    appPath,
+			ModuleName: "blog",
+			ModulePath: "github.com/test/blog",
+			TypeName:   typeName,
+			Fields:     previous,
+			NoMessage:  true,
+		}
+		newOpts := *opts
+		newOpts.Fields = fields
+
+		g, _, err := typed.NewFieldsGenerator(opts, &newOpts, packd.NewMemoryBox())
+		require.NoError(t, err)
+		runner := genny.WetRunner(context.Background())
+		require.NoError(t, runner.With(g))
+		return runner.Run()
+	}
+
+	protoPath := filepath.Join(appPath, "proto/blog/blog/post.proto")
+	require.NoError(t, os.MkdirAll(filepath.Dir(protoPath), 0o755))
+	require.NoError(t, os.WriteFile(protoPath, []byte(`syntax = "proto3";
+package blog.blog;
+
+message Post {
+  string title = 1;
+  string body = 2;
+}
+`), 0o644))
+
+	// Act
+	err = runGenerator(parseFields("title", "body"), parseFields("title", "tags:array.uint"))
+
+	// Assert
+	require.NoError(t, err)
+	content, err := os.ReadFile(protoPath)
+	require.NoError(t, err)
+	require.NotContains(t, string(content), "body = 2")
+	require.Contains(t, string(content), "reserved 2;")
+	require.Contains(t, string(content), `reserved "body";`)
+	require.Regexp(t, `repeated uint64 tags\s+= 3;`, string(content))
+
+	// Act: add the removed field again
+	err = runGenerator(parseFields("title", "tags:array.uint"), parseFields("title", "tags:array.uint", "body"))
+
+	// Assert
+	require.ErrorContains(t, err, "its name is reserved")
+}
@@ -82,6 +82,18 @@ func NewGenerator(replacer placeholder.Replacer, opts *typed.Options) (*genny.Ge
 	return g, typed.Box(componentTemplate, opts, g)
 }
 
+// NewFieldsGenerator returns the generator to update the fields of a list type in a module.
+// It also returns the files that must be updated manually because they were modified.
+func NewFieldsGenerator(previous, opts *typed.Options) (*genny.Generator, []string, error) {
+	return typed.NewFieldsGenerator(
+		previous,
+		opts,
+		xgenny.NewEmbedWalker(fsMessages, "files/messages/", opts.AppPath),
+		xgenny.NewEmbedWalker(fsComponent, "files/component/", opts.AppPath),
+		xgenny.NewEmbedWalker(fsSimapp, "files/simapp/", opts.AppPath),
+	)
+}
+
 // protoTxModify modifies the tx.proto file to add the required RPCs and messages.
 //
 // What it expects:
@@ -116,6 +116,20 @@ func NewGenerator(replacer placeholder.Replacer, opts *typed.Options) (*genny.Ge
 	return g, typed.Box(componentTemplate, opts, g)
 }
 
+// NewFieldsGenerator returns the generator to update the fields of a map type in a module.
+// It also returns the files that must be updated manually because they were modified.
+func NewFieldsGenerator(previous, opts *typed.Options) (*genny.Generator, []string, error) {
+	return typed.NewFieldsGenerator(
+		previous,
+		opts,
+		xgenny.NewEmbedWalker(fsMessages, "files/messages/", opts.AppPath),
+		xgenny.NewEmbedWalker(fsTestsMessages, "files/tests/messages/", opts.AppPath),
+		xgenny.NewEmbedWalker(fsComponent, "files/component/", opts.AppPath),
+		xgenny.NewEmbedWalker(fsTestsComponent, "files/tests/component/", opts.AppPath),
+		xgenny.NewEmbedWalker(fsSimapp, "files/simapp/", opts.AppPath),
+	)
+}
+
 // Modifies query.proto to add the required RPCs and Messages.
 //
 // What it depends on:
@@ -180,6 +194,27 @@ func protoRPCModify(opts *typed.Options) genny.RunFn {
 		protoutil.AttachComment(rpcQueryGet, fmt.Sprintf("Queries a list of %v items.", typenameUpper))
 		protoutil.Append(serviceQuery, rpcQueryGet, rpcQueryAll)
 
+		for _, index := range opts.SecondaryIndexes {
+			rpcQueryBy := protoutil.NewRPC(
+				"List"+typenameUpper+"By"+index.Name.UpperCamel,
+				"QueryList"+typenameUpper+"By"+index.Name.UpperCamel+"Request",
+				"QueryList"+typenameUpper+"By"+index.Name.UpperCamel+"Response",
+				protoutil.WithRPCOptions(
+					protoutil.NewOption(
+						"google.api.http",
+						fmt.Sprintf(
+							"/%s/%s/%s/by_%s/{%s}",
+							appModulePath, opts.ModuleName, typenameSnake, index.Name.Snake, index.ProtoFieldName(),
+						),
+						protoutil.Custom(),
+						protoutil.SetField("get"),
+					),
+				),
+			)
+			protoutil.AttachComment(rpcQueryBy, fmt.Sprintf("Queries a list of %v items by %v.", typenameUpper, index.Name.LowerCamel))
+			protoutil.Append(serviceQuery, rpcQueryBy)
+		}
+
 		//  Ensure custom types are imported
 		var protoImports []*proto.Import
 		for _, imp := range opts.Fields.ProtoImports() {
@@ -229,6 +264,30 @@ func protoRPCModify(opts *typed.Options) genny.RunFn {
 		)
 		protoutil.Append(protoFile, queryGetRequest, queryGetResponse, queryAllRequest, queryAllResponse)
 
+		for _, index := range opts.SecondaryIndexes {
+			queryByRequest := protoutil.NewMessage(
+				"QueryList"+typenameUpper+"By"+index.Name.UpperCamel+"Request",
+				protoutil.WithFields(
+					index.ToProtoField(1),
+					protoutil.NewField(paginationName, paginationType+"Request", 2),
+				),
+			)
+			queryByResponse := protoutil.NewMessage(
+				"QueryList"+typenameUpper+"By"+index.Name.UpperCamel+"Response",
+				protoutil.WithFields(
+					protoutil.NewField(
+						typenameLower,
+						typenameUpper,
+						1,
+						protoutil.Repeated(),
+						protoutil.WithFieldOptions(gogoOption),
+					),
+					protoutil.NewField(paginationName, paginationType+"Response", 2),
+				),
+			)
+			protoutil.Append(protoFile, queryByRequest, queryByResponse)
+		}
+
 		newFile := genny.NewFileS(path, protoutil.Print(protoFile))
 		return r.File(newFile)
 	}
@@ -243,7 +302,11 @@ func clientCliQueryModify(replacer placeholder.Replacer, opts *typed.Options) ge
 		}
 		template := `cmd.AddCommand(CmdList%[2]v())
 	cmd.AddCommand(CmdShow%[2]v())
-%[1]v`
+`
+		for _, index := range opts.SecondaryIndexes {
+			template += fmt.Sprintf("\tcmd.AddCommand(CmdList%%[2]vBy%v())\n", index.Name.UpperCamel)
+		}
+		template += "%[1]v"
 		replacement := fmt.Sprintf(template, typed.Placeholder,
 			opts.TypeName.UpperCamel,
 		)
@@ -326,7 +389,11 @@ func genesisTypesModify(replacer placeholder.Replacer, opts *typed.Options) genn
 %[2]vIndexMap := make(map[string]struct{})
 
 for _, elem := range gs.%[3]vList {
-	index := %[4]v
+	key, err := %[4]v
+	if err != nil {
+		return fmt.Errorf("invalid index for %[2]v: %%w", err)
+	}
+	index := string(key)
 	if _, ok := %[2]vIndexMap[index]; ok {
 		return fmt.Errorf("duplicated index for %[2]v")
 	}
@@ -338,7 +405,7 @@ for _, elem := range gs.%[3]vList {
 			typed.PlaceholderGenesisTypesValidate,
 			opts.TypeName.LowerCamel,
 			opts.TypeName.UpperCamel,
-			fmt.Sprintf("string(%s)", keyCall),
+			keyCall,
 		)
 		content = replacer.Replace(content, typed.PlaceholderGenesisTypesValidate, replacementTypesValidate)
 
@@ -13,17 +13,18 @@ import (
 
 // Options ...
 type Options struct {
-	AppName      string
-	AppPath      string
-	ModuleName   string
-	ModulePath   string
-	TypeName     multiformatname.Name
-	MsgSigner    multiformatname.Name
-	Fields       field.Fields
-	Indexes      field.Fields
-	NoMessage    bool
-	NoSimulation bool
-	IsIBC        bool
+	AppName          string
+	AppPath          string
+	ModuleName       string
+	ModulePath       string
+	TypeName         multiformatname.Name
+	MsgSigner        multiformatname.Name
+	Fields           field.Fields
+	Indexes          field.Fields
+	SecondaryIndexes field.Fields
+	NoMessage        bool
+	NoSimulation     bool
+	IsIBC            bool
 }
 
 // Validate that options are usable.
@@ -4,7 +4,9 @@ import (
 	"embed"
 	"fmt"
 	"math/rand"
+	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/emicklei/proto"
 
@@ -13,7 +15,9 @@ import (
 	"github.com/ignite/cli/ignite/pkg/gomodulepath"
 	"github.com/ignite/cli/ignite/pkg/placeholder"
 	"github.com/ignite/cli/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/ignite/pkg/xast"
 	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/templates/field"
 	"github.com/ignite/cli/ignite/templates/module"
 	"github.com/ignite/cli/ignite/templates/typed"
 )
@@ -81,6 +85,78 @@ func NewGenerator(replacer placeholder.Replacer, opts *typed.Options) (*genny.Ge
 	return g, typed.Box(componentTemplate, opts, g)
 }
 
+// NewFieldsGenerator returns the generator to update the fields of a singleton type in a module.
+// It also returns the files that must be updated manually because they were modified.
+func NewFieldsGenerator(previous, opts *typed.Options) (*genny.Generator, []string, error) {
+	g, notUpdated, err := typed.NewFieldsGenerator(
+		previous,
+		opts,
+		xgenny.NewEmbedWalker(fsMessages, "files/messages/", opts.AppPath),
+		xgenny.NewEmbedWalker(fsComponent, "files/component/", opts.AppPath),
+		xgenny.NewEmbedWalker(fsimapp, "files/simapp/", opts.AppPath),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, removed := typed.FieldsDiff(previous.Fields, opts.Fields)
+	if len(removed) > 0 {
+		g.RunFn(genesisTestsFieldsModify(opts, removed))
+	}
+	return g, notUpdated, nil
+}
+
+// genesisTestsFieldsModify removes the sample values of the removed fields from the genesis tests.
+func genesisTestsFieldsModify(opts *typed.Options, removed field.Fields) genny.RunFn {
+	return func(r *genny.Runner) error {
+		for _, path := range []string{
+			filepath.Join(opts.AppPath, "x", opts.ModuleName, "genesis_test.go"),
+			filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/genesis_test.go"),
+		} {
+			f, err := r.Disk.Find(path)
+			if os.IsNotExist(err) {
+				continue
+			} else if err != nil {
+				return err
+			}
+
+			var (
+				lines   []string
+				inState bool
+				state   = fmt.Sprintf("%[1]v: &types.%[1]v{", opts.TypeName.UpperCamel)
+			)
+			for _, line := range strings.Split(f.String(), "\n") {
+				trimmed := strings.TrimSpace(line)
+				switch {
+				case strings.HasPrefix(trimmed, state):
+					inState = true
+				case inState && strings.HasPrefix(trimmed, "}"):
+					inState = false
+				case inState && isFieldValue(trimmed, removed):
+					continue
+				}
+				lines = append(lines, line)
+			}
+
+			newFile := genny.NewFileS(path, strings.Join(lines, "\n"))
+			if err := r.File(newFile); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// isFieldValue checks if the line assigns the value of one of the fields in a struct literal.
+func isFieldValue(line string, fields field.Fields) bool {
+	for _, f := range fields {
+		if strings.HasPrefix(line, f.Name.UpperCamel+":") {
+			return true
+		}
+	}
+	return false
+}
+
 func typesKeyModify(opts *typed.Options) genny.RunFn {
 	return func(r *genny.Runner) error {
 		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/keys.go")
@@ -270,6 +346,11 @@ func genesisTestsModify(replacer placeholder.Replacer, opts *typed.Options) genn
 		)
 		content = replacer.Replace(content, module.PlaceholderGenesisTestAssert, replacementTests)
 
+		content, err = appendGenesisImports(content, opts.Fields)
+		if err != nil {
+			return err
+		}
+
 		newFile := genny.NewFileS(path, content)
 		return r.File(newFile)
 	}
@@ -300,11 +381,28 @@ func genesisTypesTestsModify(replacer placeholder.Replacer, opts *typed.Options)
 		)
 		content := replacer.Replace(f.String(), module.PlaceholderTypesGenesisValidField, replacementValid)
 
+		content, err = appendGenesisImports(content, opts.Fields)
+		if err != nil {
+			return err
+		}
+
 		newFile := genny.NewFileS(path, content)
 		return r.File(newFile)
 	}
 }
 
+// appendGenesisImports adds the imports required by the genesis args of the fields.
+func appendGenesisImports(content string, fields field.Fields) (string, error) {
+	for _, goImport := range fields.GoGenesisImports() {
+		var err error
+		content, err = xast.AppendImport(content, goImport.Alias, goImport.Name)
+		if err != nil {
+			return "", err
+		}
+	}
+	return content, nil
+}
+
 func genesisModuleModify(replacer placeholder.Replacer, opts *typed.Options) genny.RunFn {
 	return func(r *genny.Runner) error {
 		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "genesis.go")
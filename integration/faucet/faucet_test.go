@@ -82,7 +82,7 @@ func TestRequestCoinsFromFaucet(t *testing.T) {
 
 	// faucet request fails when requesting more than max coins
 	_, err = faucetClient.Transfer(ctx, cosmosfaucet.NewTransferRequest(addr, []string{"500token"}))
-	isErrTransferRequest(err, http.StatusInternalServerError)
+	isErrTransferRequest(err, http.StatusTooManyRequests)
 
 	// faucet request fails when transfer should fail
 	_, err = faucetClient.Transfer(ctx, cosmosfaucet.NewTransferRequest(addr, []string{"500nonexistent"}))
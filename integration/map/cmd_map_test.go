@@ -128,6 +128,36 @@ func TestCreateMap(t *testing.T) {
 		)),
 	))
 
+	env.Must(env.Exec("create a map with composite index and secondary indexes",
+		step.NewSteps(step.New(
+			step.Exec(
+				envtest.IgniteApp,
+				"s",
+				"map",
+				"--yes",
+				"order",
+				"owner:address",
+				"denom",
+				"amount:uint",
+				"--index",
+				"seller:address,orderID:uint",
+				"--secondary-index",
+				"owner,denom",
+				"--module",
+				"example",
+			),
+			step.Workdir(app.SourcePath()),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating a map with a secondary index that is not a field",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "map", "--yes", "map_with_invalid_secondary_index", "email", "--secondary-index", "name"),
+			step.Workdir(app.SourcePath()),
+		)),
+		envtest.ExecShouldError(),
+	))
+
 	env.Must(env.Exec("create a map with invalid index",
 		step.NewSteps(step.New(
 			step.Exec(